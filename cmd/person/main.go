@@ -1,17 +1,60 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
 	"github.com/AskaryanKarine/BMSTU-ds-1/internal/app"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
 	"github.com/charmbracelet/log"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
+const usage = `Usage:
+  person [flags]               run the service
+  person config print [flags]  show the effective config, secrets redacted
+
+Run person -h for the flags.
+`
+
 func main() {
-	a, err := app.New()
+	args := os.Args[1:]
+	if len(args) > 0 && args[0] == "config" {
+		if len(args) < 2 || args[1] != "print" {
+			fmt.Fprint(os.Stderr, usage)
+			os.Exit(2)
+		}
+		cfg := loadConfig(args[2:])
+		if err := config.Print(os.Stdout, cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	a, err := app.New(loadConfig(args), func() (config.Config, error) {
+		return config.Load(args)
+	})
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	if err = a.Start(); err != nil {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err = a.Run(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func loadConfig(args []string) config.Config {
+	cfg, err := config.Load(args)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
 		log.Fatal(err)
 	}
+	return cfg
 }
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"context"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/personctl"
+	"os"
+	"os/signal"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := personctl.Run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
@@ -1,38 +1,173 @@
 package app
 
 import (
+	"context"
+	"expvar"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/buildinfo"
 	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/breaker"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/cache"
 	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/connection"
 	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/person"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/tenant"
 	"github.com/AskaryanKarine/BMSTU-ds-1/internal/server"
+	"github.com/charmbracelet/log"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 type App struct {
-	srv *server.Server
-	cfg config.Config
+	srv      *server.Server
+	grpcSrv  *server.GRPCServer
+	adminSrv *server.Admin
+	// cfg changes on reload, the admin server reads it meanwhile.
+	cfgMu   sync.RWMutex
+	cfg     config.Config
+	cluster *connection.Cluster
+	persons *tenant.Registry[tenantStorage]
+	// load reads the config again on SIGHUP.
+	load func() (config.Config, error)
+	hup  chan os.Signal
 }
 
-func New() (*App, error) {
-	cfg, err := config.New()
+// tenantStorage is the person storage of a tenant.
+type tenantStorage struct {
+	repositories.Storage
+	// cache is nil when the cache is disabled.
+	cache interface {
+		SetTTL(ttl time.Duration)
+		Stats() cache.Stats
+	}
+}
+
+// cacheStatsApp is the app whose caches the person_cache expvar reports,
+// the last one made, as a name can only be published once.
+var (
+	cacheStatsApp     atomic.Pointer[App]
+	cacheStatsPublish sync.Once
+)
+
+func publishCacheStats(a *App) {
+	cacheStatsApp.Store(a)
+	cacheStatsPublish.Do(func() {
+		expvar.Publish("person_cache", expvar.Func(func() any {
+			stats := make(map[string]cache.Stats)
+			if a := cacheStatsApp.Load(); a != nil {
+				a.persons.Each(func(t string, s tenantStorage) {
+					if s.cache != nil {
+						stats[t] = s.cache.Stats()
+					}
+				})
+			}
+			return stats
+		}))
+	})
+}
+
+// New makes the app with cfg. load is called to read the config again on
+// SIGHUP.
+func New(cfg config.Config, load func() (config.Config, error)) (*App, error) {
+	setLogLevel(cfg.LogLevel)
+	hup := notifyHangup()
+
+	cluster, err := connection.OpenCluster(cfg)
 	if err != nil {
+		signal.Stop(hup)
 		return nil, err
 	}
 
-	db, err := connection.OpenPostgres(cfg)
-	if err != nil {
+	a := &App{cfg: cfg, cluster: cluster, load: load, hup: hup}
+	// The database is shared, so is the breaker. Caches are per tenant, as
+	// they are keyed by id only.
+	brk := breaker.New(cfg.BreakerFailures, cfg.BreakerCooldown)
+	a.persons = tenant.NewRegistry(cfg.ServedTenants(), func(t string) tenantStorage {
+		s := tenantStorage{Storage: breaker.NewStorage(
+			person.NewReplicatedStorage(cluster, person.Tenant{ID: t, Schema: cfg.TenantSchema(t)}),
+			brk,
+		)}
+		if cfg.PersonCacheEnabled {
+			cached := cache.NewStorage(s.Storage, cache.NewLRU(cfg.PersonCacheSize), a.config().PersonCacheTTL)
+			s.Storage, s.cache = cached, cached
+		}
+		return s
+	})
+	if cfg.PersonCacheEnabled {
+		publishCacheStats(a)
+	}
+
+	repos := func(t string) (server.PersonRepository, error) {
+		s, err := a.persons.Storage(t)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	if a.srv, err = server.NewMultiTenant(cfg, repos); err != nil {
+		signal.Stop(hup)
+		cluster.Close()
 		return nil, err
 	}
+	a.grpcSrv = server.NewMultiTenantGRPC(cfg, repos, a.srv.Maintenance(), a.srv.GRPCCredentials())
+	a.adminSrv = server.NewAdmin(a.srv.Maintenance(), a.config)
+	return a, nil
+}
+
+// Run serves until ctx is done or a server fails, then stops the servers and
+// closes the database connections. The app can not be run again.
+func (a *App) Run(ctx context.Context) error {
+	info := buildinfo.Get()
+	log.Info("person service", "version", info.Version, "commit", info.Commit, "build_time", info.BuildTime)
 
-	personStorage := person.NewStorage(db)
+	cfg := a.config()
+	// Addresses need a restart to change, the config may be reloaded meanwhile.
+	port, grpcPort, adminHost, adminPort := cfg.Port, cfg.GRPCPort, cfg.AdminHost, cfg.AdminPort
 
-	srv := server.New(personStorage)
-	return &App{
-		srv: srv,
-		cfg: cfg,
-	}, nil
+	l := NewLifecycle(cfg.ShutdownTimeout)
+	l.Add(
+		Component{
+			Name: "postgres",
+			Stop: func(context.Context) error { return a.cluster.Close() },
+		},
+		Component{
+			Name: "config reload",
+			Run: func(ctx context.Context) error {
+				a.reloadOnHangup(ctx)
+				return nil
+			},
+		},
+		Component{
+			Name: "work refresh",
+			Run: func(ctx context.Context) error {
+				a.refreshWorkDaily(ctx)
+				return nil
+			},
+		},
+		Component{
+			Name: "admin server",
+			Run:  func(context.Context) error { return a.adminSrv.Run(adminHost, adminPort) },
+			Stop: a.adminSrv.Shutdown,
+		},
+		Component{
+			Name: "grpc server",
+			Run:  func(context.Context) error { return a.grpcSrv.Run(grpcPort) },
+			Stop: a.grpcSrv.Shutdown,
+		},
+		Component{
+			Name: "http server",
+			Run:  func(context.Context) error { return a.srv.Run(port) },
+			Stop: a.srv.Shutdown,
+		},
+	)
+	return l.Run(ctx)
 }
 
-func (a *App) Start() error {
-	a.srv.Run(a.cfg.Port)
-	return nil
+// config returns the effective config.
+func (a *App) config() config.Config {
+	a.cfgMu.RLock()
+	defer a.cfgMu.RUnlock()
+	return a.cfg
 }
@@ -0,0 +1,41 @@
+package app
+
+import (
+	"encoding/json"
+	"expvar"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/cache"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/memory"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/tenant"
+	"testing"
+	"time"
+)
+
+func TestPublishCacheStats(t *testing.T) {
+	newApp := func(misses int) *App {
+		a := &App{}
+		a.persons = tenant.NewRegistry([]string{"default"}, func(string) tenantStorage {
+			cached := cache.NewStorage(memory.NewStorage(), cache.NewLRU(10), time.Minute)
+			return tenantStorage{Storage: cached, cache: cached}
+		})
+		s, err := a.persons.Storage("default")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < misses; i++ {
+			_, _ = s.GetPersonByID(1)
+		}
+		return a
+	}
+
+	// A second app in the same process must not publish the name again.
+	publishCacheStats(newApp(1))
+	publishCacheStats(newApp(2))
+
+	var stats map[string]cache.Stats
+	if err := json.Unmarshal([]byte(expvar.Get("person_cache").String()), &stats); err != nil {
+		t.Fatal(err)
+	}
+	if stats["default"].Misses != 2 {
+		t.Errorf("stats of the last app expected, but got %+v", stats)
+	}
+}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/charmbracelet/log"
+	"time"
+)
+
+const defaultShutdownTimeout = 10 * time.Second
+
+// Component is a part of the app with its own lifetime, like a server, a
+// background worker or a connection pool.
+type Component struct {
+	Name string
+	// Run blocks until the component is stopped or fails. Its context is
+	// cancelled when the component is stopped. Nil when there is nothing to
+	// run, like for a connection pool.
+	Run func(ctx context.Context) error
+	// Stop makes Run return and releases the resources within ctx. Nil when
+	// cancelling the context of Run is enough.
+	Stop func(ctx context.Context) error
+}
+
+// Lifecycle runs components together: they are started in the order they
+// were added and stopped in reverse order, so that a component may use the
+// ones added before it until it stops.
+type Lifecycle struct {
+	components      []Component
+	shutdownTimeout time.Duration
+}
+
+// NewLifecycle makes a lifecycle that gives components shutdownTimeout in
+// total to stop.
+func NewLifecycle(shutdownTimeout time.Duration) *Lifecycle {
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = defaultShutdownTimeout
+	}
+	return &Lifecycle{shutdownTimeout: shutdownTimeout}
+}
+
+func (l *Lifecycle) Add(components ...Component) {
+	l.components = append(l.components, components...)
+}
+
+type running struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Run starts the components and stops them when ctx is done or any of them
+// fails. It returns the failure along with the errors of stopping.
+func (l *Lifecycle) Run(ctx context.Context) error {
+	failed := make(chan error, len(l.components))
+	runs := make([]running, 0, len(l.components))
+	for _, c := range l.components {
+		// Components are stopped one by one, not all at once with ctx.
+		runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		r := running{cancel: cancel, done: make(chan struct{})}
+		runs = append(runs, r)
+		if c.Run == nil {
+			close(r.done)
+			continue
+		}
+
+		log.Info("starting", "component", c.Name)
+		go func() {
+			defer close(r.done)
+			err := c.Run(runCtx)
+			if runCtx.Err() != nil {
+				return
+			}
+			if err == nil {
+				err = errors.New("stopped unexpectedly")
+			}
+			failed <- fmt.Errorf("%s: %w", c.Name, err)
+		}()
+	}
+
+	var errs []error
+	select {
+	case <-ctx.Done():
+	case err := <-failed:
+		log.Error("component failed, stopping", "err", err)
+		errs = append(errs, err)
+	}
+
+	stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), l.shutdownTimeout)
+	defer cancel()
+	for i := len(l.components) - 1; i >= 0; i-- {
+		if err := l.stop(stopCtx, l.components[i], runs[i]); err != nil {
+			errs = append(errs, fmt.Errorf("stop %s: %w", l.components[i].Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (l *Lifecycle) stop(ctx context.Context, c Component, r running) error {
+	log.Info("stopping", "component", c.Name)
+	r.cancel()
+	if c.Stop != nil {
+		if err := c.Stop(ctx); err != nil {
+			return err
+		}
+	}
+
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
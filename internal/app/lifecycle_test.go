@@ -0,0 +1,137 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder keeps the order in which components were stopped.
+type recorder struct {
+	mu      sync.Mutex
+	stopped []string
+}
+
+func (r *recorder) add(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stopped = append(r.stopped, name)
+}
+
+// worker runs until its context is done.
+func (r *recorder) worker(name string) Component {
+	return Component{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			r.add(name)
+			return nil
+		},
+	}
+}
+
+// server runs until it is stopped, and fails with err when it is not nil.
+func (r *recorder) server(name string, err error) Component {
+	stop := make(chan struct{})
+	return Component{
+		Name: name,
+		Run: func(context.Context) error {
+			if err != nil {
+				return err
+			}
+			<-stop
+			return nil
+		},
+		Stop: func(context.Context) error {
+			r.add(name)
+			close(stop)
+			return nil
+		},
+	}
+}
+
+func TestLifecycle_Run(t *testing.T) {
+	errListen := errors.New("listen error")
+
+	tests := []struct {
+		name            string
+		components      func(r *recorder) []Component
+		cancel          bool
+		expectedErr     error
+		expectedStopped []string
+	}{
+		{
+			name: "stops in reverse order on cancel",
+			components: func(r *recorder) []Component {
+				return []Component{
+					{Name: "db", Stop: func(context.Context) error { r.add("db"); return nil }},
+					r.worker("worker"),
+					r.server("grpc", nil),
+					r.server("http", nil),
+				}
+			},
+			cancel:          true,
+			expectedStopped: []string{"http", "grpc", "worker", "db"},
+		},
+		{
+			name: "stops everything when a component fails",
+			components: func(r *recorder) []Component {
+				return []Component{
+					r.worker("worker"),
+					r.server("grpc", errListen),
+					r.server("http", nil),
+				}
+			},
+			expectedErr:     errListen,
+			expectedStopped: []string{"http", "grpc", "worker"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{}
+			l := NewLifecycle(time.Second)
+			l.Add(tt.components(r)...)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				time.AfterFunc(10*time.Millisecond, cancel)
+			}
+
+			err := l.Run(ctx)
+			if !errors.Is(err, tt.expectedErr) || (err == nil) != (tt.expectedErr == nil) {
+				t.Errorf("Run() error expected %v, but got %v", tt.expectedErr, err)
+			}
+			if !slices.Equal(r.stopped, tt.expectedStopped) {
+				t.Errorf("stop order expected %v, but got %v", tt.expectedStopped, r.stopped)
+			}
+		})
+	}
+}
+
+func TestLifecycle_shutdownTimeout(t *testing.T) {
+	r := &recorder{}
+	l := NewLifecycle(20 * time.Millisecond)
+	l.Add(
+		Component{Name: "db", Stop: func(context.Context) error { r.add("db"); return nil }},
+		Component{
+			Name: "stuck",
+			Run: func(context.Context) error {
+				select {}
+			},
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := l.Run(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Run() error expected %v, but got %v", context.DeadlineExceeded, err)
+	}
+	if !slices.Equal(r.stopped, []string{"db"}) {
+		t.Errorf("components after the stuck one expected to be stopped, but got %v", r.stopped)
+	}
+}
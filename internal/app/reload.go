@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"expvar"
+	"github.com/charmbracelet/log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// reloads counts config reloads by outcome, along with the time and error
+// of the last one and the changes that wait for a restart.
+var reloads = expvar.NewMap("config_reload")
+
+// notifyHangup starts catching SIGHUP, whose default action would kill the
+// process. New calls it before waiting for Postgres, which may take long.
+func notifyHangup() chan os.Signal {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	return hup
+}
+
+// reloadOnHangup reloads the config on every SIGHUP until ctx is done.
+func (a *App) reloadOnHangup(ctx context.Context) {
+	defer signal.Stop(a.hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-a.hup:
+			a.reload()
+		}
+	}
+}
+
+// reload reads the config again and applies the fields that may change at
+// runtime. An invalid config is not applied at all.
+func (a *App) reload() {
+	reloads.Set("last_reload", timeVar(time.Now()))
+
+	cfg, err := a.load()
+	if err != nil {
+		log.Error("config reload failed, keeping the current config", "err", err)
+		reloads.Add("failed", 1)
+		reloads.Set("last_error", stringVar(err.Error()))
+		return
+	}
+
+	applied, reloaded, restart := a.config().Reload(cfg)
+	setLogLevel(applied.LogLevel)
+	a.srv.Reload(applied)
+	a.persons.Each(func(_ string, s tenantStorage) {
+		if s.cache != nil {
+			s.cache.SetTTL(applied.PersonCacheTTL)
+		}
+	})
+	a.cfgMu.Lock()
+	a.cfg = applied
+	a.cfgMu.Unlock()
+
+	reloads.Add("succeeded", 1)
+	reloads.Set("last_error", stringVar(""))
+	reloads.Set("restart_required", stringVar(strings.Join(restart, ",")))
+	log.Info("config reloaded", "changed", reloaded)
+	if len(restart) > 0 {
+		log.Warn("config changes need a restart to apply", "fields", restart)
+	}
+}
+
+func setLogLevel(level string) {
+	// The level was validated with the config.
+	l, _ := log.ParseLevel(level)
+	log.SetLevel(l)
+}
+
+func stringVar(s string) *expvar.String {
+	v := new(expvar.String)
+	v.Set(s)
+	return v
+}
+
+func timeVar(t time.Time) *expvar.String {
+	return stringVar(t.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestApp_hangupBeforeRun(t *testing.T) {
+	loaded := make(chan struct{}, 1)
+	a := &App{hup: notifyHangup(), load: func() (config.Config, error) {
+		loaded <- struct{}{}
+		return config.Config{}, errors.New("invalid config")
+	}}
+
+	// Sent before the reload loop runs, it must not kill the process.
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = p.Signal(syscall.SIGHUP); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		a.reloadOnHangup(ctx)
+		close(done)
+	}()
+	select {
+	case <-loaded:
+	case <-time.After(5 * time.Second):
+		t.Error("SIGHUP sent before the reload loop was not handled")
+	}
+	cancel()
+	<-done
+}
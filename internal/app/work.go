@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/charmbracelet/log"
+	"time"
+)
+
+// refreshWorkDaily refreshes the work of persons now, for the days the app
+// was down, and after every midnight UTC, when employments end, until ctx
+// is done.
+func (a *App) refreshWorkDaily(ctx context.Context) {
+	for {
+		a.refreshWork()
+
+		timer := time.NewTimer(time.Until(models.Today(time.Now()).AddDate(0, 0, 1)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// refreshWork refreshes every served tenant, a failed one does not stop the
+// others.
+func (a *App) refreshWork() {
+	for _, t := range a.config().ServedTenants() {
+		s, err := a.persons.Storage(t)
+		if err != nil {
+			log.Error("work refresh failed", "tenant", t, "err", err)
+			continue
+		}
+		ids, err := s.RefreshWork()
+		if err != nil {
+			log.Error("work refresh failed", "tenant", t, "err", err)
+			continue
+		}
+		log.Debug("work refreshed", "tenant", t, "persons", len(ids))
+	}
+}
@@ -0,0 +1,44 @@
+// Package buildinfo describes the running binary. The version, commit and
+// build time are set at build time with ldflags:
+//
+//	go build -ldflags "-X github.com/AskaryanKarine/BMSTU-ds-1/internal/buildinfo.Version=v1.2.0
+//	  -X github.com/AskaryanKarine/BMSTU-ds-1/internal/buildinfo.Commit=$(git rev-parse HEAD)
+//	  -X github.com/AskaryanKarine/BMSTU-ds-1/internal/buildinfo.BuildTime=$(date -u +%FT%TZ)"
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+var (
+	Version   = "dev"
+	Commit    string
+	BuildTime string
+)
+
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the build info. The commit and build time that are not set
+// with ldflags are taken from the VCS stamp of the go toolchain, if any.
+func Get() Info {
+	info := Info{Version: Version, Commit: Commit, BuildTime: BuildTime, GoVersion: runtime.Version()}
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	for _, s := range bi.Settings {
+		switch {
+		case s.Key == "vcs.revision" && info.Commit == "":
+			info.Commit = s.Value
+		case s.Key == "vcs.time" && info.BuildTime == "":
+			info.BuildTime = s.Value
+		}
+	}
+	return info
+}
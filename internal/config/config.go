@@ -1,23 +1,317 @@
 package config
 
 import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
 	"github.com/ilyakaznacheev/cleanenv"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
 )
 
+const (
+	EnvDev  = "dev"
+	EnvTest = "test"
+	EnvProd = "prod"
+)
+
+const (
+	TenantSourceNone      = "none"
+	TenantSourceHeader    = "header"
+	TenantSourceJWT       = "jwt"
+	TenantSourceSubdomain = "subdomain"
+
+	TenantIsolationColumn = "column"
+	TenantIsolationSchema = "schema"
+)
+
+// envConfigFile is the config file to read when the -config flag is not
+// given.
+const envConfigFile = "CONFIG_FILE"
+
+// Config is read from defaults, the config file, environment variables and
+// command line flags, each layer overriding the previous one. Keys of the
+// file are the lower case env names, flags the same with dashes: POSTGRES_DSN
+// is postgres_dsn in the file and -postgres-dsn on the command line. Fields
+// tagged reload are applied by a reload on SIGHUP, others need a restart.
 type Config struct {
-	AppEnv      string `env:"APP_ENV" envDefault:"test"`
-	PostgresDSN string `env:"POSTGRES_DSN"`
-	Port        int    `env:"PORT" envDefault:"8000"`
+	AppEnv      string `yaml:"app_env" toml:"app_env" env:"APP_ENV" env-default:"test"`
+	PostgresDSN string `yaml:"postgres_dsn" toml:"postgres_dsn" env:"POSTGRES_DSN" secret:"true"`
+	Port        int    `yaml:"port" toml:"port" env:"PORT" env-default:"8000"`
+	GRPCPort    int    `yaml:"grpc_port" toml:"grpc_port" env:"GRPC_PORT" env-default:"9000"`
+	PublicURL   string `yaml:"public_url" toml:"public_url" env:"PUBLIC_URL"`
+	Version     string `yaml:"app_version" toml:"app_version" env:"APP_VERSION"`
+	// MaxBodySize limits request bodies, in bytes.
+	MaxBodySize int64 `yaml:"max_body_size" toml:"max_body_size" env:"MAX_BODY_SIZE" env-default:"1048576" reload:"true"`
+	// LenientDecoding accepts unknown fields and any Content-Type in request
+	// bodies, as before strict decoding was introduced.
+	LenientDecoding bool `yaml:"lenient_decoding" toml:"lenient_decoding" env:"LENIENT_DECODING" env-default:"false" reload:"true"`
+	// CompressionMinSize is the smallest response, in bytes, that is
+	// compressed. Zero compresses all of them.
+	CompressionMinSize int `yaml:"compression_min_size" toml:"compression_min_size" env:"COMPRESSION_MIN_SIZE" env-default:"1024" reload:"true"`
+	// CacheMaxAge is how long clients may reuse responses of read endpoints
+	// without revalidating them.
+	CacheMaxAge time.Duration `yaml:"cache_max_age" toml:"cache_max_age" env:"CACHE_MAX_AGE" env-default:"0s" reload:"true"`
+	// PersonCacheEnabled puts an in-process cache of persons by id in front
+	// of Postgres.
+	PersonCacheEnabled bool          `yaml:"person_cache_enabled" toml:"person_cache_enabled" env:"PERSON_CACHE_ENABLED" env-default:"false"`
+	PersonCacheSize    int           `yaml:"person_cache_size" toml:"person_cache_size" env:"PERSON_CACHE_SIZE" env-default:"10000"`
+	PersonCacheTTL     time.Duration `yaml:"person_cache_ttl" toml:"person_cache_ttl" env:"PERSON_CACHE_TTL" env-default:"1m" reload:"true"`
+	// PostgresReplicaDSNs are read replicas of PostgresDSN, separated by
+	// commas.
+	PostgresReplicaDSNs []string `yaml:"postgres_replica_dsns" toml:"postgres_replica_dsns" env:"POSTGRES_REPLICA_DSNS" env-separator:"," secret:"true"`
+	// ReplicaStickiness is how long a client reads from the primary after it
+	// wrote, as told by the X-Last-Write it sends back.
+	ReplicaStickiness          time.Duration `yaml:"replica_stickiness" toml:"replica_stickiness" env:"REPLICA_STICKINESS" env-default:"5s"`
+	ReplicaHealthCheckInterval time.Duration `yaml:"replica_health_check_interval" toml:"replica_health_check_interval" env:"REPLICA_HEALTH_CHECK_INTERVAL" env-default:"5s"`
+	// PostgresConnectTimeout is how long the app waits for Postgres at
+	// startup.
+	PostgresConnectTimeout time.Duration `yaml:"postgres_connect_timeout" toml:"postgres_connect_timeout" env:"POSTGRES_CONNECT_TIMEOUT" env-default:"30s"`
+	// PostgresMaxOpenConns and the other pool limits apply to each database
+	// of the cluster. Zero means no limit.
+	PostgresMaxOpenConns    int           `yaml:"postgres_max_open_conns" toml:"postgres_max_open_conns" env:"POSTGRES_MAX_OPEN_CONNS" env-default:"25"`
+	PostgresMaxIdleConns    int           `yaml:"postgres_max_idle_conns" toml:"postgres_max_idle_conns" env:"POSTGRES_MAX_IDLE_CONNS" env-default:"5"`
+	PostgresConnMaxLifetime time.Duration `yaml:"postgres_conn_max_lifetime" toml:"postgres_conn_max_lifetime" env:"POSTGRES_CONN_MAX_LIFETIME" env-default:"30m"`
+	PostgresConnMaxIdleTime time.Duration `yaml:"postgres_conn_max_idle_time" toml:"postgres_conn_max_idle_time" env:"POSTGRES_CONN_MAX_IDLE_TIME" env-default:"5m"`
+	// SlowQueryThreshold is the duration above which queries are logged.
+	// Zero disables the log.
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold" toml:"slow_query_threshold" env:"SLOW_QUERY_THRESHOLD" env-default:"200ms"`
+	// BreakerFailures is how many database outage errors in a row open the
+	// circuit breaker, which then fails requests fast for BreakerCooldown.
+	BreakerFailures int           `yaml:"breaker_failures" toml:"breaker_failures" env:"BREAKER_FAILURES" env-default:"5"`
+	BreakerCooldown time.Duration `yaml:"breaker_cooldown" toml:"breaker_cooldown" env:"BREAKER_COOLDOWN" env-default:"10s"`
+	// LogLevel is one of debug, info, warn, error and fatal.
+	LogLevel string `yaml:"log_level" toml:"log_level" env:"LOG_LEVEL" env-default:"info" reload:"true"`
+	// CORSAllowOrigins are the origins allowed to call the HTTP API from a
+	// browser, separated by commas. Without any, dev and test allow every
+	// origin, *, while prod allows none.
+	CORSAllowOrigins []string `yaml:"cors_allow_origins" toml:"cors_allow_origins" env:"CORS_ALLOW_ORIGINS" env-separator:"," reload:"true"`
+	// RateLimit is how many requests per second a client, by IP, may make,
+	// with bursts of up to RateLimitBurst. Zero disables the limit.
+	RateLimit      float64 `yaml:"rate_limit" toml:"rate_limit" env:"RATE_LIMIT" env-default:"0" reload:"true"`
+	RateLimitBurst int     `yaml:"rate_limit_burst" toml:"rate_limit_burst" env:"RATE_LIMIT_BURST" env-default:"20" reload:"true"`
+	// TrustedProxies are the CIDR ranges of the proxies in front of the
+	// service, separated by commas. Clients are told apart by the
+	// X-Forwarded-For of these proxies, by the address of the connection
+	// without any.
+	TrustedProxies []string `yaml:"trusted_proxies" toml:"trusted_proxies" env:"TRUSTED_PROXIES" env-separator:","`
+	// CORSAllowMethods and CORSAllowHeaders answer preflight requests,
+	// CORSExposeHeaders are the response headers scripts may read. Both
+	// header lists get TenantHeader with TenantSource header.
+	CORSAllowMethods  []string `yaml:"cors_allow_methods" toml:"cors_allow_methods" env:"CORS_ALLOW_METHODS" env-separator:"," env-default:"GET,HEAD,POST,PATCH,DELETE" reload:"true"`
+	CORSAllowHeaders  []string `yaml:"cors_allow_headers" toml:"cors_allow_headers" env:"CORS_ALLOW_HEADERS" env-separator:"," env-default:"Accept,Accept-Language,Content-Type,If-None-Match,If-Modified-Since,X-Last-Write" reload:"true"`
+	CORSExposeHeaders []string `yaml:"cors_expose_headers" toml:"cors_expose_headers" env:"CORS_EXPOSE_HEADERS" env-separator:"," env-default:"Location,ETag,Last-Modified,Retry-After,Content-Language,X-Next-Cursor,Link,X-Last-Write" reload:"true"`
+	// CORSAllowCredentials lets browsers send cookies and authorization,
+	// which needs CORSAllowOrigins without *.
+	CORSAllowCredentials bool          `yaml:"cors_allow_credentials" toml:"cors_allow_credentials" env:"CORS_ALLOW_CREDENTIALS" env-default:"false" reload:"true"`
+	CORSMaxAge           time.Duration `yaml:"cors_max_age" toml:"cors_max_age" env:"CORS_MAX_AGE" env-default:"10m" reload:"true"`
+	// HSTSMaxAge is sent in Strict-Transport-Security on HTTPS requests.
+	// Zero disables the header.
+	HSTSMaxAge time.Duration `yaml:"hsts_max_age" toml:"hsts_max_age" env:"HSTS_MAX_AGE" env-default:"8760h" reload:"true"`
+	// ContentSecurityPolicy replaces the default policy, which allows the
+	// docs page and nothing from other origins.
+	ContentSecurityPolicy string `yaml:"content_security_policy" toml:"content_security_policy" env:"CONTENT_SECURITY_POLICY" reload:"true"`
+	ReferrerPolicy        string `yaml:"referrer_policy" toml:"referrer_policy" env:"REFERRER_POLICY" env-default:"no-referrer" reload:"true"`
+	// TLSCertFile and TLSKeyFile, in PEM, make the HTTP server serve HTTPS and
+	// the gRPC server TLS. The files are read again when they change, every
+	// TLSReloadInterval.
+	TLSCertFile       string        `yaml:"tls_cert_file" toml:"tls_cert_file" env:"TLS_CERT_FILE"`
+	TLSKeyFile        string        `yaml:"tls_key_file" toml:"tls_key_file" env:"TLS_KEY_FILE"`
+	TLSReloadInterval time.Duration `yaml:"tls_reload_interval" toml:"tls_reload_interval" env:"TLS_RELOAD_INTERVAL" env-default:"1m"`
+	// TLSClientCAFile enables mTLS: clients must present a certificate
+	// signed by one of its CAs, unless TLSClientCertOptional.
+	TLSClientCAFile       string `yaml:"tls_client_ca_file" toml:"tls_client_ca_file" env:"TLS_CLIENT_CA_FILE"`
+	TLSClientCertOptional bool   `yaml:"tls_client_cert_optional" toml:"tls_client_cert_optional" env:"TLS_CLIENT_CERT_OPTIONAL" env-default:"false"`
+
+	// ShutdownTimeout bounds stopping the servers and workers on SIGINT or
+	// SIGTERM. Requests in flight past it are cut off.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" env-default:"10s"`
+
+	// AdminPort serves pprof, metrics, build info, the effective config and
+	// the maintenance switch, without authentication. AdminHost is the
+	// interface it listens on, loopback only by default; empty listens on
+	// all of them.
+	AdminHost string `yaml:"admin_host" toml:"admin_host" env:"ADMIN_HOST" env-default:"127.0.0.1"`
+	AdminPort int    `yaml:"admin_port" toml:"admin_port" env:"ADMIN_PORT" env-default:"8081"`
+
+	// TenantSource is where requests carry their tenant: none serves the
+	// default tenant only, header reads TenantHeader, jwt the TenantJWTClaim
+	// claim of a bearer token signed with TenantJWTSecret (HS256) that has
+	// an exp, subdomain the host under TenantDomain, like acme in
+	// acme.persons.example.com.
+	// gRPC calls carry them in metadata and :authority.
+	TenantSource string `yaml:"tenant_source" toml:"tenant_source" env:"TENANT_SOURCE" env-default:"none"`
+	// Tenants are the tenants served, requests of others are refused.
+	Tenants         []string `yaml:"tenants" toml:"tenants" env:"TENANTS" env-separator:","`
+	TenantHeader    string   `yaml:"tenant_header" toml:"tenant_header" env:"TENANT_HEADER" env-default:"X-Tenant-ID"`
+	TenantJWTClaim  string   `yaml:"tenant_jwt_claim" toml:"tenant_jwt_claim" env:"TENANT_JWT_CLAIM" env-default:"tenant"`
+	TenantJWTSecret string   `yaml:"tenant_jwt_secret" toml:"tenant_jwt_secret" env:"TENANT_JWT_SECRET" secret:"true"`
+	TenantDomain    string   `yaml:"tenant_domain" toml:"tenant_domain" env:"TENANT_DOMAIN"`
+	// TenantIsolation column keeps the persons of all tenants in the same
+	// tables, scoped by tenant_id. schema keeps them in a Postgres schema
+	// per tenant, tenant_<id>, migrated like the public one.
+	TenantIsolation string `yaml:"tenant_isolation" toml:"tenant_isolation" env:"TENANT_ISOLATION" env-default:"column"`
 }
 
-func New() (Config, error) {
-	var cfg Config
+// Load reads the config of the layers and validates it. args are the
+// command line flags, -config among them.
+func Load(args []string) (Config, error) {
+	fs, flags := newFlagSet()
+	fs.SetOutput(io.Discard)
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			fs.SetOutput(os.Stderr)
+			fs.PrintDefaults()
+		}
+		return Config{}, err
+	}
+	if fs.NArg() > 0 {
+		return Config{}, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
 
-	err := cleanenv.ReadEnv(&cfg)
+	path := flags.path
+	if path == "" {
+		path = os.Getenv(envConfigFile)
+	}
+
+	cfg, err := defaults()
+	if err == nil && path != "" {
+		err = readFile(path, &cfg)
+	}
+	if err == nil {
+		err = readEnv(&cfg)
+	}
 	if err != nil {
+		return Config{}, fmt.Errorf("read config: %w", err)
+	}
+
+	flags.apply(&cfg)
+	if len(cfg.CORSAllowOrigins) == 0 && cfg.AppEnv != EnvProd {
+		cfg.CORSAllowOrigins = []string{"*"}
+	}
+	if err = cfg.Validate(); err != nil {
 		return Config{}, err
 	}
+	return cfg, nil
+}
 
+// defaults returns the config of the env-default tags.
+func defaults() (Config, error) {
+	var cfg Config
+	v := reflect.ValueOf(&cfg).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		def, ok := field.Tag.Lookup("env-default")
+		if !ok {
+			continue
+		}
+		if err := parseValue(v.Field(i), def, field.Tag.Get("env-separator")); err != nil {
+			return Config{}, fmt.Errorf("parsing default of field %v: %w", field.Name, err)
+		}
+	}
 	return cfg, nil
+}
 
+// readFile decodes the file over cfg. Keys missing from the file keep their
+// value, while those present replace it even with a zero.
+func readFile(path string, cfg *Config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = cleanenv.ParseYAML(f, cfg)
+	case ".toml":
+		err = cleanenv.ParseTOML(f, cfg)
+	case ".json":
+		err = cleanenv.ParseJSON(f, cfg)
+	default:
+		return fmt.Errorf("unsupported config file format %q", ext)
+	}
+	if err != nil {
+		return fmt.Errorf("config file parsing error: %w", err)
+	}
+	return nil
+}
+
+// readEnv sets the fields whose env variables are set, even to a zero.
+func readEnv(cfg *Config) error {
+	v := reflect.ValueOf(cfg).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		env := field.Tag.Get("env")
+		if env == "" {
+			continue
+		}
+		value, ok := os.LookupEnv(env)
+		if !ok {
+			continue
+		}
+		if err := parseValue(v.Field(i), value, field.Tag.Get("env-separator")); err != nil {
+			return fmt.Errorf("parsing field %v env %v: %w", field.Name, env, err)
+		}
+	}
+	return nil
+}
+
+// Reload returns c with the fields of next that a reload applies, and the
+// env names of the fields that changed, split into those applied and those
+// that need a restart.
+func (c Config) Reload(next Config) (applied Config, reloaded, restart []string) {
+	cur, v := reflect.ValueOf(&c).Elem(), reflect.ValueOf(next)
+	for i := 0; i < v.NumField(); i++ {
+		if reflect.DeepEqual(cur.Field(i).Interface(), v.Field(i).Interface()) {
+			continue
+		}
+		field := v.Type().Field(i)
+		if field.Tag.Get("reload") != "true" {
+			restart = append(restart, field.Tag.Get("env"))
+			continue
+		}
+		cur.Field(i).Set(v.Field(i))
+		reloaded = append(reloaded, field.Tag.Get("env"))
+	}
+	return c, reloaded, restart
+}
+
+// PublicBaseURL is the address clients use to reach the HTTP API.
+func (c Config) PublicBaseURL() string {
+	if c.PublicURL != "" {
+		return strings.TrimSuffix(c.PublicURL, "/")
+	}
+	scheme := "http"
+	if c.TLSEnabled() {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://localhost:%d", scheme, c.Port)
+}
+
+// TLSEnabled reports whether the HTTP server serves HTTPS.
+func (c Config) TLSEnabled() bool {
+	return c.TLSCertFile != ""
+}
+
+// ServedTenants returns the tenants the service serves.
+func (c Config) ServedTenants() []string {
+	if c.TenantSource == "" || c.TenantSource == TenantSourceNone {
+		return []string{models.DefaultTenant}
+	}
+	return c.Tenants
+}
+
+// TenantSchema returns the Postgres schema of tenant, empty when tenants
+// share the tables.
+func (c Config) TenantSchema(tenant string) string {
+	if c.TenantIsolation != TenantIsolationSchema {
+		return ""
+	}
+	return "tenant_" + tenant
 }
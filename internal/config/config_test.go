@@ -0,0 +1,279 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad_layers(t *testing.T) {
+	yamlPath := writeFile(t, "config.yaml", `
+postgres_dsn: host=file
+port: 8001
+grpc_port: 9001
+cache_max_age: 30s
+postgres_replica_dsns: [host=r1, host=r2]
+`)
+	tomlPath := writeFile(t, "config.toml", `
+postgres_dsn = "host=file"
+replica_stickiness = "2s"
+`)
+
+	tests := []struct {
+		name   string
+		env    map[string]string
+		args   []string
+		check  func(cfg Config) bool
+		expect string
+	}{
+		{
+			name: "defaults",
+			env:  map[string]string{"POSTGRES_DSN": "host=env"},
+			check: func(cfg Config) bool {
+				return cfg.Port == 8000 && cfg.AppEnv == EnvTest && cfg.ReplicaStickiness == 5*time.Second && cfg.AdminHost == "127.0.0.1"
+			},
+			expect: "defaults applied",
+		},
+		{
+			name: "yaml file",
+			args: []string{"-config", yamlPath},
+			check: func(cfg Config) bool {
+				return cfg.PostgresDSN == "host=file" && cfg.Port == 8001 && cfg.CacheMaxAge == 30*time.Second &&
+					len(cfg.PostgresReplicaDSNs) == 2 && cfg.MaxBodySize == 1048576
+			},
+			expect: "file values and defaults for the rest",
+		},
+		{
+			name:   "toml file",
+			env:    map[string]string{"CONFIG_FILE": tomlPath},
+			check:  func(cfg Config) bool { return cfg.PostgresDSN == "host=file" && cfg.ReplicaStickiness == 2*time.Second },
+			expect: "toml file values",
+		},
+		{
+			name: "zeros in the file",
+			args: []string{"-config", writeFile(t, "zeros.yaml", `
+postgres_dsn: host=file
+hsts_max_age: 0s
+slow_query_threshold: 0s
+postgres_max_open_conns: 0
+compression_min_size: 0
+`)},
+			check: func(cfg Config) bool {
+				return cfg.HSTSMaxAge == 0 && cfg.SlowQueryThreshold == 0 && cfg.PostgresMaxOpenConns == 0 &&
+					cfg.CompressionMinSize == 0 && cfg.ReferrerPolicy == "no-referrer"
+			},
+			expect: "zeros of the file kept",
+		},
+		{
+			name:   "zeros in env",
+			env:    map[string]string{"POSTGRES_DSN": "host=env", "RATE_LIMIT_BURST": "0", "PORT": "8002"},
+			args:   []string{"-config", yamlPath},
+			check:  func(cfg Config) bool { return cfg.RateLimitBurst == 0 && cfg.Port == 8002 && cfg.GRPCPort == 9001 },
+			expect: "zeros of env kept",
+		},
+		{
+			name:   "env over file",
+			env:    map[string]string{"PORT": "8002"},
+			args:   []string{"-config", yamlPath},
+			check:  func(cfg Config) bool { return cfg.Port == 8002 && cfg.GRPCPort == 9001 },
+			expect: "env port",
+		},
+		{
+			name: "flags over env",
+			env:  map[string]string{"PORT": "8002", "POSTGRES_DSN": "host=env"},
+			args: []string{"-port", "8003", "-lenient-decoding", "-person-cache-ttl", "2m", "-postgres-replica-dsns", "host=a,host=b"},
+			check: func(cfg Config) bool {
+				return cfg.Port == 8003 && cfg.LenientDecoding && cfg.PersonCacheTTL == 2*time.Minute &&
+					len(cfg.PostgresReplicaDSNs) == 2 && cfg.PostgresDSN == "host=env"
+			},
+			expect: "flag values",
+		},
+		{
+			name:   "any origin in test",
+			env:    map[string]string{"POSTGRES_DSN": "host=env"},
+			check:  func(cfg Config) bool { return reflect.DeepEqual(cfg.CORSAllowOrigins, []string{"*"}) },
+			expect: "CORSAllowOrigins *",
+		},
+		{
+			name:   "no origin in prod",
+			env:    map[string]string{"POSTGRES_DSN": "host=env", "APP_ENV": EnvProd},
+			check:  func(cfg Config) bool { return len(cfg.CORSAllowOrigins) == 0 },
+			expect: "no CORSAllowOrigins",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			cfg, err := Load(tt.args)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if !tt.check(cfg) {
+				t.Errorf("Load() expected %s, but got %+v", tt.expect, cfg)
+			}
+		})
+	}
+}
+
+func TestLoad_invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "missing dsn",
+			expected: []string{"PostgresDSN (POSTGRES_DSN): is required"},
+		},
+		{
+			name: "invalid values",
+			env:  map[string]string{"POSTGRES_DSN": "host=env", "APP_ENV": "staging"},
+			args: []string{"-port", "70000", "-cache-max-age", "-1s"},
+			expected: []string{
+				`AppEnv (APP_ENV): must be one of dev, test, prod, got "staging"`,
+				"Port (PORT): must be between 1 and 65535, got 70000",
+				"CacheMaxAge (CACHE_MAX_AGE): must not be negative",
+			},
+		},
+		{
+			name: "credentials with any origin",
+			env:  map[string]string{"POSTGRES_DSN": "host=env", "CORS_ALLOW_CREDENTIALS": "true"},
+			args: []string{"-cors-allow-origins", "*,example.com"},
+			expected: []string{
+				"CORSAllowCredentials (CORS_ALLOW_CREDENTIALS): must be false when CORSAllowOrigins has *",
+				`CORSAllowOrigins (CORS_ALLOW_ORIGINS): must be * or origins like https://example.com, got "example.com"`,
+			},
+		},
+		{
+			name:     "proxies that are not ranges",
+			env:      map[string]string{"POSTGRES_DSN": "host=env", "TRUSTED_PROXIES": "10.0.0.0/8,10.0.0.1"},
+			expected: []string{`TrustedProxies (TRUSTED_PROXIES): must be CIDR ranges like 10.0.0.0/8, got "10.0.0.1"`},
+		},
+		{
+			name:     "admin host with a port",
+			env:      map[string]string{"POSTGRES_DSN": "host=env", "ADMIN_HOST": "0.0.0.0:8081"},
+			expected: []string{`AdminHost (ADMIN_HOST): must be an IP or a host name without a port, got "0.0.0.0:8081"`},
+		},
+		{
+			name:     "env of a wrong type",
+			env:      map[string]string{"POSTGRES_DSN": "host=env", "PORT": "eighty"},
+			expected: []string{"field Port env PORT"},
+		},
+		{
+			name:     "flag of a wrong type",
+			args:     []string{"-replica-stickiness", "soon"},
+			expected: []string{"flag -replica-stickiness"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			_, err := Load(tt.args)
+			if err == nil {
+				t.Fatalf("Load() expected an error")
+			}
+			for _, msg := range tt.expected {
+				if !strings.Contains(err.Error(), msg) {
+					t.Errorf("Load() error expected to contain %q, but got %v", msg, err)
+				}
+			}
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	cfg := Config{
+		AppEnv:           EnvProd,
+		PostgresDSN:      "host=db",
+		Port:             8000,
+		GRPCPort:         8000,
+		AdminPort:        8081,
+		MaxBodySize:      1,
+		LogLevel:         "info",
+		CORSAllowOrigins: []string{"*"},
+		TenantSource:     TenantSourceNone,
+		TenantIsolation:  TenantIsolationColumn,
+
+		ReplicaStickiness:          5 * time.Second,
+		ReplicaHealthCheckInterval: 5 * time.Second,
+		BreakerFailures:            5,
+		BreakerCooldown:            10 * time.Second,
+	}
+
+	var fieldErr *FieldError
+	if err := cfg.Validate(); !errors.As(err, &fieldErr) || fieldErr.Field != "GRPCPort" {
+		t.Errorf("Validate() expected an error of GRPCPort, but got %v", err)
+	}
+
+	cfg.GRPCPort = 9000
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() expected no error, but got %v", err)
+	}
+}
+
+func TestConfig_Reload(t *testing.T) {
+	cur := Config{Port: 8000, LogLevel: "info", CacheMaxAge: time.Minute, CORSAllowOrigins: []string{"*"}}
+	next := Config{Port: 8001, LogLevel: "debug", CacheMaxAge: time.Minute, CORSAllowOrigins: []string{"https://example.com"}}
+
+	applied, reloaded, restart := cur.Reload(next)
+	if applied.LogLevel != "debug" || applied.CORSAllowOrigins[0] != "https://example.com" {
+		t.Errorf("Reload() expected reloadable fields applied, but got %+v", applied)
+	}
+	if applied.Port != 8000 {
+		t.Errorf("Reload() expected port 8000 until a restart, but got %d", applied.Port)
+	}
+	if !reflect.DeepEqual(reloaded, []string{"LOG_LEVEL", "CORS_ALLOW_ORIGINS"}) {
+		t.Errorf("Reload() expected LOG_LEVEL and CORS_ALLOW_ORIGINS reloaded, but got %v", reloaded)
+	}
+	if !reflect.DeepEqual(restart, []string{"PORT"}) {
+		t.Errorf("Reload() expected PORT to need a restart, but got %v", restart)
+	}
+}
+
+func TestPrint(t *testing.T) {
+	cfg := Config{
+		PostgresDSN:         "host=db password=secret",
+		PostgresReplicaDSNs: []string{"host=r1 password=secret"},
+		Port:                8000,
+		CacheMaxAge:         time.Minute,
+	}
+
+	var buf bytes.Buffer
+	if err := Print(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if strings.Contains(out, "password=secret") {
+		t.Errorf("Print() expected secrets redacted, but got\n%s", out)
+	}
+	for _, line := range []string{"postgres_dsn: REDACTED", "port: 8000", "cache_max_age: 1m0s", "- REDACTED"} {
+		if !strings.Contains(out, line) {
+			t.Errorf("Print() expected %q, but got\n%s", line, out)
+		}
+	}
+	if cfg.PostgresReplicaDSNs[0] != "host=r1 password=secret" {
+		t.Errorf("Print() must not change the config")
+	}
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// flagValues are the fields given on the command line, parsed but not yet
+// applied, as the other layers are read after the flags.
+type flagValues struct {
+	path   string
+	fields map[int]reflect.Value
+}
+
+// newFlagSet makes a flag for every field of Config with an env name.
+func newFlagSet() (*flag.FlagSet, *flagValues) {
+	fs := flag.NewFlagSet("person", flag.ContinueOnError)
+	values := &flagValues{fields: make(map[int]reflect.Value)}
+	fs.StringVar(&values.path, "config", "", "config file, YAML or TOML (default $"+envConfigFile+")")
+
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		env := field.Tag.Get("env")
+		if env == "" {
+			continue
+		}
+		set := func(s string) error {
+			v := reflect.New(field.Type).Elem()
+			if err := parseValue(v, s, field.Tag.Get("env-separator")); err != nil {
+				return err
+			}
+			values.fields[i] = v
+			return nil
+		}
+		if field.Type.Kind() == reflect.Bool {
+			fs.BoolFunc(flagName(env), "overrides $"+env, set)
+			continue
+		}
+		fs.Func(flagName(env), "overrides $"+env, set)
+	}
+	return fs, values
+}
+
+func (f *flagValues) apply(cfg *Config) {
+	v := reflect.ValueOf(cfg).Elem()
+	for i, value := range f.fields {
+		v.Field(i).Set(value)
+	}
+}
+
+func flagName(env string) string {
+	return strings.ToLower(strings.ReplaceAll(env, "_", "-"))
+}
+
+func parseValue(v reflect.Value, s, separator string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if separator == "" {
+			separator = ","
+		}
+		parts := strings.Split(s, separator)
+		v.Set(reflect.MakeSlice(v.Type(), len(parts), len(parts)))
+		for i, part := range parts {
+			if err := parseValue(v.Index(i), part, ""); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"gopkg.in/yaml.v3"
+	"io"
+	"reflect"
+)
+
+const redacted = "REDACTED"
+
+// Print writes the config as YAML in the format of the config file, with
+// the values of secret fields redacted.
+func Print(w io.Writer, cfg Config) error {
+	v := reflect.ValueOf(&cfg).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Tag.Get("secret") == "true" {
+			redact(v.Field(i))
+		}
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(cfg); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func redact(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		if v.String() != "" {
+			v.SetString(redacted)
+		}
+	case reflect.Slice:
+		// The slice is shared with the config of the caller.
+		s := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(s, v)
+		for i := 0; i < s.Len(); i++ {
+			redact(s.Index(i))
+		}
+		v.Set(s)
+	}
+}
@@ -0,0 +1,138 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/charmbracelet/log"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// FieldError is a field of Config with an invalid value.
+type FieldError struct {
+	// Field is the name of the field in Config, Env its env name.
+	Field string
+	Env   string
+	Msg   string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("invalid config %s (%s): %s", e.Field, e.Env, e.Msg)
+}
+
+// Validate checks the values of the fields, and returns a FieldError for
+// each invalid one.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, field, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fieldError(field, fmt.Sprintf(format, args...)))
+		}
+	}
+	nonNegative := func(d time.Duration, field string) {
+		check(d >= 0, field, "must not be negative, got %s", d)
+	}
+	positive := func(d time.Duration, field string) {
+		check(d > 0, field, "must be positive, got %s", d)
+	}
+
+	check(slices.Contains([]string{EnvDev, EnvTest, EnvProd}, c.AppEnv), "AppEnv",
+		"must be one of %s, %s, %s, got %q", EnvDev, EnvTest, EnvProd, c.AppEnv)
+	check(c.PostgresDSN != "", "PostgresDSN", "is required")
+	check(c.Port > 0 && c.Port <= 65535, "Port", "must be between 1 and 65535, got %d", c.Port)
+	check(c.GRPCPort > 0 && c.GRPCPort <= 65535, "GRPCPort", "must be between 1 and 65535, got %d", c.GRPCPort)
+	check(c.GRPCPort != c.Port, "GRPCPort", "must differ from Port %d", c.Port)
+	check(c.AdminHost == "" || net.ParseIP(c.AdminHost) != nil || !strings.ContainsAny(c.AdminHost, ":/ "), "AdminHost",
+		"must be an IP or a host name without a port, got %q", c.AdminHost)
+	check(c.AdminPort > 0 && c.AdminPort <= 65535, "AdminPort", "must be between 1 and 65535, got %d", c.AdminPort)
+	check(c.AdminPort != c.Port && c.AdminPort != c.GRPCPort, "AdminPort", "must differ from Port %d and GRPCPort %d", c.Port, c.GRPCPort)
+	if c.PublicURL != "" {
+		u, err := url.Parse(c.PublicURL)
+		check(err == nil && u.Scheme != "" && u.Host != "", "PublicURL", "must be an absolute URL, got %q", c.PublicURL)
+	}
+	check(c.MaxBodySize > 0, "MaxBodySize", "must be positive, got %d", c.MaxBodySize)
+	check(c.CompressionMinSize >= 0, "CompressionMinSize", "must not be negative, got %d", c.CompressionMinSize)
+	nonNegative(c.CacheMaxAge, "CacheMaxAge")
+	if c.PersonCacheEnabled {
+		check(c.PersonCacheSize > 0, "PersonCacheSize", "must be positive, got %d", c.PersonCacheSize)
+		check(c.PersonCacheTTL > 0, "PersonCacheTTL", "must be positive, got %s", c.PersonCacheTTL)
+	}
+	for i, dsn := range c.PostgresReplicaDSNs {
+		check(dsn != "", "PostgresReplicaDSNs", "replica %d is empty", i)
+	}
+	positive(c.ReplicaStickiness, "ReplicaStickiness")
+	positive(c.ReplicaHealthCheckInterval, "ReplicaHealthCheckInterval")
+	nonNegative(c.PostgresConnectTimeout, "PostgresConnectTimeout")
+	check(c.PostgresMaxOpenConns >= 0, "PostgresMaxOpenConns", "must not be negative, got %d", c.PostgresMaxOpenConns)
+	check(c.PostgresMaxIdleConns >= 0, "PostgresMaxIdleConns", "must not be negative, got %d", c.PostgresMaxIdleConns)
+	nonNegative(c.PostgresConnMaxLifetime, "PostgresConnMaxLifetime")
+	nonNegative(c.PostgresConnMaxIdleTime, "PostgresConnMaxIdleTime")
+	nonNegative(c.SlowQueryThreshold, "SlowQueryThreshold")
+	check(c.BreakerFailures > 0, "BreakerFailures", "must be positive, got %d", c.BreakerFailures)
+	positive(c.BreakerCooldown, "BreakerCooldown")
+	_, err := log.ParseLevel(c.LogLevel)
+	check(err == nil, "LogLevel", "must be one of debug, info, warn, error, fatal, got %q", c.LogLevel)
+	for _, origin := range c.CORSAllowOrigins {
+		if origin == "*" {
+			check(!c.CORSAllowCredentials, "CORSAllowCredentials", "must be false when CORSAllowOrigins has *")
+			continue
+		}
+		u, err := url.Parse(origin)
+		check(err == nil && u.Scheme != "" && u.Host != "" && u.Path == "", "CORSAllowOrigins",
+			"must be * or origins like https://example.com, got %q", origin)
+	}
+	nonNegative(c.CORSMaxAge, "CORSMaxAge")
+	for _, cidr := range c.TrustedProxies {
+		_, _, err := net.ParseCIDR(cidr)
+		check(err == nil, "TrustedProxies", "must be CIDR ranges like 10.0.0.0/8, got %q", cidr)
+	}
+	nonNegative(c.HSTSMaxAge, "HSTSMaxAge")
+	check((c.TLSCertFile == "") == (c.TLSKeyFile == ""), "TLSKeyFile", "must be set together with TLSCertFile")
+	check(c.TLSClientCAFile == "" || c.TLSEnabled(), "TLSClientCAFile", "needs TLSCertFile and TLSKeyFile")
+	for _, field := range []string{"TLSCertFile", "TLSKeyFile", "TLSClientCAFile"} {
+		path := reflect.ValueOf(c).FieldByName(field).String()
+		if path == "" {
+			continue
+		}
+		_, err := os.Stat(path)
+		check(err == nil, field, "can not read %q: %v", path, err)
+	}
+	nonNegative(c.TLSReloadInterval, "TLSReloadInterval")
+	nonNegative(c.ShutdownTimeout, "ShutdownTimeout")
+	check(slices.Contains([]string{TenantSourceNone, TenantSourceHeader, TenantSourceJWT, TenantSourceSubdomain}, c.TenantSource),
+		"TenantSource", "must be one of %s, %s, %s, %s, got %q",
+		TenantSourceNone, TenantSourceHeader, TenantSourceJWT, TenantSourceSubdomain, c.TenantSource)
+	if c.TenantSource != TenantSourceNone {
+		check(len(c.Tenants) > 0, "Tenants", "are required with TenantSource %s", c.TenantSource)
+	}
+	for _, tenant := range c.Tenants {
+		check(models.ValidTenant(tenant), "Tenants",
+			"must be lower case letters, digits and _, starting with a letter, got %q", tenant)
+	}
+	switch c.TenantSource {
+	case TenantSourceHeader:
+		check(c.TenantHeader != "", "TenantHeader", "is required with TenantSource %s", c.TenantSource)
+	case TenantSourceJWT:
+		check(c.TenantJWTClaim != "", "TenantJWTClaim", "is required with TenantSource %s", c.TenantSource)
+		check(len(c.TenantJWTSecret) >= 32, "TenantJWTSecret", "must be at least 32 bytes with TenantSource %s", c.TenantSource)
+	case TenantSourceSubdomain:
+		check(c.TenantDomain != "", "TenantDomain", "is required with TenantSource %s", c.TenantSource)
+	}
+	check(slices.Contains([]string{TenantIsolationColumn, TenantIsolationSchema}, c.TenantIsolation),
+		"TenantIsolation", "must be %s or %s, got %q", TenantIsolationColumn, TenantIsolationSchema, c.TenantIsolation)
+	check(c.RateLimit >= 0, "RateLimit", "must not be negative, got %g", c.RateLimit)
+	check(c.RateLimit == 0 || c.RateLimitBurst > 0, "RateLimitBurst", "must be positive, got %d", c.RateLimitBurst)
+
+	return errors.Join(errs...)
+}
+
+func fieldError(field, msg string) *FieldError {
+	f, _ := reflect.TypeOf(Config{}).FieldByName(field)
+	return &FieldError{Field: field, Env: f.Tag.Get("env"), Msg: msg}
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// PersonChange is a person as it was last written, or the tombstone of a
+// deleted one, with only the id set and UpdatedAt at the deletion.
+type PersonChange struct {
+	Person
+	Deleted bool
+	// Version orders changes, it is assigned by the storage when the
+	// change commits, unlike UpdatedAt.
+	Version int64
+}
+
+// PersonCursor points at the last change of a page ordered by (version, id).
+type PersonCursor struct {
+	Version int64
+	ID      int32
+}
+
+func NewPersonCursor(c PersonChange) PersonCursor {
+	return PersonCursor{Version: c.Version, ID: c.ID}
+}
+
+// After reports whether c comes after the cursor.
+func (c PersonChange) After(cursor PersonCursor) bool {
+	return c.Version > cursor.Version || c.Version == cursor.Version && c.ID > cursor.ID
+}
+
+func (c PersonCursor) Encode() string {
+	raw := fmt.Sprintf("%d|%d", c.Version, c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodePersonCursor(s string) (PersonCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return PersonCursor{}, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	rawVersion, rawID, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return PersonCursor{}, ErrInvalidCursor
+	}
+
+	version, err := strconv.ParseInt(rawVersion, 10, 64)
+	if err != nil {
+		return PersonCursor{}, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	id, err := strconv.ParseInt(rawID, 10, 32)
+	if err != nil {
+		return PersonCursor{}, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	return PersonCursor{Version: version, ID: int32(id)}, nil
+}
+
+// SortPersonChanges orders changes by (version, id).
+func SortPersonChanges(changes []PersonChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[j].After(NewPersonCursor(changes[i]))
+	})
+}
@@ -0,0 +1,164 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+var ErrInvalidMerge = errors.New("invalid merge")
+
+type mergeField struct {
+	name   string
+	isZero func(p Person) bool
+	copy   func(dst *Person, src Person)
+}
+
+// mergeFields are the fields a merge can take from any of the merged persons.
+var mergeFields = []mergeField{
+	{"name", func(p Person) bool { return p.Name == "" }, func(d *Person, s Person) { d.Name = s.Name }},
+	{"age", func(p Person) bool { return p.Age == 0 }, func(d *Person, s Person) { d.Age = s.Age }},
+	{"email", func(p Person) bool { return p.Email == "" }, func(d *Person, s Person) { d.Email = s.Email }},
+	{"phone", func(p Person) bool { return p.Phone == "" }, func(d *Person, s Person) { d.Phone = s.Phone }},
+	{"birth_date", func(p Person) bool { return p.BirthDate == nil }, func(d *Person, s Person) { d.BirthDate = s.BirthDate }},
+	{"address", func(p Person) bool { return p.Address == "" }, func(d *Person, s Person) { d.Address = s.Address }},
+	{"postal_address", func(p Person) bool { return p.PostalAddress.IsZero() }, func(d *Person, s Person) { d.PostalAddress = s.PostalAddress }},
+	{"work", func(p Person) bool { return p.Work == "" }, func(d *Person, s Person) { d.Work = s.Work }},
+}
+
+// PersonMerge merges the sources into the target person. Fields maps a
+// field name to the id of the person whose value wins; other fields keep the
+// target value, or take the first non-empty source value if it is empty.
+type PersonMerge struct {
+	TargetID  int32
+	SourceIDs []int32
+	Fields    map[string]int32
+}
+
+func (m PersonMerge) IDs() []int32 {
+	return append([]int32{m.TargetID}, m.SourceIDs...)
+}
+
+func (m PersonMerge) Validate() error {
+	if m.TargetID <= 0 {
+		return fmt.Errorf("%w: target id must be positive", ErrInvalidMerge)
+	}
+	if len(m.SourceIDs) == 0 {
+		return fmt.Errorf("%w: no source ids", ErrInvalidMerge)
+	}
+
+	ids := make(map[int32]bool, len(m.SourceIDs)+1)
+	for _, id := range m.IDs() {
+		if id <= 0 {
+			return fmt.Errorf("%w: ids must be positive", ErrInvalidMerge)
+		}
+		if ids[id] {
+			return fmt.Errorf("%w: id %d is given twice", ErrInvalidMerge, id)
+		}
+		ids[id] = true
+	}
+
+	for field, id := range m.Fields {
+		if !isMergeField(field) {
+			return fmt.Errorf("%w: unknown field %q", ErrInvalidMerge, field)
+		}
+		if !ids[id] {
+			return fmt.Errorf("%w: field %q takes the value of %d, which is not merged", ErrInvalidMerge, field, id)
+		}
+	}
+	return nil
+}
+
+// Apply returns the merged person. persons must hold the target and all the
+// sources; the result keeps the target id and creation time.
+func (m PersonMerge) Apply(persons []Person) (Person, error) {
+	if err := m.Validate(); err != nil {
+		return Person{}, err
+	}
+
+	byID := make(map[int32]Person, len(persons))
+	for _, p := range persons {
+		byID[p.ID] = p
+	}
+	ordered := make([]Person, 0, len(persons))
+	for _, id := range m.IDs() {
+		p, ok := byID[id]
+		if !ok {
+			return Person{}, fmt.Errorf("%w: person %d is missing", ErrInvalidMerge, id)
+		}
+		ordered = append(ordered, p)
+	}
+
+	merged := ordered[0]
+	for _, f := range mergeFields {
+		if id, ok := m.Fields[f.name]; ok {
+			f.copy(&merged, byID[id])
+			continue
+		}
+		for _, p := range ordered {
+			if !f.isZero(p) {
+				f.copy(&merged, p)
+				break
+			}
+		}
+	}
+	return merged, nil
+}
+
+// MergeRelations points the relations of the sources at the target. It
+// returns the relations that change and the ids of those to drop: the ones
+// between merged persons, and the ones a relation kept already repeats.
+func (m PersonMerge) MergeRelations(relations []PersonRelation) ([]PersonRelation, []int32) {
+	type key struct {
+		from, to int32
+		typ      RelationType
+	}
+	remap := func(id int32) int32 {
+		if slices.Contains(m.SourceIDs, id) {
+			return m.TargetID
+		}
+		return id
+	}
+
+	// Relations that do not change are kept first.
+	sorted := slices.Clone(relations)
+	sort.Slice(sorted, func(i, j int) bool {
+		ci := remap(sorted[i].FromID) != sorted[i].FromID || remap(sorted[i].ToID) != sorted[i].ToID
+		cj := remap(sorted[j].FromID) != sorted[j].FromID || remap(sorted[j].ToID) != sorted[j].ToID
+		if ci != cj {
+			return cj
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	var changed []PersonRelation
+	var dropped []int32
+	kept := make(map[key]bool, len(sorted))
+	for _, r := range sorted {
+		from, to := remap(r.FromID), remap(r.ToID)
+		k := key{from, to, r.Type}
+		if r.Bidirectional {
+			k.from, k.to = min(from, to), max(from, to)
+		}
+		if from == to || kept[k] {
+			dropped = append(dropped, r.ID)
+			continue
+		}
+		kept[k] = true
+		if from != r.FromID || to != r.ToID {
+			r.FromID, r.ToID = from, to
+			changed = append(changed, r)
+		}
+	}
+	return changed, dropped
+}
+
+func isMergeField(name string) bool {
+	for _, f := range mergeFields {
+		if f.name == name {
+			return true
+		}
+	}
+	return false
+}
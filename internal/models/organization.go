@@ -0,0 +1,117 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var ErrInvalidEmployment = errors.New("invalid employment")
+
+// Organization is an employer of persons. Organizations are unique by
+// NormalizeOrganizationName of their name.
+type Organization struct {
+	ID        int32
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Employment links a person to an organization. It is current until
+// EndDate, or for good when EndDate is nil.
+type Employment struct {
+	ID             int32
+	PersonID       int32
+	OrganizationID int32
+	Role           string
+	StartDate      *time.Time `gorm:"type:date"`
+	EndDate        *time.Time `gorm:"type:date"`
+	CreatedAt      time.Time
+}
+
+func (e Employment) Validate() error {
+	if e.PersonID <= 0 || e.OrganizationID <= 0 {
+		return fmt.Errorf("%w: ids must be positive", ErrInvalidEmployment)
+	}
+	if e.StartDate != nil && e.EndDate != nil && e.EndDate.Before(*e.StartDate) {
+		return fmt.Errorf("%w: end_date is before start_date", ErrInvalidEmployment)
+	}
+	return nil
+}
+
+// CurrentAt reports whether the employment has not ended by t.
+func (e Employment) CurrentAt(t time.Time) bool {
+	return e.EndDate == nil || !e.EndDate.Before(Today(t))
+}
+
+// Today returns the date of t in UTC, as kept in date columns.
+func Today(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// CurrentEmployment returns the employment Person.Work is derived from: the
+// current one that started last, the latest recorded among equals.
+func CurrentEmployment(employments []Employment, now time.Time) (Employment, bool) {
+	var current Employment
+	found := false
+	for _, e := range employments {
+		if !e.CurrentAt(now) {
+			continue
+		}
+		if !found || startsAfter(e, current) {
+			current, found = e, true
+		}
+	}
+	return current, found
+}
+
+// startsAfter orders employments like "start_date desc nulls last, id desc".
+func startsAfter(a, b Employment) bool {
+	switch {
+	case a.StartDate == nil && b.StartDate == nil:
+		return a.ID > b.ID
+	case a.StartDate == nil:
+		return false
+	case b.StartDate == nil:
+		return true
+	case !a.StartDate.Equal(*b.StartDate):
+		return a.StartDate.After(*b.StartDate)
+	}
+	return a.ID > b.ID
+}
+
+// organizationLegalForms are dropped from names, so that "ООО Сбер" and
+// "Sber" are the same organization.
+var organizationLegalForms = map[string]bool{
+	"ооо": true, "оао": true, "зао": true, "пао": true, "ао": true, "ип": true,
+	"ooo": true, "llc": true, "ltd": true, "inc": true, "corp": true, "gmbh": true, "jsc": true, "pjsc": true,
+}
+
+var organizationSeparatorRe = regexp.MustCompile(`[^a-z0-9а-я]+`)
+
+// organizationTranslit transliterates Cyrillic letter by letter, so that
+// Сбер becomes sber.
+var organizationTranslit = strings.NewReplacer(
+	"ж", "zh", "х", "kh", "ц", "ts", "ч", "ch", "ш", "sh", "щ", "shch", "ю", "yu", "я", "ya", "ъ", "", "ь", "",
+	"а", "a", "б", "b", "в", "v", "г", "g", "д", "d", "е", "e", "з", "z", "и", "i", "й", "y", "к", "k",
+	"л", "l", "м", "m", "н", "n", "о", "o", "п", "p", "р", "r", "с", "s", "т", "t", "у", "u", "ф", "f",
+	"ы", "y", "э", "e",
+)
+
+// NormalizeOrganizationName returns the key organizations are unique by:
+// the words of name in lower case without punctuation and legal forms,
+// transliterated to Latin. It must match normalize_organization_name in
+// the migrations, which the database keys organizations with.
+func NormalizeOrganizationName(name string) string {
+	s := strings.ReplaceAll(strings.ToLower(name), "ё", "е")
+	words := make([]string, 0, 4)
+	for _, word := range organizationSeparatorRe.Split(s, -1) {
+		if word == "" || organizationLegalForms[word] {
+			continue
+		}
+		words = append(words, organizationTranslit.Replace(word))
+	}
+	return strings.Join(words, " ")
+}
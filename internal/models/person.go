@@ -1,9 +1,74 @@
 package models
 
+import (
+	"strings"
+	"time"
+)
+
 type Person struct {
-	ID      int32  `json:"id" validate:"omitempty"`
-	Name    string `json:"name" validate:"required"`
-	Age     int32  `json:"age" validate:"omitempty,gt=0"`
-	Address string `json:"address" validate:"omitempty"`
-	Work    string `json:"work" validate:"omitempty"`
+	ID    int32  `json:"id" validate:"omitempty"`
+	Name  string `json:"name" validate:"required,personname"`
+	Age   int32  `json:"age" validate:"omitempty,sane_age"`
+	Email string `json:"email" validate:"omitempty,email"`
+	Phone string `json:"phone" validate:"omitempty,phone"`
+	// BirthDate takes precedence over Age, which is only kept for persons
+	// created before birth dates were collected.
+	BirthDate *time.Time `json:"birth_date" gorm:"type:date" validate:"omitempty,past_date"`
+	// Address is the legacy free-text address.
+	Address       string        `json:"address" validate:"omitempty,notblank"`
+	PostalAddress PostalAddress `json:"postal_address" gorm:"embedded;embeddedPrefix:address_"`
+	Work          string        `json:"work" validate:"omitempty,notblank"`
+	CreatedAt     time.Time     `json:"-"`
+	UpdatedAt     time.Time     `json:"-"`
+}
+
+type PostalAddress struct {
+	Country    string `json:"country" validate:"omitempty,iso3166_1_alpha2"`
+	City       string `json:"city" validate:"omitempty,notblank"`
+	Street     string `json:"street" validate:"omitempty,notblank"`
+	PostalCode string `json:"postal_code" validate:"omitempty,postal_code"`
+}
+
+func (a PostalAddress) IsZero() bool {
+	return a == PostalAddress{}
+}
+
+// String formats the address on one line, most specific part first.
+func (a PostalAddress) String() string {
+	parts := make([]string, 0, 4)
+	for _, part := range []string{a.Street, a.City, a.PostalCode, a.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// CurrentAge returns the age in full years derived from BirthDate, or the
+// stored Age for persons without one.
+func (p Person) CurrentAge() int32 {
+	return p.AgeAt(time.Now())
+}
+
+func (p Person) AgeAt(t time.Time) int32 {
+	if p.BirthDate == nil {
+		return p.Age
+	}
+
+	birth := p.BirthDate.UTC()
+	t = t.UTC()
+	age := t.Year() - birth.Year()
+	if t.Month() < birth.Month() || t.Month() == birth.Month() && t.Day() < birth.Day() {
+		age--
+	}
+	return int32(age)
+}
+
+// DisplayAddress returns the legacy free-text address, falling back to the
+// structured one, so clients reading only "address" keep working.
+func (p Person) DisplayAddress() string {
+	if p.Address != "" {
+		return p.Address
+	}
+	return p.PostalAddress.String()
 }
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type PersonSortField string
+
+const (
+	PersonSortByID        PersonSortField = "id"
+	PersonSortByName      PersonSortField = "name"
+	PersonSortByAge       PersonSortField = "age"
+	PersonSortByCreatedAt PersonSortField = "created_at"
+	PersonSortByUpdatedAt PersonSortField = "updated_at"
+)
+
+func (f PersonSortField) Valid() bool {
+	switch f {
+	case PersonSortByID, PersonSortByName, PersonSortByAge, PersonSortByCreatedAt, PersonSortByUpdatedAt:
+		return true
+	}
+	return false
+}
+
+// Value returns the sort key of p as it is stored in a page cursor.
+func (f PersonSortField) Value(p Person) string {
+	switch f {
+	case PersonSortByName:
+		return p.Name
+	case PersonSortByAge:
+		return strconv.Itoa(int(p.CurrentAge()))
+	case PersonSortByCreatedAt:
+		return p.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case PersonSortByUpdatedAt:
+		return p.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	default:
+		return strconv.Itoa(int(p.ID))
+	}
+}
+
+// ParseValue converts a sort key taken from a page cursor back to the column type.
+func (f PersonSortField) ParseValue(s string) (any, error) {
+	switch f {
+	case PersonSortByName:
+		return s, nil
+	case PersonSortByID, PersonSortByAge:
+		v, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		return int32(v), nil
+	case PersonSortByCreatedAt, PersonSortByUpdatedAt:
+		return time.Parse(time.RFC3339Nano, s)
+	}
+	return nil, fmt.Errorf("unknown sort field %q", f)
+}
+
+type PersonFilter struct {
+	Name    string
+	Address string
+	Work    string
+	MinAge  int32
+	MaxAge  int32
+}
+
+// PersonPageCursor is the keyset position (sort key, id) after which a page starts.
+type PersonPageCursor struct {
+	SortBy PersonSortField
+	Value  string
+	ID     int32
+}
+
+func NewPersonPageCursor(sortBy PersonSortField, p Person) PersonPageCursor {
+	return PersonPageCursor{SortBy: sortBy, Value: sortBy.Value(p), ID: p.ID}
+}
+
+func (c PersonPageCursor) Encode() string {
+	raw := fmt.Sprintf("%s|%d|%s", c.SortBy, c.ID, c.Value)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodePersonPageCursor(s string) (PersonPageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return PersonPageCursor{}, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	parts := strings.SplitN(string(raw), "|", 3)
+	if len(parts) != 3 || !PersonSortField(parts[0]).Valid() {
+		return PersonPageCursor{}, ErrInvalidCursor
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return PersonPageCursor{}, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	c := PersonPageCursor{SortBy: PersonSortField(parts[0]), ID: int32(id), Value: parts[2]}
+	if _, err = c.SortBy.ParseValue(c.Value); err != nil {
+		return PersonPageCursor{}, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	return c, nil
+}
+
+type PersonQuery struct {
+	Filter PersonFilter
+	SortBy PersonSortField
+	Desc   bool
+	After  *PersonPageCursor
+	Limit  int
+}
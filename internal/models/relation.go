@@ -0,0 +1,201 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+)
+
+var ErrInvalidRelation = errors.New("invalid relation")
+
+// RelationType is the kind of a relation. Relations go from the first
+// person to the second: from the parent to the child, from the manager to
+// the report.
+type RelationType string
+
+const (
+	RelationSpouse  RelationType = "spouse"
+	RelationParent  RelationType = "parent"
+	RelationManager RelationType = "manager"
+)
+
+func (t RelationType) Valid() bool {
+	switch t {
+	case RelationSpouse, RelationParent, RelationManager:
+		return true
+	}
+	return false
+}
+
+// RelationDirection is which way relations are followed from a person: out
+// from the first person to the second, like from a manager to the reports,
+// in the other way round, or both.
+type RelationDirection string
+
+const (
+	RelationOut  RelationDirection = "out"
+	RelationIn   RelationDirection = "in"
+	RelationBoth RelationDirection = "both"
+)
+
+func (d RelationDirection) Valid() bool {
+	switch d {
+	case RelationOut, RelationIn, RelationBoth:
+		return true
+	}
+	return false
+}
+
+// PersonRelation is a typed edge between two persons. Bidirectional edges
+// are followed both ways whatever the direction asked for; spouses always
+// are.
+type PersonRelation struct {
+	ID            int32
+	FromID        int32
+	ToID          int32
+	Type          RelationType
+	Bidirectional bool
+	CreatedAt     time.Time
+}
+
+// Normalize makes spouse relations bidirectional.
+func (r PersonRelation) Normalize() PersonRelation {
+	if r.Type == RelationSpouse {
+		r.Bidirectional = true
+	}
+	return r
+}
+
+func (r PersonRelation) Validate() error {
+	if !r.Type.Valid() {
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidRelation, r.Type)
+	}
+	if r.FromID <= 0 || r.ToID <= 0 {
+		return fmt.Errorf("%w: ids must be positive", ErrInvalidRelation)
+	}
+	if r.FromID == r.ToID {
+		return fmt.Errorf("%w: a person can not be related to itself", ErrInvalidRelation)
+	}
+	return nil
+}
+
+// Connects reports whether the relation connects a and b, in either order.
+func (r PersonRelation) Connects(a, b int32) bool {
+	return r.FromID == a && r.ToID == b || r.FromID == b && r.ToID == a
+}
+
+// Step returns the person the relation leads to from the person with id, and
+// whether it can be followed from there in direction d.
+func (r PersonRelation) Step(id int32, d RelationDirection) (int32, bool) {
+	switch {
+	case r.FromID == id && (d != RelationIn || r.Bidirectional):
+		return r.ToID, true
+	case r.ToID == id && (d != RelationOut || r.Bidirectional):
+		return r.FromID, true
+	}
+	return 0, false
+}
+
+// RelationQuery asks for the persons related to PersonID through up to
+// Depth relations of Types, all types when it is empty.
+type RelationQuery struct {
+	PersonID  int32
+	Types     []RelationType
+	Direction RelationDirection
+	Depth     int
+}
+
+// RelatedPerson is a person found by a RelationQuery. Path holds the ids of
+// the persons from the queried one to this one, both included; RelationID
+// and Type are of the last relation on it.
+type RelatedPerson struct {
+	Person     Person
+	RelationID int32
+	Type       RelationType
+	Depth      int
+	Path       []int32
+}
+
+// SortRelated orders related persons by depth, then by id.
+func SortRelated(related []RelatedPerson) {
+	sort.Slice(related, func(i, j int) bool {
+		if related[i].Depth != related[j].Depth {
+			return related[i].Depth < related[j].Depth
+		}
+		return related[i].Person.ID < related[j].Person.ID
+	})
+}
+
+// RelationWalk walks relations breadth first, one depth per Step, so that
+// every person is found once, at the least depth, through the relation with
+// the least id among those at that depth.
+type RelationWalk struct {
+	query    RelationQuery
+	depth    int
+	paths    map[int32][]int32
+	frontier []int32
+	related  []RelatedPerson
+}
+
+func NewRelationWalk(q RelationQuery) *RelationWalk {
+	return &RelationWalk{
+		query:    q,
+		paths:    map[int32][]int32{q.PersonID: {q.PersonID}},
+		frontier: []int32{q.PersonID},
+	}
+}
+
+// Frontier returns the persons found at the last depth, whose relations the
+// next Step follows, and none once the walk is over.
+func (w *RelationWalk) Frontier() []int32 {
+	if w.depth >= w.query.Depth {
+		return nil
+	}
+	return w.frontier
+}
+
+// Step follows relations from the frontier. It takes any relations of the
+// persons, sorted by id, and skips those of other types.
+func (w *RelationWalk) Step(relations []PersonRelation) {
+	w.depth++
+	found := make(map[int32]RelatedPerson)
+	for _, r := range relations {
+		if len(w.query.Types) > 0 && !slices.Contains(w.query.Types, r.Type) {
+			continue
+		}
+		for _, from := range w.frontier {
+			to, ok := r.Step(from, w.query.Direction)
+			if _, seen := w.paths[to]; !ok || seen {
+				continue
+			}
+			if _, ok = found[to]; ok {
+				continue
+			}
+			found[to] = RelatedPerson{
+				Person:     Person{ID: to},
+				RelationID: r.ID,
+				Type:       r.Type,
+				Depth:      w.depth,
+				Path:       append(slices.Clone(w.paths[from]), to),
+			}
+		}
+	}
+
+	w.frontier = w.frontier[:0]
+	for id, p := range found {
+		w.paths[id] = p.Path
+		w.frontier = append(w.frontier, id)
+		w.related = append(w.related, p)
+	}
+	slices.Sort(w.frontier)
+}
+
+// Related returns the persons found, nearest first, with only their ids
+// set.
+func (w *RelationWalk) Related() []RelatedPerson {
+	related := append([]RelatedPerson{}, w.related...)
+	SortRelated(related)
+	return related
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Weights of the fields in PersonSimilarity. Fields empty in both persons
+// are left out, and the rest are renormalized.
+const (
+	nameSimilarityWeight    = 0.6
+	addressSimilarityWeight = 0.3
+	workSimilarityWeight    = 0.1
+)
+
+// DuplicateCandidate is a person that is likely the same as another one.
+type DuplicateCandidate struct {
+	Person Person
+	Score  float64
+}
+
+// PersonSimilarity scores how likely a and b are the same person, from 0 to 1,
+// by trigram similarity of name, address and work.
+func PersonSimilarity(a, b Person) float64 {
+	var score, total float64
+	add := func(x, y string, weight float64) {
+		if x == "" && y == "" {
+			return
+		}
+		score += weight * TrigramSimilarity(x, y)
+		total += weight
+	}
+
+	add(a.Name, b.Name, nameSimilarityWeight)
+	add(a.DisplayAddress(), b.DisplayAddress(), addressSimilarityWeight)
+	add(a.Work, b.Work, workSimilarityWeight)
+
+	if total == 0 {
+		return 0
+	}
+	return score / total
+}
+
+// TrigramSimilarity works like similarity() of the pg_trgm extension: the
+// number of trigrams the strings share divided by the number of distinct
+// trigrams in both. Words are lowercased and padded, so "Ivanov" and "ivanov,"
+// are equal.
+func TrigramSimilarity(a, b string) float64 {
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for t := range ta {
+		if tb[t] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
+
+func trigrams(s string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	res := make(map[string]bool)
+	for _, w := range words {
+		runes := []rune("  " + w + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			res[string(runes[i:i+3])] = true
+		}
+	}
+	return res
+}
+
+// RankDuplicates scores candidates against person and returns up to limit of
+// them with a score of at least minScore, best first.
+func RankDuplicates(person Person, candidates []Person, minScore float64, limit int) []DuplicateCandidate {
+	res := make([]DuplicateCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		if c.ID == person.ID {
+			continue
+		}
+		if score := PersonSimilarity(person, c); score >= minScore {
+			res = append(res, DuplicateCandidate{Person: c, Score: score})
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Score > res[j].Score })
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res
+}
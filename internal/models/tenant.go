@@ -0,0 +1,21 @@
+package models
+
+import (
+	"errors"
+	"regexp"
+)
+
+// DefaultTenant owns the persons of deployments that serve one tenant, and
+// the persons created before tenants were introduced.
+const DefaultTenant = "default"
+
+var ErrUnknownTenant = errors.New("unknown tenant")
+
+// tenantRe keeps tenant ids usable in Postgres schema names as they are.
+var tenantRe = regexp.MustCompile(`^[a-z][a-z0-9_]{0,47}$`)
+
+// ValidTenant reports whether id is a well-formed tenant id, like acme or
+// business_unit_2.
+func ValidTenant(id string) bool {
+	return tenantRe.MatchString(id)
+}
@@ -0,0 +1,107 @@
+package personctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/client"
+	"gopkg.in/yaml.v3"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+func checkFormat(format string, allowed ...string) error {
+	for _, f := range allowed {
+		if format == f {
+			return nil
+		}
+	}
+	return usageErrorf("unknown format %q, expected one of: %s", format, strings.Join(allowed, ", "))
+}
+
+func writePersons(w io.Writer, format string, persons []client.Person) error {
+	switch format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(persons)
+	case formatYAML:
+		return writeYAML(w, persons)
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tNAME\tAGE\tADDRESS\tWORK\tUPDATED")
+		for _, p := range persons {
+			if p.Deleted {
+				// Changes list deleted persons with only the id.
+				p.Name = "(deleted)"
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%d\t%s\t%s\t%s\n", p.Id, p.Name, p.Age, p.Address, p.Work, p.UpdatedAt.Format(time.RFC3339))
+		}
+		return tw.Flush()
+	}
+}
+
+func writePerson(w io.Writer, format string, p client.Person) error {
+	switch format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(p)
+	case formatYAML:
+		return writeYAML(w, p)
+	default:
+		return writePersons(w, format, []client.Person{p})
+	}
+}
+
+// writeYAML goes through JSON so field names match the API.
+func writeYAML(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var generic any
+	if err = yaml.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err = enc.Encode(generic); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func readPersonRequests(r io.Reader, format string) ([]client.PersonRequest, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read input: %w", err)
+	}
+
+	var persons []client.PersonRequest
+	switch format {
+	case formatYAML:
+		var generic any
+		if err = yaml.Unmarshal(data, &generic); err != nil {
+			return nil, usageErrorf("parse yaml input: %v", err)
+		}
+		if data, err = json.Marshal(generic); err != nil {
+			return nil, usageErrorf("parse yaml input: %v", err)
+		}
+		fallthrough
+	default:
+		if err = json.Unmarshal(data, &persons); err != nil {
+			return nil, usageErrorf("parse input: %v", err)
+		}
+	}
+	return persons, nil
+}
@@ -0,0 +1,516 @@
+// Package personctl implements the personctl command line tool.
+package personctl
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/client"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Exit codes follow the class of the HTTP status the API answered with.
+const (
+	ExitOK          = 0
+	ExitError       = 1
+	ExitUsage       = 2
+	ExitUnavailable = 3
+	ExitClientError = 4
+	ExitServerError = 5
+)
+
+const usage = `Usage: personctl [global flags] <command> [flags] [args]
+
+Commands:
+  get ID                 show one person
+  list                   list persons
+  create                 create a person
+  update ID              change the given fields of a person
+  delete ID...           delete persons
+  import                 create persons from a JSON or YAML array
+  export                 write all persons as JSON or YAML
+  profile                list, select or edit profiles
+
+Global flags:
+  -config PATH           config file (default %s)
+  -profile NAME          profile to use instead of the current one
+  -url URL               API base URL, overrides the profile
+
+Exit codes:
+  0 success, 1 error, 2 usage error, 3 API unreachable,
+  4 request rejected (HTTP 4xx), 5 server error (HTTP 5xx)
+`
+
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usageErrorf(format string, args ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+type app struct {
+	ctx        context.Context
+	stdin      io.Reader
+	stdout     io.Writer
+	configPath string
+	profiles   *profiles
+	profile    string
+	url        string
+}
+
+// Run executes personctl with args (without the program name) and returns
+// the process exit code.
+func Run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	a := &app{ctx: ctx, stdin: stdin, stdout: stdout}
+
+	fs := flag.NewFlagSet("personctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprintf(stderr, usage, defaultConfigPath()) }
+	fs.StringVar(&a.configPath, "config", defaultConfigPath(), "config file")
+	fs.StringVar(&a.profile, "profile", os.Getenv("PERSONCTL_PROFILE"), "profile name")
+	fs.StringVar(&a.url, "url", os.Getenv("PERSONCTL_URL"), "API base URL")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return ExitUsage
+	}
+
+	err := a.run(fs.Arg(0), fs.Args()[1:], stderr)
+	if err == nil {
+		return ExitOK
+	}
+	if errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	}
+
+	fmt.Fprintf(stderr, "personctl: %v\n", err)
+	return exitCode(err)
+}
+
+func exitCode(err error) int {
+	var uerr *usageError
+	if errors.As(err, &uerr) {
+		return ExitUsage
+	}
+
+	status := 0
+	var aerr *client.APIError
+	var verr *client.ValidationError
+	switch {
+	case errors.As(err, &aerr):
+		status = aerr.StatusCode
+	case errors.As(err, &verr):
+		status = verr.StatusCode
+	}
+	switch {
+	case status >= http.StatusInternalServerError:
+		return ExitServerError
+	case status >= http.StatusBadRequest:
+		return ExitClientError
+	}
+
+	var nerr net.Error
+	if errors.As(err, &nerr) || errors.Is(err, context.DeadlineExceeded) {
+		return ExitUnavailable
+	}
+	return ExitError
+}
+
+func (a *app) run(cmd string, args []string, stderr io.Writer) error {
+	var err error
+	a.profiles, err = loadProfiles(a.configPath)
+	if err != nil {
+		return err
+	}
+
+	commands := map[string]func(*flag.FlagSet, []string) error{
+		"get":     a.get,
+		"list":    a.list,
+		"create":  a.create,
+		"update":  a.update,
+		"delete":  a.delete,
+		"import":  a.importPersons,
+		"export":  a.exportPersons,
+		"profile": a.profileCmd,
+	}
+	run, ok := commands[cmd]
+	if !ok {
+		return usageErrorf("unknown command %q, run personctl -h for help", cmd)
+	}
+
+	fs := flag.NewFlagSet("personctl "+cmd, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	return run(fs, args)
+}
+
+func (a *app) client() (*client.Client, error) {
+	pr, err := a.profiles.get(a.profile)
+	if err != nil {
+		return nil, err
+	}
+	if a.url != "" {
+		pr.URL = a.url
+	}
+
+	var opts []client.Option
+	if pr.Timeout > 0 {
+		opts = append(opts, client.WithHTTPClient(&http.Client{Timeout: pr.Timeout}))
+	}
+	if pr.Retries > 0 {
+		opts = append(opts, client.WithRetries(pr.Retries, 100*time.Millisecond, 2*time.Second))
+	}
+
+	c, err := client.New(pr.URL, opts...)
+	if err != nil {
+		return nil, usageErrorf("%v", err)
+	}
+	return c, nil
+}
+
+func parse(fs *flag.FlagSet, args []string, nargs int, argsUsage string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return usageErrorf("%v", err)
+	}
+	if nargs >= 0 && fs.NArg() != nargs || nargs < 0 && fs.NArg() == 0 {
+		return usageErrorf("usage: %s %s", fs.Name(), argsUsage)
+	}
+	return nil
+}
+
+func parseID(raw string) (int32, error) {
+	id, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || id <= 0 {
+		return 0, usageErrorf("bad id %q", raw)
+	}
+	return int32(id), nil
+}
+
+func outputFlag(fs *flag.FlagSet) *string {
+	return fs.String("o", formatTable, "output format: table, json or yaml")
+}
+
+func (a *app) get(fs *flag.FlagSet, args []string) error {
+	output := outputFlag(fs)
+	if err := parse(fs, args, 1, "[-o format] ID"); err != nil {
+		return err
+	}
+	if err := checkFormat(*output, formatTable, formatJSON, formatYAML); err != nil {
+		return err
+	}
+	id, err := parseID(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	p, err := c.GetPerson(a.ctx, id)
+	if err != nil {
+		return err
+	}
+	return writePerson(a.stdout, *output, p)
+}
+
+func (a *app) list(fs *flag.FlagSet, args []string) error {
+	output := outputFlag(fs)
+	updatedSince := fs.String("updated-since", "", "only persons changed or deleted at or after this RFC 3339 time")
+	if err := parse(fs, args, 0, "[-o format] [-updated-since time]"); err != nil {
+		return err
+	}
+	if err := checkFormat(*output, formatTable, formatJSON, formatYAML); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	if *updatedSince == "" {
+		persons, err := c.ListPersons(a.ctx)
+		if err != nil {
+			return err
+		}
+		return writePersons(a.stdout, *output, persons)
+	}
+
+	since, err := time.Parse(time.RFC3339Nano, *updatedSince)
+	if err != nil {
+		return usageErrorf("bad -updated-since: %v", err)
+	}
+
+	persons := []client.Person{}
+	it := c.ListChanges(a.ctx, since, 0)
+	for it.Next() {
+		persons = append(persons, it.Person())
+	}
+	if err = it.Err(); err != nil {
+		return err
+	}
+	return writePersons(a.stdout, *output, persons)
+}
+
+func personFlags(fs *flag.FlagSet) *client.PersonRequest {
+	var req client.PersonRequest
+	fs.StringVar(&req.Name, "name", "", "name")
+	fs.Func("age", "age", func(s string) error {
+		age, err := strconv.ParseInt(s, 10, 32)
+		req.Age = int32(age)
+		return err
+	})
+	fs.StringVar(&req.Email, "email", "", "email")
+	fs.StringVar(&req.Phone, "phone", "", "phone")
+	fs.Func("birth-date", "birth date, YYYY-MM-DD", func(s string) error {
+		birthDate, err := time.Parse(time.DateOnly, s)
+		req.BirthDate = &openapi_types.Date{Time: birthDate}
+		return err
+	})
+	fs.StringVar(&req.Address, "address", "", "address")
+	fs.StringVar(&req.Work, "work", "", "work")
+	return &req
+}
+
+func (a *app) create(fs *flag.FlagSet, args []string) error {
+	output := outputFlag(fs)
+	req := personFlags(fs)
+	if err := parse(fs, args, 0, "-name NAME [-age N] [-birth-date D] [-email E] [-phone P] [-address A] [-work W] [-o format]"); err != nil {
+		return err
+	}
+	if err := checkFormat(*output, formatTable, formatJSON, formatYAML); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	id, err := c.CreatePerson(a.ctx, *req)
+	if err != nil {
+		return err
+	}
+	p, err := c.GetPerson(a.ctx, id)
+	if err != nil {
+		return err
+	}
+	return writePerson(a.stdout, *output, p)
+}
+
+func (a *app) update(fs *flag.FlagSet, args []string) error {
+	output := outputFlag(fs)
+	req := personFlags(fs)
+	if err := parse(fs, args, 1, "[-name NAME] [-age N] [-birth-date D] [-email E] [-phone P] [-address A] [-work W] [-o format] ID"); err != nil {
+		return err
+	}
+	if err := checkFormat(*output, formatTable, formatJSON, formatYAML); err != nil {
+		return err
+	}
+	id, err := parseID(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	if req.Name == "" {
+		current, err := c.GetPerson(a.ctx, id)
+		if err != nil {
+			return err
+		}
+		req.Name = current.Name
+	}
+
+	p, err := c.UpdatePerson(a.ctx, id, *req)
+	if err != nil {
+		return err
+	}
+	return writePerson(a.stdout, *output, p)
+}
+
+func (a *app) delete(fs *flag.FlagSet, args []string) error {
+	if err := parse(fs, args, -1, "ID..."); err != nil {
+		return err
+	}
+
+	ids := make([]int32, 0, fs.NArg())
+	for _, raw := range fs.Args() {
+		id, err := parseID(raw)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err = c.DeletePerson(a.ctx, id); err != nil {
+			return fmt.Errorf("delete %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func formatFromPath(path, format string) string {
+	if format != "" {
+		return format
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return formatYAML
+	}
+	return formatJSON
+}
+
+func (a *app) importPersons(fs *flag.FlagSet, args []string) error {
+	file := fs.String("f", "-", "input file, - for stdin")
+	format := fs.String("format", "", "input format: json or yaml (default from file extension)")
+	if err := parse(fs, args, 0, "[-f file] [-format json|yaml]"); err != nil {
+		return err
+	}
+	*format = formatFromPath(*file, *format)
+	if err := checkFormat(*format, formatJSON, formatYAML); err != nil {
+		return err
+	}
+
+	in := a.stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return usageErrorf("%v", err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	reqs, err := readPersonRequests(in, *format)
+	if err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	for i, req := range reqs {
+		id, err := c.CreatePerson(a.ctx, req)
+		if err != nil {
+			return fmt.Errorf("import item %d (%d imported): %w", i, i, err)
+		}
+		fmt.Fprintln(a.stdout, id)
+	}
+	return nil
+}
+
+func (a *app) exportPersons(fs *flag.FlagSet, args []string) error {
+	file := fs.String("f", "-", "output file, - for stdout")
+	format := fs.String("format", "", "output format: json or yaml (default from file extension)")
+	if err := parse(fs, args, 0, "[-f file] [-format json|yaml]"); err != nil {
+		return err
+	}
+	*format = formatFromPath(*file, *format)
+	if err := checkFormat(*format, formatJSON, formatYAML); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	persons, err := c.ListPersons(a.ctx)
+	if err != nil {
+		return err
+	}
+
+	out := a.stdout
+	if *file != "-" {
+		f, err := os.Create(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	return writePersons(out, *format, persons)
+}
+
+func (a *app) profileCmd(fs *flag.FlagSet, args []string) error {
+	const profileUsage = "list | use NAME | set NAME -url URL [-timeout D] [-retries N] | delete NAME"
+	if len(args) == 0 {
+		return usageErrorf("usage: %s %s", fs.Name(), profileUsage)
+	}
+
+	switch args[0] {
+	case "list":
+		for _, name := range a.profiles.names() {
+			marker := " "
+			if name == a.profiles.Current {
+				marker = "*"
+			}
+			fmt.Fprintf(a.stdout, "%s %s\t%s\n", marker, name, a.profiles.Profiles[name].URL)
+		}
+		return nil
+	case "use":
+		if len(args) != 2 {
+			return usageErrorf("usage: %s use NAME", fs.Name())
+		}
+		if _, err := a.profiles.get(args[1]); err != nil {
+			return err
+		}
+		a.profiles.Current = args[1]
+	case "set":
+		var pr profile
+		fs.StringVar(&pr.URL, "url", "", "API base URL")
+		fs.DurationVar(&pr.Timeout, "timeout", 0, "request timeout")
+		fs.IntVar(&pr.Retries, "retries", 0, "retries of idempotent requests")
+		if len(args) < 2 {
+			return usageErrorf("usage: %s set NAME -url URL", fs.Name())
+		}
+		if err := parse(fs, args[2:], 0, "set NAME -url URL"); err != nil {
+			return err
+		}
+		if pr.URL == "" {
+			return usageErrorf("-url is required")
+		}
+		a.profiles.Profiles[args[1]] = pr
+	case "delete":
+		if len(args) != 2 {
+			return usageErrorf("usage: %s delete NAME", fs.Name())
+		}
+		if args[1] == a.profiles.Current {
+			return usageErrorf("can not delete the current profile %q", args[1])
+		}
+		delete(a.profiles.Profiles, args[1])
+	default:
+		return usageErrorf("usage: %s %s", fs.Name(), profileUsage)
+	}
+
+	return a.profiles.save(a.configPath)
+}
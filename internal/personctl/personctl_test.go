@@ -0,0 +1,205 @@
+package personctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/memory"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/server"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testEnv struct {
+	t      *testing.T
+	url    string
+	config string
+}
+
+func newTestEnv(t *testing.T, handler http.Handler) *testEnv {
+	t.Helper()
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	return &testEnv{t: t, url: ts.URL, config: filepath.Join(t.TempDir(), "config.yaml")}
+}
+
+func newServer(t *testing.T, pr server.PersonRepository) *server.Server {
+	t.Helper()
+
+	s, err := server.New(config.Config{}, pr)
+	if err != nil {
+		t.Fatalf("server.New() error = %v", err)
+	}
+	return s
+}
+
+func (e *testEnv) run(stdin string, args ...string) (int, string, string) {
+	e.t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"-config", e.config, "-url", e.url}, args...)
+	code := Run(context.Background(), args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_CRUD(t *testing.T) {
+	e := newTestEnv(t, newServer(t, memory.NewStorage()))
+
+	code, out, errOut := e.run("", "create", "-name", "test", "-age", "20", "-o", "json")
+	if code != ExitOK {
+		t.Fatalf("create exit code %d, stderr: %s", code, errOut)
+	}
+	var created map[string]any
+	if err := json.Unmarshal([]byte(out), &created); err != nil {
+		t.Fatalf("create output is not json: %v\n%s", err, out)
+	}
+	if created["name"] != "test" || created["age"] != float64(20) {
+		t.Errorf("create output %v", created)
+	}
+
+	code, out, errOut = e.run("", "update", "-work", "bmstu", "1")
+	if code != ExitOK {
+		t.Fatalf("update exit code %d, stderr: %s", code, errOut)
+	}
+	if !strings.Contains(out, "bmstu") || !strings.Contains(out, "test") {
+		t.Errorf("update output %q", out)
+	}
+
+	code, out, _ = e.run("", "get", "-o", "yaml", "1")
+	if code != ExitOK || !strings.Contains(out, "work: bmstu") {
+		t.Errorf("get exit code %d, output %q", code, out)
+	}
+
+	code, out, _ = e.run("", "list")
+	if code != ExitOK || !strings.HasPrefix(out, "ID") || strings.Count(out, "\n") != 2 {
+		t.Errorf("list exit code %d, output %q", code, out)
+	}
+
+	if code, _, errOut = e.run("", "delete", "1"); code != ExitOK {
+		t.Fatalf("delete exit code %d, stderr: %s", code, errOut)
+	}
+
+	code, _, errOut = e.run("", "get", "1")
+	if code != ExitClientError || !strings.Contains(errOut, "person not found") {
+		t.Errorf("get after delete exit code %d, stderr: %s", code, errOut)
+	}
+}
+
+func TestRun_importExport(t *testing.T) {
+	e := newTestEnv(t, newServer(t, memory.NewStorage()))
+
+	input := "- name: a\n  age: 1\n- name: b\n  work: w\n"
+	code, out, errOut := e.run(input, "import", "-format", "yaml")
+	if code != ExitOK {
+		t.Fatalf("import exit code %d, stderr: %s", code, errOut)
+	}
+	if out != "1\n2\n" {
+		t.Errorf("import output %q", out)
+	}
+
+	file := filepath.Join(t.TempDir(), "persons.json")
+	if code, _, errOut = e.run("", "export", "-f", file); code != ExitOK {
+		t.Fatalf("export exit code %d, stderr: %s", code, errOut)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var exported []map[string]any
+	if err = json.Unmarshal(data, &exported); err != nil {
+		t.Fatalf("export output is not json: %v", err)
+	}
+	if len(exported) != 2 || exported[0]["name"] != "a" || exported[1]["work"] != "w" {
+		t.Errorf("export output %v", exported)
+	}
+
+	// Exported files can be imported back as is.
+	if code, _, errOut = e.run("", "import", "-f", file); code != ExitOK {
+		t.Fatalf("re-import exit code %d, stderr: %s", code, errOut)
+	}
+	if _, out, _ = e.run("", "list", "-o", "json"); strings.Count(out, `"id"`) != 4 {
+		t.Errorf("list after re-import output %q", out)
+	}
+}
+
+func TestRun_exitCodes(t *testing.T) {
+	failing := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	tests := []struct {
+		name     string
+		handler  http.Handler
+		url      string
+		args     []string
+		expected int
+	}{
+		{name: "unknown command", args: []string{"nope"}, expected: ExitUsage},
+		{name: "bad id", args: []string{"get", "abc"}, expected: ExitUsage},
+		{name: "bad format", args: []string{"list", "-o", "xml"}, expected: ExitUsage},
+		{name: "validation error", args: []string{"create", "-name", "x", "-age", "-1"}, expected: ExitClientError},
+		{name: "server error", handler: failing, args: []string{"list"}, expected: ExitServerError},
+		{name: "unreachable", url: unreachable.URL, args: []string{"list"}, expected: ExitUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := tt.handler
+			if handler == nil {
+				handler = newServer(t, memory.NewStorage())
+			}
+			e := newTestEnv(t, handler)
+			if tt.url != "" {
+				e.url = tt.url
+			}
+
+			if code, _, errOut := e.run("", tt.args...); code != tt.expected {
+				t.Errorf("expected exit code %d, but got %d, stderr: %s", tt.expected, code, errOut)
+			}
+		})
+	}
+}
+
+func TestRun_profiles(t *testing.T) {
+	ts := httptest.NewServer(newServer(t, memory.NewStorage()))
+	t.Cleanup(ts.Close)
+	cfg := filepath.Join(t.TempDir(), "config.yaml")
+
+	run := func(args ...string) (int, string) {
+		var stdout, stderr bytes.Buffer
+		code := Run(context.Background(), append([]string{"-config", cfg}, args...), nil, &stdout, &stderr)
+		return code, stdout.String() + stderr.String()
+	}
+
+	if code, out := run("profile", "set", "test", "-url", ts.URL, "-retries", "2"); code != ExitOK {
+		t.Fatalf("profile set exit code %d: %s", code, out)
+	}
+	if code, out := run("profile", "use", "test"); code != ExitOK {
+		t.Fatalf("profile use exit code %d: %s", code, out)
+	}
+
+	code, out := run("profile", "list")
+	if code != ExitOK || !strings.Contains(out, "* test") || !strings.Contains(out, "  local") {
+		t.Errorf("profile list exit code %d, output %q", code, out)
+	}
+
+	if code, out = run("list"); code != ExitOK {
+		t.Errorf("list with profile exit code %d: %s", code, out)
+	}
+	if code, _ = run("-profile", "missing", "list"); code != ExitUsage {
+		t.Errorf("list with unknown profile expected exit code %d, but got %d", ExitUsage, code)
+	}
+	if code, _ = run("profile", "delete", "test"); code != ExitUsage {
+		t.Errorf("deleting the current profile expected exit code %d, but got %d", ExitUsage, code)
+	}
+}
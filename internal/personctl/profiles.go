@@ -0,0 +1,103 @@
+package personctl
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const (
+	defaultProfile = "local"
+	defaultURL     = "http://localhost:8000"
+)
+
+type profile struct {
+	URL     string        `yaml:"url"`
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+	Retries int           `yaml:"retries,omitempty"`
+}
+
+// profiles is the personctl config file, by default
+// $XDG_CONFIG_HOME/personctl/config.yaml:
+//
+//	current: prod
+//	profiles:
+//	  local:
+//	    url: http://localhost:8000
+//	  prod:
+//	    url: https://persons.example.com
+//	    timeout: 5s
+//	    retries: 5
+type profiles struct {
+	Current  string             `yaml:"current"`
+	Profiles map[string]profile `yaml:"profiles"`
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "personctl.yaml"
+	}
+	return filepath.Join(dir, "personctl", "config.yaml")
+}
+
+func loadProfiles(path string) (*profiles, error) {
+	p := &profiles{
+		Current:  defaultProfile,
+		Profiles: map[string]profile{defaultProfile: {URL: defaultURL}},
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read config %s: %w", path, err)
+	}
+
+	if err = yaml.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if p.Profiles == nil {
+		p.Profiles = map[string]profile{}
+	}
+	return p, nil
+}
+
+func (p *profiles) save(path string) error {
+	data, err := yaml.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	if err = os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("write config %s: %w", path, err)
+	}
+	return nil
+}
+
+func (p *profiles) get(name string) (profile, error) {
+	if name == "" {
+		name = p.Current
+	}
+	pr, ok := p.Profiles[name]
+	if !ok {
+		return profile{}, usageErrorf("unknown profile %q", name)
+	}
+	return pr, nil
+}
+
+func (p *profiles) names() []string {
+	names := make([]string, 0, len(p.Profiles))
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,146 @@
+// Package breaker is a circuit breaker in front of a person storage. While
+// the database is down it fails calls fast instead of letting every request
+// wait for a connection timeout.
+package breaker
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgconn"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultFailures = 5
+	defaultCooldown = 10 * time.Second
+)
+
+// ErrOpen is returned without calling the database while the breaker is
+// open.
+var ErrOpen = errors.New("circuit breaker is open")
+
+// UnavailableError is an error of a call made while the database is down.
+type UnavailableError struct {
+	// RetryAfter is when the breaker lets calls through again.
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("database is unavailable: %v", e.Err)
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+type state int
+
+const (
+	closed state = iota
+	open
+	// halfOpen lets a single call through to check whether the database is
+	// back.
+	halfOpen
+)
+
+// Breaker opens after failures outage errors in a row and stays open for
+// cooldown. Errors of the database that answered, like constraint
+// violations, are not outages.
+type Breaker struct {
+	failures int
+	cooldown time.Duration
+
+	mu       sync.Mutex
+	state    state
+	failed   int
+	openedAt time.Time
+	now      func() time.Time
+}
+
+func New(failures int, cooldown time.Duration) *Breaker {
+	if failures <= 0 {
+		failures = defaultFailures
+	}
+	if cooldown <= 0 {
+		cooldown = defaultCooldown
+	}
+	return &Breaker{failures: failures, cooldown: cooldown, now: time.Now}
+}
+
+func (b *Breaker) Do(f func() error) error {
+	if retryAfter, ok := b.allow(); !ok {
+		return &UnavailableError{RetryAfter: retryAfter, Err: ErrOpen}
+	}
+
+	err := f()
+	if !outage(err) {
+		b.succeeded()
+		return err
+	}
+	return &UnavailableError{RetryAfter: b.fail(), Err: err}
+}
+
+func (b *Breaker) allow() (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case open:
+		elapsed := b.now().Sub(b.openedAt)
+		if elapsed < b.cooldown {
+			return b.cooldown - elapsed, false
+		}
+		b.state = halfOpen
+		return 0, true
+	case halfOpen:
+		// The probe is still running.
+		return b.cooldown, false
+	default:
+		return 0, true
+	}
+}
+
+func (b *Breaker) succeeded() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state, b.failed = closed, 0
+}
+
+func (b *Breaker) fail() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failed++
+	if b.state == halfOpen || b.failed >= b.failures {
+		b.state, b.openedAt = open, b.now()
+	}
+	return b.cooldown
+}
+
+// outage reports whether err means the database could not be reached, as
+// opposed to an error of a query it answered.
+func outage(err error) bool {
+	if err == nil {
+		return false
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Connection exceptions, shutdowns and too many connections.
+		return strings.HasPrefix(pgErr.Code, "08") ||
+			pgErr.Code == "57P01" || pgErr.Code == "57P02" || pgErr.Code == "57P03" ||
+			pgErr.Code == "53300"
+	}
+	var netErr net.Error
+	var connectErr *pgconn.ConnectError
+	return errors.As(err, &netErr) || errors.As(err, &connectErr) ||
+		errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,109 @@
+package breaker
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/memory"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	b := New(2, 10*time.Second)
+	b.now = func() time.Time { return now }
+
+	calls := 0
+	down := func() error {
+		calls++
+		return &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	}
+	up := func() error {
+		calls++
+		return nil
+	}
+
+	for i := 0; i < 2; i++ {
+		var unavailable *UnavailableError
+		if err := b.Do(down); !errors.As(err, &unavailable) || errors.Is(err, ErrOpen) {
+			t.Fatalf("Do() of a failing call expected an outage, but got %v", err)
+		}
+	}
+
+	now = now.Add(4 * time.Second)
+	var unavailable *UnavailableError
+	err := b.Do(up)
+	if !errors.As(err, &unavailable) || !errors.Is(err, ErrOpen) {
+		t.Fatalf("Do() expected %v, but got %v", ErrOpen, err)
+	}
+	if unavailable.RetryAfter != 6*time.Second {
+		t.Errorf("RetryAfter expected 6s, but got %v", unavailable.RetryAfter)
+	}
+	if calls != 2 {
+		t.Errorf("expected no calls while the breaker is open, but got %d", calls-2)
+	}
+
+	// A failed probe opens the breaker again right away.
+	now = now.Add(6 * time.Second)
+	if err = b.Do(down); errors.Is(err, ErrOpen) {
+		t.Fatalf("Do() after the cooldown expected a probe, but got %v", err)
+	}
+	if err = b.Do(up); !errors.Is(err, ErrOpen) {
+		t.Errorf("Do() after a failed probe expected %v, but got %v", ErrOpen, err)
+	}
+
+	now = now.Add(10 * time.Second)
+	for i := 0; i < 2; i++ {
+		if err = b.Do(up); err != nil {
+			t.Errorf("Do() after a successful probe expected no error, but got %v", err)
+		}
+	}
+}
+
+func TestOutage(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "no error", err: nil, expected: false},
+		{name: "not found", err: gorm.ErrRecordNotFound, expected: false},
+		{name: "duplicate", err: fmt.Errorf("error creating person: %w", gorm.ErrDuplicatedKey), expected: false},
+		{name: "syntax error", err: &pgconn.PgError{Code: "42601"}, expected: false},
+		{name: "admin shutdown", err: &pgconn.PgError{Code: "57P01"}, expected: true},
+		{name: "connection failure", err: &pgconn.PgError{Code: "08006"}, expected: true},
+		{name: "network", err: fmt.Errorf("error getting person: %w", &net.OpError{Op: "read"}), expected: true},
+		{name: "bad connection", err: driver.ErrBadConn, expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := outage(tt.err); got != tt.expected {
+				t.Errorf("outage(%v) expected %v, but got %v", tt.err, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestStorage(t *testing.T) {
+	b := New(1, time.Minute)
+	next := memory.NewStorage()
+	s := NewStorage(next, b)
+
+	person, err := s.CreatePerson(models.Person{Name: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.GetPersonByID(person.ID + 1); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetPersonByID() of a missing person expected %v, but got %v", gorm.ErrRecordNotFound, err)
+	}
+
+	b.fail()
+	if _, err = s.GetPersonByID(person.ID); !errors.Is(err, ErrOpen) {
+		t.Errorf("GetPersonByID() expected %v, but got %v", ErrOpen, err)
+	}
+}
@@ -0,0 +1,182 @@
+package breaker
+
+import (
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"time"
+)
+
+type storage struct {
+	next    repositories.Storage
+	breaker *Breaker
+}
+
+func NewStorage(next repositories.Storage, breaker *Breaker) *storage {
+	return &storage{next: next, breaker: breaker}
+}
+
+func (s *storage) Session(lastWrite time.Time) repositories.Storage {
+	return &storage{next: s.next.Session(lastWrite), breaker: s.breaker}
+}
+
+func call[T any](b *Breaker, f func() (T, error)) (T, error) {
+	var res T
+	err := b.Do(func() error {
+		var err error
+		res, err = f()
+		return err
+	})
+	return res, err
+}
+
+func (s *storage) GetAllPerson() ([]models.Person, error) {
+	return call(s.breaker, s.next.GetAllPerson)
+}
+
+func (s *storage) CreatePerson(person models.Person) (models.Person, error) {
+	return call(s.breaker, func() (models.Person, error) {
+		return s.next.CreatePerson(person)
+	})
+}
+
+func (s *storage) CreatePersons(persons []models.Person) ([]models.Person, error) {
+	return call(s.breaker, func() ([]models.Person, error) {
+		return s.next.CreatePersons(persons)
+	})
+}
+
+func (s *storage) GetPersonByID(id int32) (models.Person, error) {
+	return call(s.breaker, func() (models.Person, error) {
+		return s.next.GetPersonByID(id)
+	})
+}
+
+func (s *storage) GetPersonsByIDs(ids []int32) ([]models.Person, error) {
+	return call(s.breaker, func() ([]models.Person, error) {
+		return s.next.GetPersonsByIDs(ids)
+	})
+}
+
+func (s *storage) ListPersons(query models.PersonQuery) ([]models.Person, error) {
+	return call(s.breaker, func() ([]models.Person, error) {
+		return s.next.ListPersons(query)
+	})
+}
+
+func (s *storage) DeletePersonByID(id int32) error {
+	return s.breaker.Do(func() error {
+		return s.next.DeletePersonByID(id)
+	})
+}
+
+func (s *storage) UpdatePersonByID(id int32, person models.Person) error {
+	return s.breaker.Do(func() error {
+		return s.next.UpdatePersonByID(id, person)
+	})
+}
+
+func (s *storage) GetPersonsUpdatedSince(since time.Time, after *models.PersonCursor, limit int) ([]models.PersonChange, error) {
+	return call(s.breaker, func() ([]models.PersonChange, error) {
+		return s.next.GetPersonsUpdatedSince(since, after, limit)
+	})
+}
+
+func (s *storage) GetDuplicateCandidates(person models.Person, limit int) ([]models.Person, error) {
+	return call(s.breaker, func() ([]models.Person, error) {
+		return s.next.GetDuplicateCandidates(person, limit)
+	})
+}
+
+func (s *storage) MergePersons(merge models.PersonMerge) (models.Person, error) {
+	return call(s.breaker, func() (models.Person, error) {
+		return s.next.MergePersons(merge)
+	})
+}
+
+func (s *storage) GetMergedPersonID(id int32) (int32, error) {
+	return call(s.breaker, func() (int32, error) {
+		return s.next.GetMergedPersonID(id)
+	})
+}
+
+func (s *storage) CreateRelation(relation models.PersonRelation) (models.PersonRelation, error) {
+	return call(s.breaker, func() (models.PersonRelation, error) {
+		return s.next.CreateRelation(relation)
+	})
+}
+
+func (s *storage) DeleteRelation(personID, relationID int32) error {
+	return s.breaker.Do(func() error {
+		return s.next.DeleteRelation(personID, relationID)
+	})
+}
+
+func (s *storage) GetRelatedPersons(query models.RelationQuery) ([]models.RelatedPerson, error) {
+	return call(s.breaker, func() ([]models.RelatedPerson, error) {
+		return s.next.GetRelatedPersons(query)
+	})
+}
+
+func (s *storage) ListOrganizations(name string) ([]models.Organization, error) {
+	return call(s.breaker, func() ([]models.Organization, error) {
+		return s.next.ListOrganizations(name)
+	})
+}
+
+func (s *storage) CreateOrganization(organization models.Organization) (models.Organization, error) {
+	return call(s.breaker, func() (models.Organization, error) {
+		return s.next.CreateOrganization(organization)
+	})
+}
+
+func (s *storage) GetOrganizationByID(id int32) (models.Organization, error) {
+	return call(s.breaker, func() (models.Organization, error) {
+		return s.next.GetOrganizationByID(id)
+	})
+}
+
+func (s *storage) UpdateOrganizationByID(id int32, organization models.Organization) error {
+	return s.breaker.Do(func() error {
+		return s.next.UpdateOrganizationByID(id, organization)
+	})
+}
+
+func (s *storage) DeleteOrganizationByID(id int32) error {
+	return s.breaker.Do(func() error {
+		return s.next.DeleteOrganizationByID(id)
+	})
+}
+
+func (s *storage) GetEmployments(personID int32) ([]models.Employment, error) {
+	return call(s.breaker, func() ([]models.Employment, error) {
+		return s.next.GetEmployments(personID)
+	})
+}
+
+func (s *storage) GetOrganizationEmployments(organizationID int32) ([]models.Employment, error) {
+	return call(s.breaker, func() ([]models.Employment, error) {
+		return s.next.GetOrganizationEmployments(organizationID)
+	})
+}
+
+func (s *storage) CreateEmployment(employment models.Employment) (models.Employment, error) {
+	return call(s.breaker, func() (models.Employment, error) {
+		return s.next.CreateEmployment(employment)
+	})
+}
+
+func (s *storage) UpdateEmployment(personID, employmentID int32, employment models.Employment) error {
+	return s.breaker.Do(func() error {
+		return s.next.UpdateEmployment(personID, employmentID, employment)
+	})
+}
+
+func (s *storage) DeleteEmployment(personID, employmentID int32) error {
+	return s.breaker.Do(func() error {
+		return s.next.DeleteEmployment(personID, employmentID)
+	})
+}
+
+func (s *storage) RefreshWork() ([]int32, error) {
+	return call(s.breaker, s.next.RefreshWork)
+}
@@ -0,0 +1,205 @@
+// Package cache is a read-through cache of persons by id in front of another
+// person storage.
+package cache
+
+import (
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"golang.org/x/sync/singleflight"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Backend keeps cached persons. The in-process LRU is the only one for now,
+// a shared cache can implement it to be used by all instances.
+type Backend interface {
+	Get(id int32) (models.Person, bool)
+	Set(id int32, person models.Person, ttl time.Duration)
+	Delete(id int32)
+}
+
+type sizedBackend interface {
+	Backend
+	Len() int
+	Evictions() int64
+}
+
+type Stats struct {
+	Hits          int64 `json:"hits"`
+	Misses        int64 `json:"misses"`
+	Invalidations int64 `json:"invalidations"`
+	// Evictions and Size are only known for backends that count them, like
+	// the LRU.
+	Evictions int64 `json:"evictions"`
+	Size      int   `json:"size"`
+}
+
+// storage caches persons by id. Other methods go to the next storage as is.
+type storage struct {
+	repositories.Storage
+	*shared
+}
+
+type shared struct {
+	backend Backend
+	ttl     atomic.Int64
+
+	// loads shares one query between concurrent misses of the same id.
+	loads singleflight.Group
+	// generation changes on every invalidation. Persons loaded while it
+	// changed are not cached, as they may be older than the write. mu makes
+	// checking it and filling the backend atomic.
+	generation atomic.Uint64
+	mu         sync.Mutex
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	invalidations atomic.Int64
+}
+
+func NewStorage(next repositories.Storage, backend Backend, ttl time.Duration) *storage {
+	s := &storage{Storage: next, shared: &shared{backend: backend}}
+	s.SetTTL(ttl)
+	return s
+}
+
+func (s *storage) Session(lastWrite time.Time) repositories.Storage {
+	return &storage{Storage: s.Storage.Session(lastWrite), shared: s.shared}
+}
+
+// SetTTL applies to persons cached from now on.
+func (s *storage) SetTTL(ttl time.Duration) {
+	s.ttl.Store(int64(ttl))
+}
+
+func (s *storage) GetPersonByID(id int32) (models.Person, error) {
+	if person, ok := s.backend.Get(id); ok {
+		s.hits.Add(1)
+		return person, nil
+	}
+	s.misses.Add(1)
+
+	generation := s.generation.Load()
+	v, err, _ := s.loads.Do(strconv.Itoa(int(id)), func() (any, error) {
+		return s.Storage.GetPersonByID(id)
+	})
+	if err != nil {
+		return models.Person{}, err
+	}
+
+	person := v.(models.Person)
+	s.fill(generation, person)
+	return person, nil
+}
+
+func (s *storage) GetPersonsByIDs(ids []int32) ([]models.Person, error) {
+	res := make([]models.Person, 0, len(ids))
+	var missing []int32
+	for _, id := range ids {
+		if person, ok := s.backend.Get(id); ok {
+			res = append(res, person)
+			continue
+		}
+		missing = append(missing, id)
+	}
+	s.hits.Add(int64(len(res)))
+	s.misses.Add(int64(len(missing)))
+	if len(missing) == 0 {
+		return res, nil
+	}
+
+	generation := s.generation.Load()
+	persons, err := s.Storage.GetPersonsByIDs(missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range persons {
+		s.fill(generation, p)
+	}
+	return append(res, persons...), nil
+}
+
+func (s *storage) UpdatePersonByID(id int32, person models.Person) error {
+	defer s.invalidate(id)
+	return s.Storage.UpdatePersonByID(id, person)
+}
+
+func (s *storage) DeletePersonByID(id int32) error {
+	defer s.invalidate(id)
+	return s.Storage.DeletePersonByID(id)
+}
+
+func (s *storage) MergePersons(merge models.PersonMerge) (models.Person, error) {
+	defer s.invalidate(merge.IDs()...)
+	return s.Storage.MergePersons(merge)
+}
+
+// UpdateOrganizationByID invalidates the employed persons, whose work is the
+// name of the organization.
+func (s *storage) UpdateOrganizationByID(id int32, organization models.Organization) error {
+	err := s.Storage.UpdateOrganizationByID(id, organization)
+	employments, _ := s.Storage.GetOrganizationEmployments(id)
+	ids := make([]int32, 0, len(employments))
+	for _, e := range employments {
+		ids = append(ids, e.PersonID)
+	}
+	s.invalidate(ids...)
+	return err
+}
+
+func (s *storage) CreateEmployment(employment models.Employment) (models.Employment, error) {
+	defer s.invalidate(employment.PersonID)
+	return s.Storage.CreateEmployment(employment)
+}
+
+func (s *storage) UpdateEmployment(personID, employmentID int32, employment models.Employment) error {
+	defer s.invalidate(personID)
+	return s.Storage.UpdateEmployment(personID, employmentID, employment)
+}
+
+func (s *storage) DeleteEmployment(personID, employmentID int32) error {
+	defer s.invalidate(personID)
+	return s.Storage.DeleteEmployment(personID, employmentID)
+}
+
+func (s *storage) RefreshWork() ([]int32, error) {
+	ids, err := s.Storage.RefreshWork()
+	s.invalidate(ids...)
+	return ids, err
+}
+
+func (s *storage) Stats() Stats {
+	stats := Stats{
+		Hits:          s.hits.Load(),
+		Misses:        s.misses.Load(),
+		Invalidations: s.invalidations.Load(),
+	}
+	if b, ok := s.backend.(sizedBackend); ok {
+		stats.Evictions = b.Evictions()
+		stats.Size = b.Len()
+	}
+	return stats
+}
+
+func (s *storage) fill(generation uint64, person models.Person) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.generation.Load() == generation {
+		s.backend.Set(person.ID, person, time.Duration(s.ttl.Load()))
+	}
+}
+
+// invalidate runs whether the write succeeded or not. Loads started before
+// it are not cached and not joined by later misses.
+func (s *storage) invalidate(ids ...int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generation.Add(1)
+	for _, id := range ids {
+		s.backend.Delete(id)
+		s.loads.Forget(strconv.Itoa(int(id)))
+	}
+	s.invalidations.Add(int64(len(ids)))
+}
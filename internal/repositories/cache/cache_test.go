@@ -0,0 +1,245 @@
+package cache
+
+import (
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/conformance"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/memory"
+	"gorm.io/gorm"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingStorage counts reads by id, and holds them back while block is
+// not nil.
+type countingStorage struct {
+	repositories.Storage
+	reads   atomic.Int64
+	block   chan struct{}
+	started chan struct{}
+}
+
+func (s *countingStorage) GetPersonByID(id int32) (models.Person, error) {
+	s.reads.Add(1)
+	if s.block != nil {
+		s.started <- struct{}{}
+		<-s.block
+	}
+	return s.Storage.GetPersonByID(id)
+}
+
+func newTestStorage(t *testing.T, persons ...models.Person) (*storage, *countingStorage) {
+	t.Helper()
+
+	next := &countingStorage{Storage: memory.NewStorage()}
+	for _, p := range persons {
+		if _, err := next.CreatePerson(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return NewStorage(next, NewLRU(10), time.Minute), next
+}
+
+func TestStorage_readThrough(t *testing.T) {
+	s, next := newTestStorage(t, models.Person{Name: "test"})
+
+	for i := 0; i < 3; i++ {
+		person, err := s.GetPersonByID(1)
+		if err != nil || person.Name != "test" {
+			t.Fatalf("GetPersonByID() = %v, %v", person, err)
+		}
+	}
+	if next.reads.Load() != 1 {
+		t.Errorf("expected 1 read from the storage, but got %d", next.reads.Load())
+	}
+
+	if _, err := s.GetPersonByID(2); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetPersonByID() of a missing person expected %v, but got %v", gorm.ErrRecordNotFound, err)
+	}
+
+	stats := s.Stats()
+	if stats.Hits != 2 || stats.Misses != 2 || stats.Size != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestStorage_invalidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		write    func(s *storage) error
+		expected string
+	}{
+		{
+			name: "update",
+			write: func(s *storage) error {
+				return s.UpdatePersonByID(1, models.Person{Name: "updated"})
+			},
+			expected: "updated",
+		},
+		{
+			name: "delete",
+			write: func(s *storage) error {
+				return s.DeletePersonByID(1)
+			},
+		},
+		{
+			name: "merge",
+			write: func(s *storage) error {
+				_, err := s.MergePersons(models.PersonMerge{TargetID: 2, SourceIDs: []int32{1}})
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestStorage(t, models.Person{Name: "test"}, models.Person{Name: "other"})
+			if _, err := s.GetPersonByID(1); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := tt.write(s); err != nil {
+				t.Fatalf("write error = %v", err)
+			}
+
+			person, err := s.GetPersonByID(1)
+			if tt.expected == "" {
+				if !errors.Is(err, gorm.ErrRecordNotFound) {
+					t.Errorf("GetPersonByID() expected %v, but got %v, %v", gorm.ErrRecordNotFound, person, err)
+				}
+				return
+			}
+			if err != nil || person.Name != tt.expected {
+				t.Errorf("GetPersonByID() expected %q, but got %v, %v", tt.expected, person, err)
+			}
+		})
+	}
+}
+
+func TestStorage_stampede(t *testing.T) {
+	s, next := newTestStorage(t, models.Person{Name: "test"})
+	next.block = make(chan struct{})
+	next.started = make(chan struct{}, 1)
+
+	const readers = 50
+	var wg sync.WaitGroup
+	wg.Add(readers)
+	for i := 0; i < readers; i++ {
+		go func() {
+			defer wg.Done()
+			if _, err := s.GetPersonByID(1); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	<-next.started
+	// Give the other readers time to join the running load.
+	time.Sleep(50 * time.Millisecond)
+	close(next.block)
+	wg.Wait()
+
+	if next.reads.Load() != 1 {
+		t.Errorf("expected 1 read from the storage, but got %d", next.reads.Load())
+	}
+}
+
+func TestStorage_writeDuringLoad(t *testing.T) {
+	s, next := newTestStorage(t, models.Person{Name: "test"})
+	next.block = make(chan struct{})
+	next.started = make(chan struct{}, 1)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := s.GetPersonByID(1); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	<-next.started
+	if err := next.Storage.UpdatePersonByID(1, models.Person{Name: "updated"}); err != nil {
+		t.Fatal(err)
+	}
+	s.invalidate(1)
+	close(next.block)
+	<-done
+
+	if _, ok := s.backend.Get(1); ok {
+		t.Errorf("person loaded before the write must not be cached")
+	}
+}
+
+func TestStorage_getPersonsByIDs(t *testing.T) {
+	s, _ := newTestStorage(t, models.Person{Name: "a"}, models.Person{Name: "b"}, models.Person{Name: "c"})
+	if _, err := s.GetPersonByID(2); err != nil {
+		t.Fatal(err)
+	}
+
+	persons, err := s.GetPersonsByIDs([]int32{1, 2, 3, 4})
+	if err != nil || len(persons) != 3 {
+		t.Fatalf("GetPersonsByIDs() = %v, %v", persons, err)
+	}
+	if stats := s.Stats(); stats.Hits != 1 || stats.Misses != 4 || stats.Size != 3 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestStorage_session(t *testing.T) {
+	s, _ := newTestStorage(t, models.Person{Name: "test"})
+	if _, err := s.GetPersonByID(1); err != nil {
+		t.Fatal(err)
+	}
+
+	session := s.Session(time.Now())
+	if err := session.UpdatePersonByID(1, models.Person{Name: "updated"}); err != nil {
+		t.Fatalf("UpdatePersonByID() error = %v", err)
+	}
+
+	person, err := s.GetPersonByID(1)
+	if err != nil || person.Name != "updated" {
+		t.Errorf("GetPersonByID() after a write of the session expected %q, but got %v, %v", "updated", person, err)
+	}
+	if stats := s.Stats(); stats.Invalidations != 1 {
+		t.Errorf("expected the session to share the stats, but got %+v", stats)
+	}
+}
+
+func TestLRU(t *testing.T) {
+	now := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLRU(2)
+	c.now = func() time.Time { return now }
+
+	c.Set(1, models.Person{ID: 1}, time.Minute)
+	c.Set(2, models.Person{ID: 2}, time.Minute)
+	c.Get(1)
+	c.Set(3, models.Person{ID: 3}, time.Minute)
+
+	if _, ok := c.Get(2); ok {
+		t.Errorf("least recently used person 2 expected to be evicted")
+	}
+	if _, ok := c.Get(1); !ok {
+		t.Errorf("person 1 expected to be cached")
+	}
+	if c.Evictions() != 1 || c.Len() != 2 {
+		t.Errorf("expected 1 eviction and 2 persons, but got %d and %d", c.Evictions(), c.Len())
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.Get(1); ok {
+		t.Errorf("person 1 expected to expire")
+	}
+	if c.Len() != 1 {
+		t.Errorf("expired person expected to be removed, but got %d persons", c.Len())
+	}
+}
+
+func TestStorage_tenants(t *testing.T) {
+	conformance.Run(t, func(*testing.T) (repositories.Storage, repositories.Storage) {
+		db := memory.NewDB()
+		return NewStorage(db.Tenant("acme"), NewLRU(10), time.Minute),
+			NewStorage(db.Tenant("globex"), NewLRU(10), time.Minute)
+	})
+}
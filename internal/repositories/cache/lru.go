@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"container/list"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type lruEntry struct {
+	id        int32
+	person    models.Person
+	expiresAt time.Time
+}
+
+type lru struct {
+	mu      sync.Mutex
+	size    int
+	entries map[int32]*list.Element
+	order   *list.List
+	now     func() time.Time
+
+	evictions atomic.Int64
+}
+
+func NewLRU(size int) *lru {
+	return &lru{
+		size:    size,
+		entries: make(map[int32]*list.Element, size),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+func (c *lru) Get(id int32) (models.Person, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[id]
+	if !ok {
+		return models.Person{}, false
+	}
+	entry := el.Value.(*lruEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(el)
+		return models.Person{}, false
+	}
+	c.order.MoveToFront(el)
+	return entry.person, true
+}
+
+func (c *lru) Set(id int32, person models.Person, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+	if el, ok := c.entries[id]; ok {
+		entry := el.Value.(*lruEntry)
+		entry.person, entry.expiresAt = person, expiresAt
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[id] = c.order.PushFront(&lruEntry{id: id, person: person, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+		c.evictions.Add(1)
+	}
+}
+
+func (c *lru) Delete(id int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[id]; ok {
+		c.remove(el)
+	}
+}
+
+// Len counts expired persons too.
+func (c *lru) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *lru) Evictions() int64 {
+	return c.evictions.Load()
+}
+
+func (c *lru) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*lruEntry).id)
+}
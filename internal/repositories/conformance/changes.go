@@ -0,0 +1,117 @@
+package conformance
+
+import (
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"strings"
+	"testing"
+	"time"
+)
+
+// changeNames lists the changes of s as names, deleted ones as -id.
+func changeNames(t *testing.T, s repositories.Storage, since time.Time, after *models.PersonCursor, limit int) ([]models.PersonChange, string) {
+	t.Helper()
+	changes, err := s.GetPersonsUpdatedSince(since, after, limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(changes))
+	for _, c := range changes {
+		if c.Deleted {
+			names = append(names, fmt.Sprint(-c.ID))
+			continue
+		}
+		names = append(names, c.Name)
+	}
+	return changes, strings.Join(names, ",")
+}
+
+// RunChanges checks the changes feed, on the storage newStorage returns,
+// empty and called once per subtest.
+func RunChanges(t *testing.T, newStorage func(t *testing.T) repositories.Storage) {
+	t.Run("order", func(t *testing.T) {
+		s := newStorage(t)
+		ivan, err := s.CreatePerson(newPerson("Ivan", ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = s.CreatePerson(newPerson("Petr", "")); err != nil {
+			t.Fatal(err)
+		}
+		if err = s.UpdatePersonByID(ivan.ID, models.Person{Name: "Ivan Petrov"}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, names := changeNames(t, s, time.Time{}, nil, 100); names != "Petr,Ivan Petrov" {
+			t.Errorf("changes expected %q, but got %q", "Petr,Ivan Petrov", names)
+		}
+		if _, names := changeNames(t, s, time.Now().Add(time.Hour), nil, 100); names != "" {
+			t.Errorf("changes since later expected none, but got %q", names)
+		}
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		s := newStorage(t)
+		for _, name := range []string{"a", "b", "c"} {
+			if _, err := s.CreatePerson(newPerson(name, "")); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		page, names := changeNames(t, s, time.Time{}, nil, 2)
+		if names != "a,b" {
+			t.Fatalf("first page expected %q, but got %q", "a,b", names)
+		}
+		cursor := models.NewPersonCursor(page[len(page)-1])
+		if _, names = changeNames(t, s, time.Time{}, &cursor, 2); names != "c" {
+			t.Errorf("second page expected %q, but got %q", "c", names)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		s := newStorage(t)
+		p, err := s.CreatePerson(newPerson("Ivan", ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		page, _ := changeNames(t, s, time.Time{}, nil, 100)
+		cursor := models.NewPersonCursor(page[len(page)-1])
+		if err = s.DeletePersonByID(p.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		// A reader past the person learns that it is gone.
+		expected := fmt.Sprint(-p.ID)
+		if _, names := changeNames(t, s, time.Time{}, &cursor, 100); names != expected {
+			t.Errorf("changes after the person expected %q, but got %q", expected, names)
+		}
+		if _, names := changeNames(t, s, time.Time{}, nil, 100); names != expected {
+			t.Errorf("changes expected %q, but got %q", expected, names)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		s := newStorage(t)
+		target, err := s.CreatePerson(newPerson("Ivan", ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		source, err := s.CreatePerson(newPerson("Ivan Petrov", ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = s.MergePersons(models.PersonMerge{TargetID: target.ID, SourceIDs: []int32{source.ID}}); err != nil {
+			t.Fatal(err)
+		}
+
+		changes, _ := changeNames(t, s, time.Time{}, nil, 100)
+		deleted := map[int32]bool{}
+		for _, c := range changes {
+			deleted[c.ID] = c.Deleted
+		}
+		if len(changes) != 2 || deleted[target.ID] || !deleted[source.ID] {
+			t.Errorf("changes expected the target and a tombstone of the source, but got %v", changes)
+		}
+	})
+}
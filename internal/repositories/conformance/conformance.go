@@ -0,0 +1,260 @@
+// Package conformance checks that person storages behave the same: that
+// they keep tenants apart, walk relations and derive work from employments
+// alike. Every storage runs it in
+// its tests.
+package conformance
+
+import (
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+// Run checks that nothing done through the storage of one tenant reaches the
+// persons of another. newTenants returns the empty storages of two tenants
+// over the same data, and is called once per subtest.
+func Run(t *testing.T, newTenants func(t *testing.T) (a, b repositories.Storage)) {
+	tests := []struct {
+		name  string
+		check func(t *testing.T, a, b repositories.Storage, p models.Person)
+	}{
+		{name: "get by id", check: checkGetByID},
+		{name: "reads", check: checkReads},
+		{name: "update", check: checkUpdate},
+		{name: "delete", check: checkDelete},
+		{name: "merge", check: checkMerge},
+		{name: "merged ids", check: checkMergedID},
+		{name: "email unique per tenant", check: checkEmail},
+		{name: "relations", check: checkRelations},
+		{name: "organizations", check: checkOrganizations},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := newTenants(t)
+			p, err := a.CreatePerson(newPerson("Ivan Petrov", "ivan@example.com"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// b has persons of its own, so empty results are not a fluke.
+			if _, err = b.CreatePerson(newPerson("Ivan Petrova", "")); err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, a, b, p)
+		})
+	}
+}
+
+func newPerson(name, email string) models.Person {
+	return models.Person{Name: name, Age: 30, Address: "Moscow", Work: "BMSTU", Email: email}
+}
+
+func requireOwn(t *testing.T, a repositories.Storage, p models.Person) {
+	t.Helper()
+	got, err := a.GetPersonByID(p.ID)
+	if err != nil {
+		t.Fatalf("person of the tenant expected to be kept, but got %v", err)
+	}
+	if got.Name != p.Name {
+		t.Errorf("person of the tenant expected name %q, but got %q", p.Name, got.Name)
+	}
+}
+
+func requireNone(t *testing.T, method string, persons []models.Person, p models.Person) {
+	t.Helper()
+	for _, got := range persons {
+		if got.ID == p.ID {
+			t.Errorf("%s of another tenant expected not to return person %d", method, p.ID)
+		}
+	}
+}
+
+// changedPersons returns the persons of all changes, tombstones included.
+func changedPersons(t *testing.T, s repositories.Storage) []models.Person {
+	t.Helper()
+	changes, err := s.GetPersonsUpdatedSince(time.Time{}, nil, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	persons := make([]models.Person, 0, len(changes))
+	for _, c := range changes {
+		persons = append(persons, c.Person)
+	}
+	return persons
+}
+
+func checkGetByID(t *testing.T, _, b repositories.Storage, p models.Person) {
+	_, err := b.GetPersonByID(p.ID)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetPersonByID() of another tenant expected %v, but got %v", gorm.ErrRecordNotFound, err)
+	}
+}
+
+func checkReads(t *testing.T, _, b repositories.Storage, p models.Person) {
+	all, err := b.GetAllPerson()
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireNone(t, "GetAllPerson()", all, p)
+
+	byIDs, err := b.GetPersonsByIDs([]int32{p.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireNone(t, "GetPersonsByIDs()", byIDs, p)
+
+	listed, err := b.ListPersons(models.PersonQuery{SortBy: models.PersonSortByID, Limit: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireNone(t, "ListPersons()", listed, p)
+
+	requireNone(t, "GetPersonsUpdatedSince()", changedPersons(t, b), p)
+
+	duplicates, err := b.GetDuplicateCandidates(models.Person{Name: p.Name}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireNone(t, "GetDuplicateCandidates()", duplicates, p)
+}
+
+func checkUpdate(t *testing.T, a, b repositories.Storage, p models.Person) {
+	err := b.UpdatePersonByID(p.ID, models.Person{Name: "Changed"})
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("UpdatePersonByID() of another tenant expected %v, but got %v", gorm.ErrRecordNotFound, err)
+	}
+	requireOwn(t, a, p)
+}
+
+func checkDelete(t *testing.T, a, b repositories.Storage, p models.Person) {
+	// Deleting a missing person is not an error, so only the result counts.
+	_ = b.DeletePersonByID(p.ID)
+	requireOwn(t, a, p)
+	requireNone(t, "GetPersonsUpdatedSince()", changedPersons(t, b), p)
+}
+
+func checkMerge(t *testing.T, a, b repositories.Storage, p models.Person) {
+	own, err := b.CreatePerson(newPerson("Petr Ivanov", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = b.MergePersons(models.PersonMerge{TargetID: own.ID, SourceIDs: []int32{p.ID}})
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("MergePersons() of a person of another tenant expected %v, but got %v", gorm.ErrRecordNotFound, err)
+	}
+	requireOwn(t, a, p)
+	requireOwn(t, b, own)
+}
+
+func checkMergedID(t *testing.T, a, b repositories.Storage, p models.Person) {
+	source, err := a.CreatePerson(newPerson("Ivan Petrov", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = a.MergePersons(models.PersonMerge{TargetID: p.ID, SourceIDs: []int32{source.ID}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = b.GetMergedPersonID(source.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetMergedPersonID() of another tenant expected %v, but got %v", gorm.ErrRecordNotFound, err)
+	}
+	if id, err := a.GetMergedPersonID(source.ID); err != nil || id != p.ID {
+		t.Errorf("GetMergedPersonID() of the tenant expected %d, but got %d, %v", p.ID, id, err)
+	}
+}
+
+func checkEmail(t *testing.T, a, b repositories.Storage, p models.Person) {
+	if _, err := b.CreatePerson(newPerson("Ivan Sidorov", p.Email)); err != nil {
+		t.Errorf("CreatePerson() with an email of another tenant expected no error, but got %v", err)
+	}
+	if _, err := b.CreatePersons([]models.Person{newPerson("Ivan Sidorov", "other@example.com")}); err != nil {
+		t.Errorf("CreatePersons() expected no error, but got %v", err)
+	}
+	if _, err := a.CreatePerson(newPerson("Ivan Sidorov", p.Email)); !errors.Is(err, gorm.ErrDuplicatedKey) {
+		t.Errorf("CreatePerson() with a taken email expected %v, but got %v", gorm.ErrDuplicatedKey, err)
+	}
+}
+
+func checkRelations(t *testing.T, a, b repositories.Storage, p models.Person) {
+	own, err := b.CreatePerson(newPerson("Petr Ivanov", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = b.CreateRelation(models.PersonRelation{FromID: own.ID, ToID: p.ID, Type: models.RelationManager})
+	if !errors.Is(err, gorm.ErrForeignKeyViolated) {
+		t.Errorf("CreateRelation() with a person of another tenant expected %v, but got %v", gorm.ErrForeignKeyViolated, err)
+	}
+
+	report, err := a.CreatePerson(newPerson("Anna Petrova", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	relation, err := a.CreateRelation(models.PersonRelation{FromID: p.ID, ToID: report.ID, Type: models.RelationManager})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = b.DeleteRelation(p.ID, relation.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("DeleteRelation() of another tenant expected %v, but got %v", gorm.ErrRecordNotFound, err)
+	}
+	query := models.RelationQuery{PersonID: p.ID, Direction: models.RelationBoth, Depth: 3}
+	related, err := b.GetRelatedPersons(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(related) != 0 {
+		t.Errorf("GetRelatedPersons() of another tenant expected none, but got %d", len(related))
+	}
+	if related, err = a.GetRelatedPersons(query); err != nil || len(related) != 1 {
+		t.Errorf("GetRelatedPersons() of the tenant expected 1 person, but got %d, %v", len(related), err)
+	}
+}
+
+func checkOrganizations(t *testing.T, a, b repositories.Storage, p models.Person) {
+	employments, err := a.GetEmployments(p.ID)
+	if err != nil || len(employments) != 1 {
+		t.Fatalf("employment of the work of the person expected, but got %v, %v", employments, err)
+	}
+	id := employments[0].OrganizationID
+
+	if _, err = b.GetOrganizationByID(id); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetOrganizationByID() of another tenant expected %v, but got %v", gorm.ErrRecordNotFound, err)
+	}
+	organizations, err := b.ListOrganizations("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range organizations {
+		if o.ID == id {
+			t.Errorf("ListOrganizations() of another tenant expected not to return organization %d", id)
+		}
+	}
+	if employments, err = b.GetEmployments(p.ID); err != nil || len(employments) != 0 {
+		t.Errorf("GetEmployments() of another tenant expected none, but got %v, %v", employments, err)
+	}
+	if employments, err = b.GetOrganizationEmployments(id); err != nil || len(employments) != 0 {
+		t.Errorf("GetOrganizationEmployments() of another tenant expected none, but got %v, %v", employments, err)
+	}
+
+	own, err := b.CreatePerson(newPerson("Petr Ivanov", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = b.CreateEmployment(models.Employment{PersonID: own.ID, OrganizationID: id})
+	if !errors.Is(err, gorm.ErrForeignKeyViolated) {
+		t.Errorf("CreateEmployment() at an organization of another tenant expected %v, but got %v", gorm.ErrForeignKeyViolated, err)
+	}
+	err = b.UpdateOrganizationByID(id, models.Organization{Name: "Changed"})
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("UpdateOrganizationByID() of another tenant expected %v, but got %v", gorm.ErrRecordNotFound, err)
+	}
+	// Only the result counts, the error of another tenant is not checked.
+	_ = b.DeleteOrganizationByID(id)
+	if _, err = a.GetOrganizationByID(id); err != nil {
+		t.Errorf("organization of the tenant expected to be kept, but got %v", err)
+	}
+	requireWork(t, a, p.ID, p.Work)
+}
@@ -0,0 +1,252 @@
+package conformance
+
+import (
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func requireWork(t *testing.T, s repositories.Storage, id int32, work string) {
+	t.Helper()
+	p, err := s.GetPersonByID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Work != work {
+		t.Errorf("work expected %q, but got %q", work, p.Work)
+	}
+}
+
+func days(n int) *time.Time {
+	d := models.Today(time.Now()).AddDate(0, 0, n)
+	return &d
+}
+
+// RunOrganizations checks that organizations are unique by normalized name
+// and that the work of persons is derived from their employments, on the
+// storage newStorage returns, empty and called once per subtest.
+func RunOrganizations(t *testing.T, newStorage func(t *testing.T) repositories.Storage) {
+	t.Run("normalized names", func(t *testing.T) {
+		s := newStorage(t)
+		sber, err := s.CreateOrganization(models.Organization{Name: "Sber"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{"sber", "ООО «Сбер»", "Sber, LLC"} {
+			if _, err = s.CreateOrganization(models.Organization{Name: name}); !errors.Is(err, gorm.ErrDuplicatedKey) {
+				t.Errorf("CreateOrganization(%q) expected %v, but got %v", name, gorm.ErrDuplicatedKey, err)
+			}
+		}
+
+		found, err := s.ListOrganizations("сбер")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found) != 1 || found[0].ID != sber.ID {
+			t.Errorf("ListOrganizations() expected %v, but got %v", sber, found)
+		}
+		if found, err = s.ListOrganizations("google"); err != nil || len(found) != 0 {
+			t.Errorf("ListOrganizations() of another name expected none, but got %v, %v", found, err)
+		}
+	})
+
+	t.Run("work links to an organization", func(t *testing.T) {
+		s := newStorage(t)
+		if _, err := s.CreateOrganization(models.Organization{Name: "Sber"}); err != nil {
+			t.Fatal(err)
+		}
+
+		p, err := s.CreatePerson(models.Person{Name: "Ivan", Work: "ООО Сбер"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Work != "Sber" {
+			t.Errorf("CreatePerson() work expected %q, but got %q", "Sber", p.Work)
+		}
+		persons, err := s.CreatePersons([]models.Person{{Name: "Anna", Work: "Google"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if persons[0].Work != "Google" {
+			t.Errorf("CreatePersons() work expected %q, but got %q", "Google", persons[0].Work)
+		}
+		if err = s.UpdatePersonByID(p.ID, models.Person{Work: "sber"}); err != nil {
+			t.Fatal(err)
+		}
+		requireWork(t, s, p.ID, "Sber")
+
+		employments, err := s.GetEmployments(p.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(employments) != 1 || employments[0].StartDate == nil {
+			t.Errorf("one employment from today expected, but got %v", employments)
+		}
+		organizations, err := s.ListOrganizations("")
+		if err != nil || len(organizations) != 2 {
+			t.Errorf("organizations Sber and Google expected, but got %v, %v", organizations, err)
+		}
+	})
+
+	t.Run("work without an organization", func(t *testing.T) {
+		s := newStorage(t)
+		p, err := s.CreatePerson(models.Person{Name: "Ivan", Work: "ООО"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		requireWork(t, s, p.ID, "ООО")
+		if organizations, err := s.ListOrganizations(""); err != nil || len(organizations) != 0 {
+			t.Errorf("no organizations expected, but got %v, %v", organizations, err)
+		}
+	})
+
+	t.Run("current employment", func(t *testing.T) {
+		s := newStorage(t)
+		p, err := s.CreatePerson(models.Person{Name: "Ivan", Work: "Sber"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		google, err := s.CreateOrganization(models.Organization{Name: "Google"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		employment, err := s.CreateEmployment(models.Employment{
+			PersonID: p.ID, OrganizationID: google.ID, Role: "engineer", StartDate: days(0),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		requireWork(t, s, p.ID, "Google")
+
+		employment.StartDate, employment.EndDate = days(-30), days(-1)
+		if err = s.UpdateEmployment(p.ID, employment.ID, employment); err != nil {
+			t.Fatal(err)
+		}
+		requireWork(t, s, p.ID, "Sber")
+
+		employments, err := s.GetEmployments(p.ID)
+		if err != nil || len(employments) != 2 {
+			t.Fatalf("two employments expected, but got %v, %v", employments, err)
+		}
+		if err = s.DeleteEmployment(p.ID, employments[0].ID); err != nil {
+			t.Fatal(err)
+		}
+		requireWork(t, s, p.ID, "")
+
+		if err = s.DeleteEmployment(p.ID, employments[0].ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("DeleteEmployment() of a deleted one expected %v, but got %v", gorm.ErrRecordNotFound, err)
+		}
+		_, err = s.CreateEmployment(models.Employment{PersonID: p.ID, OrganizationID: google.ID + 1000})
+		if !errors.Is(err, gorm.ErrForeignKeyViolated) {
+			t.Errorf("CreateEmployment() at a missing organization expected %v, but got %v", gorm.ErrForeignKeyViolated, err)
+		}
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		s := newStorage(t)
+		p, err := s.CreatePerson(models.Person{Name: "Ivan", Work: "Sber"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = s.CreateOrganization(models.Organization{Name: "Google"}); err != nil {
+			t.Fatal(err)
+		}
+		employments, err := s.GetEmployments(p.ID)
+		if err != nil || len(employments) != 1 {
+			t.Fatalf("one employment expected, but got %v, %v", employments, err)
+		}
+
+		id := employments[0].OrganizationID
+		if err = s.UpdateOrganizationByID(id, models.Organization{Name: "Sber LLC"}); err != nil {
+			t.Fatal(err)
+		}
+		requireWork(t, s, p.ID, "Sber LLC")
+		if err = s.UpdateOrganizationByID(id, models.Organization{Name: "google"}); !errors.Is(err, gorm.ErrDuplicatedKey) {
+			t.Errorf("UpdateOrganizationByID() to a taken name expected %v, but got %v", gorm.ErrDuplicatedKey, err)
+		}
+		if err = s.UpdateOrganizationByID(id+1000, models.Organization{Name: "Sber"}); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("UpdateOrganizationByID() of a missing one expected %v, but got %v", gorm.ErrRecordNotFound, err)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		s := newStorage(t)
+		p, err := s.CreatePerson(models.Person{Name: "Ivan", Work: "Sber"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		employments, err := s.GetEmployments(p.ID)
+		if err != nil || len(employments) != 1 {
+			t.Fatalf("one employment expected, but got %v, %v", employments, err)
+		}
+
+		id := employments[0].OrganizationID
+		if err = s.DeleteOrganizationByID(id); !errors.Is(err, gorm.ErrForeignKeyViolated) {
+			t.Errorf("DeleteOrganizationByID() with employments expected %v, but got %v", gorm.ErrForeignKeyViolated, err)
+		}
+		if err = s.DeletePersonByID(p.ID); err != nil {
+			t.Fatal(err)
+		}
+		if err = s.DeleteOrganizationByID(id); err != nil {
+			t.Errorf("DeleteOrganizationByID() without employments expected no error, but got %v", err)
+		}
+		if _, err = s.GetOrganizationByID(id); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("GetOrganizationByID() of a deleted one expected %v, but got %v", gorm.ErrRecordNotFound, err)
+		}
+		if err = s.DeleteOrganizationByID(id); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("DeleteOrganizationByID() of a deleted one expected %v, but got %v", gorm.ErrRecordNotFound, err)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		s := newStorage(t)
+		target, err := s.CreatePerson(models.Person{Name: "Ivan", Work: "Sber"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		source, err := s.CreatePerson(models.Person{Name: "Ivan", Work: "Google"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		merged, err := s.MergePersons(models.PersonMerge{TargetID: target.ID, SourceIDs: []int32{source.ID}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		employments, err := s.GetEmployments(target.ID)
+		if err != nil || len(employments) != 2 {
+			t.Errorf("employments of both persons expected, but got %v, %v", employments, err)
+		}
+		// Both started today, so the one recorded last is current.
+		if merged.Work != "Google" {
+			t.Errorf("merged work expected %q, but got %q", "Google", merged.Work)
+		}
+		requireWork(t, s, target.ID, "Google")
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		s := newStorage(t)
+		p, err := s.CreatePerson(models.Person{Name: "Ivan", Work: "Sber"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Work derived on writes is up to date, so nothing is touched.
+		ids, err := s.RefreshWork()
+		if err != nil || len(ids) != 0 {
+			t.Errorf("RefreshWork() expected no persons, but got %v, %v", ids, err)
+		}
+		refreshed, err := s.GetPersonByID(p.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !refreshed.UpdatedAt.Equal(p.UpdatedAt) {
+			t.Errorf("updated at expected %v, but got %v", p.UpdatedAt, refreshed.UpdatedAt)
+		}
+		requireWork(t, s, p.ID, "Sber")
+	})
+}
@@ -0,0 +1,283 @@
+package conformance
+
+import (
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"gorm.io/gorm"
+	"maps"
+	"slices"
+	"testing"
+)
+
+// relationGraph is the persons and relations RunRelations walks: a chain
+// of managers with a cycle back to the top, and a family.
+type relationGraph struct {
+	ids   map[string]int32
+	names map[int32]string
+}
+
+func newRelationGraph(t *testing.T, s repositories.Storage) relationGraph {
+	t.Helper()
+
+	g := relationGraph{ids: make(map[string]int32), names: make(map[int32]string)}
+	for _, name := range []string{"ceo", "cto", "dev1", "dev2", "intern", "grandpa", "dad", "mom", "kid"} {
+		p, err := s.CreatePerson(newPerson(name, ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		g.ids[name], g.names[p.ID] = p.ID, name
+	}
+
+	relations := []models.PersonRelation{
+		{FromID: g.ids["ceo"], ToID: g.ids["cto"], Type: models.RelationManager},
+		{FromID: g.ids["cto"], ToID: g.ids["dev1"], Type: models.RelationManager},
+		{FromID: g.ids["cto"], ToID: g.ids["dev2"], Type: models.RelationManager},
+		{FromID: g.ids["dev2"], ToID: g.ids["intern"], Type: models.RelationManager},
+		{FromID: g.ids["intern"], ToID: g.ids["ceo"], Type: models.RelationManager},
+		{FromID: g.ids["grandpa"], ToID: g.ids["dad"], Type: models.RelationParent},
+		{FromID: g.ids["dad"], ToID: g.ids["kid"], Type: models.RelationParent},
+		{FromID: g.ids["dad"], ToID: g.ids["mom"], Type: models.RelationSpouse, Bidirectional: true},
+	}
+	for _, r := range relations {
+		if _, err := s.CreateRelation(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return g
+}
+
+func (g relationGraph) depths(related []models.RelatedPerson) map[string]int {
+	res := make(map[string]int, len(related))
+	for _, r := range related {
+		res[g.names[r.Person.ID]] = r.Depth
+	}
+	return res
+}
+
+// RunRelations checks the walks over relations of the storage newStorage
+// returns, empty and called once per subtest.
+func RunRelations(t *testing.T, newStorage func(t *testing.T) repositories.Storage) {
+	tests := []struct {
+		name     string
+		from     string
+		types    []models.RelationType
+		dir      models.RelationDirection
+		depth    int
+		expected map[string]int
+	}{
+		{
+			name:     "direct reports",
+			from:     "ceo",
+			types:    []models.RelationType{models.RelationManager},
+			dir:      models.RelationOut,
+			depth:    1,
+			expected: map[string]int{"cto": 1},
+		},
+		{
+			name:     "all reports through a cycle",
+			from:     "ceo",
+			types:    []models.RelationType{models.RelationManager},
+			dir:      models.RelationOut,
+			depth:    10,
+			expected: map[string]int{"cto": 1, "dev1": 2, "dev2": 2, "intern": 3},
+		},
+		{
+			name:     "managers",
+			from:     "intern",
+			types:    []models.RelationType{models.RelationManager},
+			dir:      models.RelationIn,
+			depth:    2,
+			expected: map[string]int{"dev2": 1, "cto": 2},
+		},
+		{
+			name:     "family tree",
+			from:     "kid",
+			dir:      models.RelationBoth,
+			depth:    3,
+			expected: map[string]int{"dad": 1, "grandpa": 2, "mom": 2},
+		},
+		{
+			name:     "spouses both ways",
+			from:     "mom",
+			types:    []models.RelationType{models.RelationSpouse},
+			dir:      models.RelationOut,
+			depth:    1,
+			expected: map[string]int{"dad": 1},
+		},
+		{
+			name:     "other types are not followed",
+			from:     "dad",
+			types:    []models.RelationType{models.RelationManager},
+			dir:      models.RelationBoth,
+			depth:    3,
+			expected: map[string]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStorage(t)
+			g := newRelationGraph(t, s)
+
+			related, err := s.GetRelatedPersons(models.RelationQuery{
+				PersonID: g.ids[tt.from], Types: tt.types, Direction: tt.dir, Depth: tt.depth,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := g.depths(related); !maps.Equal(got, tt.expected) {
+				t.Errorf("related persons expected %v, but got %v", tt.expected, got)
+			}
+			for i := 1; i < len(related); i++ {
+				if related[i-1].Depth > related[i].Depth {
+					t.Errorf("related persons expected nearest first, but got %v", g.depths(related))
+				}
+			}
+		})
+	}
+
+	t.Run("path", func(t *testing.T) {
+		s := newStorage(t)
+		g := newRelationGraph(t, s)
+
+		related, err := s.GetRelatedPersons(models.RelationQuery{
+			PersonID: g.ids["ceo"], Direction: models.RelationOut, Depth: 3,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []int32{g.ids["ceo"], g.ids["cto"], g.ids["dev2"], g.ids["intern"]}
+		last := related[len(related)-1]
+		if !slices.Equal(last.Path, expected) || last.Type != models.RelationManager {
+			t.Errorf("path to intern expected %v, but got %v", expected, last.Path)
+		}
+	})
+
+	t.Run("dense", func(t *testing.T) {
+		s := newStorage(t)
+
+		// Every person manages every other one: there are more paths than
+		// persons by far, and each person is still found once.
+		var ids []int32
+		for i := 0; i < 8; i++ {
+			p, err := s.CreatePerson(newPerson("dense", ""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, p.ID)
+		}
+		for _, from := range ids {
+			for _, to := range ids {
+				if from == to {
+					continue
+				}
+				if _, err := s.CreateRelation(models.PersonRelation{FromID: from, ToID: to, Type: models.RelationManager}); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		related, err := s.GetRelatedPersons(models.RelationQuery{
+			PersonID: ids[0], Direction: models.RelationBoth, Depth: 10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(related) != len(ids)-1 {
+			t.Fatalf("related persons expected %d, but got %d", len(ids)-1, len(related))
+		}
+		for _, r := range related {
+			if r.Depth != 1 || len(r.Path) != 2 {
+				t.Errorf("person %d expected at depth 1, but got depth %d, path %v", r.Person.ID, r.Depth, r.Path)
+			}
+		}
+	})
+
+	t.Run("duplicates", func(t *testing.T) {
+		s := newStorage(t)
+		g := newRelationGraph(t, s)
+
+		duplicates := []models.PersonRelation{
+			{FromID: g.ids["ceo"], ToID: g.ids["cto"], Type: models.RelationManager},
+			{FromID: g.ids["mom"], ToID: g.ids["dad"], Type: models.RelationSpouse, Bidirectional: true},
+		}
+		for _, r := range duplicates {
+			if _, err := s.CreateRelation(r); !errors.Is(err, gorm.ErrDuplicatedKey) {
+				t.Errorf("CreateRelation() of a duplicate expected %v, but got %v", gorm.ErrDuplicatedKey, err)
+			}
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		s := newStorage(t)
+		g := newRelationGraph(t, s)
+
+		spouse, err := s.GetRelatedPersons(models.RelationQuery{
+			PersonID: g.ids["mom"], Direction: models.RelationOut, Depth: 1,
+		})
+		if err != nil || len(spouse) != 1 {
+			t.Fatalf("spouse expected, but got %v, %v", spouse, err)
+		}
+		if err = s.DeleteRelation(g.ids["kid"], spouse[0].RelationID); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("DeleteRelation() of another person expected %v, but got %v", gorm.ErrRecordNotFound, err)
+		}
+		if err = s.DeleteRelation(g.ids["mom"], spouse[0].RelationID); err != nil {
+			t.Errorf("DeleteRelation() error = %v", err)
+		}
+
+		if err = s.DeletePersonByID(g.ids["dev2"]); err != nil {
+			t.Fatal(err)
+		}
+		related, err := s.GetRelatedPersons(models.RelationQuery{
+			PersonID: g.ids["ceo"], Direction: models.RelationOut, Depth: 10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string]int{"cto": 1, "dev1": 2}
+		if got := g.depths(related); !maps.Equal(got, expected) {
+			t.Errorf("related persons after a delete expected %v, but got %v", expected, got)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		s := newStorage(t)
+		g := newRelationGraph(t, s)
+		if _, err := s.CreateRelation(models.PersonRelation{FromID: g.ids["ceo"], ToID: g.ids["dev1"], Type: models.RelationManager}); err != nil {
+			t.Fatal(err)
+		}
+
+		// The cto manages both devs, so those relations would relate the cto
+		// to itself, and the ceo managing dev1 repeats the ceo managing the cto.
+		_, err := s.MergePersons(models.PersonMerge{TargetID: g.ids["cto"], SourceIDs: []int32{g.ids["dev1"], g.ids["dev2"]}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		related, err := s.GetRelatedPersons(models.RelationQuery{
+			PersonID: g.ids["cto"], Direction: models.RelationBoth, Depth: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string]int{"ceo": 1, "intern": 1}
+		if got := g.depths(related); !maps.Equal(got, expected) {
+			t.Errorf("related persons after a merge expected %v, but got %v", expected, got)
+		}
+
+		for _, r := range related {
+			if r.Person.ID == g.ids["ceo"] {
+				err = s.DeleteRelation(g.ids["cto"], r.RelationID)
+			}
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		related, err = s.GetRelatedPersons(models.RelationQuery{
+			PersonID: g.ids["ceo"], Direction: models.RelationOut, Depth: 1,
+		})
+		if err != nil || len(related) != 0 {
+			t.Errorf("no relations from the ceo besides the merged one expected, but got %v, %v", related, err)
+		}
+	})
+}
@@ -0,0 +1,219 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/charmbracelet/log"
+	"gorm.io/gorm"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultStickiness          = 5 * time.Second
+	defaultHealthCheckInterval = 5 * time.Second
+)
+
+// Cluster is a primary database and its read replicas. Reads are spread
+// over the healthy replicas, except for the reads of clients that wrote
+// recently, which go to the primary so that they see their own writes.
+type Cluster struct {
+	primary  *gorm.DB
+	replicas []*replica
+	next     atomic.Uint64
+
+	stickiness time.Duration
+	mu         sync.Mutex
+	// writes keeps the rows written by this process, so that its cache is
+	// not filled from a replica behind them.
+	writes map[int32]time.Time
+	now    func() time.Time
+
+	ping func(ctx context.Context, db *gorm.DB) error
+	stop context.CancelFunc
+	done chan struct{}
+}
+
+type replica struct {
+	name    string
+	db      *gorm.DB
+	healthy atomic.Bool
+}
+
+// OpenCluster waits for the primary only.
+func OpenCluster(cfg config.Config) (*Cluster, error) {
+	primary, err := OpenPostgres(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := make([]*gorm.DB, 0, len(cfg.PostgresReplicaDSNs))
+	for i, dsn := range cfg.PostgresReplicaDSNs {
+		// A replica that is down is left to the health checks.
+		db, err := connect(dsn, cfg, true)
+		if err != nil {
+			return nil, fmt.Errorf("replica %d: %w", i, err)
+		}
+		replicas = append(replicas, db)
+	}
+
+	c := NewCluster(primary, replicas, cfg.ReplicaStickiness)
+	c.StartHealthChecks(cfg.ReplicaHealthCheckInterval)
+	return c, nil
+}
+
+// NewCluster takes replicas as healthy until a health check fails.
+func NewCluster(primary *gorm.DB, replicas []*gorm.DB, stickiness time.Duration) *Cluster {
+	if stickiness <= 0 {
+		stickiness = defaultStickiness
+	}
+
+	c := &Cluster{
+		primary:    primary,
+		stickiness: stickiness,
+		writes:     make(map[int32]time.Time),
+		now:        time.Now,
+		ping:       ping,
+	}
+	for i, db := range replicas {
+		r := &replica{name: fmt.Sprintf("replica %d", i), db: db}
+		r.healthy.Store(true)
+		c.replicas = append(c.replicas, r)
+	}
+	return c
+}
+
+func (c *Cluster) Primary() *gorm.DB {
+	return c.primary
+}
+
+// Reader reads from the primary within the stickiness window after the
+// client wrote, at lastWrite, or after the row was written. Id 0 stands for
+// reads of many rows.
+func (c *Cluster) Reader(id int32, lastWrite time.Time) *gorm.DB {
+	if len(c.replicas) == 0 || c.sticky(id, lastWrite) {
+		return c.primary
+	}
+
+	start := c.next.Add(1)
+	for i := range c.replicas {
+		r := c.replicas[(start+uint64(i))%uint64(len(c.replicas))]
+		if r.healthy.Load() {
+			return r.db
+		}
+	}
+	return c.primary
+}
+
+func (c *Cluster) Written(ids ...int32) {
+	if len(c.replicas) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for id, at := range c.writes {
+		if now.Sub(at) >= c.stickiness {
+			delete(c.writes, id)
+		}
+	}
+	for _, id := range ids {
+		c.writes[id] = now
+	}
+}
+
+// lastWrite comes from clients and other instances, so it is only trusted
+// within the window on either side of now.
+func (c *Cluster) sticky(id int32, lastWrite time.Time) bool {
+	now := c.now()
+	if d := now.Sub(lastWrite); !lastWrite.IsZero() && d > -c.stickiness && d < c.stickiness {
+		return true
+	}
+	if id == 0 {
+		return false
+	}
+
+	c.mu.Lock()
+	at := c.writes[id]
+	c.mu.Unlock()
+	return !at.IsZero() && now.Sub(at) < c.stickiness
+}
+
+// StartHealthChecks takes a replica that does not answer out of rotation
+// until it does again.
+func (c *Cluster) StartHealthChecks(interval time.Duration) {
+	if len(c.replicas) == 0 {
+		return
+	}
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.stop, c.done = cancel, make(chan struct{})
+	go func() {
+		defer close(c.done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			c.CheckHealth(ctx, interval)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (c *Cluster) CheckHealth(ctx context.Context, timeout time.Duration) {
+	for _, r := range c.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := c.ping(pingCtx, r.db)
+		cancel()
+
+		healthy := err == nil
+		if r.healthy.Swap(healthy) != healthy {
+			if healthy {
+				log.Info("postgres replica is back in rotation", "replica", r.name)
+			} else {
+				log.Error("postgres replica is out of rotation", "replica", r.name, "err", err)
+			}
+		}
+	}
+}
+
+func (c *Cluster) Close() error {
+	if c.stop != nil {
+		c.stop()
+		<-c.done
+	}
+
+	dbs := []*gorm.DB{c.primary}
+	for _, r := range c.replicas {
+		dbs = append(dbs, r.db)
+	}
+	for _, db := range dbs {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		if err = sqlDB.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ping(ctx context.Context, db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
@@ -0,0 +1,125 @@
+package connection
+
+import (
+	"context"
+	"errors"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+// newTestDB makes a database that is never connected to.
+func newTestDB(t *testing.T, name string) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.Open("host=localhost dbname="+name), &gorm.Config{DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func newTestCluster(t *testing.T, replicas int) (*Cluster, *gorm.DB, []*gorm.DB) {
+	t.Helper()
+
+	primary := newTestDB(t, "primary")
+	dbs := make([]*gorm.DB, 0, replicas)
+	for i := 0; i < replicas; i++ {
+		dbs = append(dbs, newTestDB(t, "replica"))
+	}
+	return NewCluster(primary, dbs, time.Second), primary, dbs
+}
+
+func TestCluster_Reader(t *testing.T) {
+	c, primary, replicas := newTestCluster(t, 2)
+
+	seen := make(map[*gorm.DB]int)
+	for i := 0; i < 4; i++ {
+		seen[c.Reader(1, time.Time{})]++
+	}
+	if seen[replicas[0]] != 2 || seen[replicas[1]] != 2 {
+		t.Errorf("expected reads spread over both replicas, but got %v", seen)
+	}
+	if seen[primary] != 0 {
+		t.Errorf("expected no reads from the primary, but got %d", seen[primary])
+	}
+}
+
+func TestCluster_noReplicas(t *testing.T) {
+	c, primary, _ := newTestCluster(t, 0)
+
+	c.Written(1)
+	if db := c.Reader(2, time.Now()); db != primary {
+		t.Errorf("expected reads from the primary without replicas")
+	}
+}
+
+func TestCluster_healthChecks(t *testing.T) {
+	c, primary, replicas := newTestCluster(t, 2)
+	down := map[*gorm.DB]bool{replicas[0]: true}
+	c.ping = func(_ context.Context, db *gorm.DB) error {
+		if down[db] {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	c.CheckHealth(context.Background(), time.Second)
+	for i := 0; i < 3; i++ {
+		if db := c.Reader(1, time.Time{}); db != replicas[1] {
+			t.Fatalf("expected reads from the healthy replica only")
+		}
+	}
+
+	down[replicas[1]] = true
+	c.CheckHealth(context.Background(), time.Second)
+	if db := c.Reader(1, time.Time{}); db != primary {
+		t.Errorf("expected reads from the primary when no replica is healthy")
+	}
+
+	down = map[*gorm.DB]bool{}
+	c.CheckHealth(context.Background(), time.Second)
+	seen := make(map[*gorm.DB]bool)
+	for i := 0; i < 2; i++ {
+		seen[c.Reader(1, time.Time{})] = true
+	}
+	if !seen[replicas[0]] || !seen[replicas[1]] {
+		t.Errorf("expected recovered replicas back in rotation")
+	}
+}
+
+func TestCluster_stickiness(t *testing.T) {
+	now := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	c, primary, _ := newTestCluster(t, 1)
+	c.now = func() time.Time { return now }
+
+	c.Written(1)
+	tests := []struct {
+		name      string
+		id        int32
+		lastWrite time.Time
+		primary   bool
+	}{
+		{name: "written person", id: 1, primary: true},
+		{name: "other person", id: 2, primary: false},
+		{name: "many persons", id: 0, primary: false},
+		{name: "other person after a write of the session", id: 2, lastWrite: now, primary: true},
+		{name: "many persons after a write of the session", id: 0, lastWrite: now.Add(-time.Second / 2), primary: true},
+		{name: "old write of the session", id: 0, lastWrite: now.Add(-time.Second), primary: false},
+		{name: "write of the session far in the future", id: 0, lastWrite: now.Add(time.Hour), primary: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Reader(tt.id, tt.lastWrite) == primary; got != tt.primary {
+				t.Errorf("Reader(%d, %v) from the primary expected %v, but got %v", tt.id, tt.lastWrite, tt.primary, got)
+			}
+		})
+	}
+
+	lastWrite := now
+	now = now.Add(time.Second)
+	if c.Reader(1, lastWrite) == primary || c.Reader(0, lastWrite) == primary {
+		t.Errorf("expected reads from the replica after the stickiness window")
+	}
+}
@@ -3,16 +3,58 @@ package connection
 import (
 	"fmt"
 	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/charmbracelet/log"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	"time"
 )
 
+const (
+	minConnectBackoff = 500 * time.Millisecond
+	maxConnectBackoff = 5 * time.Second
+)
+
+// OpenPostgres retries for up to PostgresConnectTimeout, so that the app may
+// start before Postgres.
 func OpenPostgres(cfg config.Config) (*gorm.DB, error) {
-	db, err := gorm.Open(postgres.Open(cfg.PostgresDSN), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	return open(cfg.PostgresDSN, cfg)
+}
+
+func open(dsn string, cfg config.Config) (*gorm.DB, error) {
+	deadline := time.Now().Add(cfg.PostgresConnectTimeout)
+	backoff := minConnectBackoff
+	for attempt := 1; ; attempt++ {
+		db, err := connect(dsn, cfg, false)
+		if err == nil {
+			return db, nil
+		}
+		if time.Now().Add(backoff).After(deadline) {
+			return nil, fmt.Errorf("connect postgres error after %d attempts: %w", attempt, err)
+		}
+
+		log.Warn("postgres is not available, retrying", "attempt", attempt, "backoff", backoff, "err", err)
+		time.Sleep(backoff)
+		backoff = min(2*backoff, maxConnectBackoff)
+	}
+}
+
+func connect(dsn string, cfg config.Config, lazy bool) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger:               newQueryLogger(cfg.SlowQueryThreshold),
+		TranslateError:       true,
+		DisableAutomaticPing: lazy,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("connect postgres error: %w", err)
+		return nil, err
 	}
 
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(cfg.PostgresMaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.PostgresMaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.PostgresConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.PostgresConnMaxIdleTime)
 	return db, nil
 }
@@ -0,0 +1,45 @@
+package connection
+
+import (
+	"context"
+	"github.com/charmbracelet/log"
+	"gorm.io/gorm/logger"
+	"time"
+)
+
+// queryLogger logs queries that take at least slowThreshold, without their
+// parameters, as those are personal data. Other gorm logs are dropped:
+// errors are returned to and logged by the callers.
+type queryLogger struct {
+	slowThreshold time.Duration
+}
+
+func newQueryLogger(slowThreshold time.Duration) logger.Interface {
+	if slowThreshold <= 0 {
+		return logger.Default.LogMode(logger.Silent)
+	}
+	return queryLogger{slowThreshold: slowThreshold}
+}
+
+func (l queryLogger) LogMode(logger.LogLevel) logger.Interface {
+	return l
+}
+
+func (queryLogger) Info(context.Context, string, ...any) {}
+
+func (queryLogger) Warn(context.Context, string, ...any) {}
+
+func (queryLogger) Error(context.Context, string, ...any) {}
+
+func (l queryLogger) Trace(_ context.Context, begin time.Time, fc func() (string, int64), _ error) {
+	elapsed := time.Since(begin)
+	if elapsed < l.slowThreshold {
+		return
+	}
+	sql, rows := fc()
+	log.Warn("slow query", "elapsed", elapsed, "threshold", l.slowThreshold, "rows", rows, "sql", sql)
+}
+
+func (queryLogger) ParamsFilter(_ context.Context, sql string, _ ...any) (string, []any) {
+	return sql, nil
+}
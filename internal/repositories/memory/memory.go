@@ -0,0 +1,481 @@
+// Package memory is an in-memory person storage with the same behaviour as
+// the Postgres one. It is meant for tests and local tooling.
+package memory
+
+import (
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"gorm.io/gorm"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DB keeps the persons of all tenants. Ids are unique across tenants, like
+// the serial ids in Postgres.
+type DB struct {
+	mu                 sync.Mutex
+	nextID             int32
+	nextRelationID     int32
+	nextOrganizationID int32
+	nextEmploymentID   int32
+	lastTS             time.Time
+	// open holds back the changes of writes in progress and the later ones
+	// from the feed, like Postgres does with running transactions. Writes
+	// here are done at once, tests open some to stand for slow ones.
+	open    map[int64]bool
+	tenants map[string]*storage
+}
+
+func NewDB() *DB {
+	return &DB{open: make(map[int64]bool), tenants: make(map[string]*storage)}
+}
+
+func (db *DB) Tenant(tenant string) *storage {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	s, ok := db.tenants[tenant]
+	if !ok {
+		s = &storage{
+			db:            db,
+			persons:       make(map[int32]models.Person),
+			tombstones:    make(map[int32]time.Time),
+			merges:        make(map[int32]int32),
+			relations:     make(map[int32]models.PersonRelation),
+			organizations: make(map[int32]models.Organization),
+			employments:   make(map[int32]models.Employment),
+		}
+		db.tenants[tenant] = s
+	}
+	return s
+}
+
+type storage struct {
+	db      *DB
+	persons map[int32]models.Person
+	// tombstones are the times persons were deleted at, by id.
+	tombstones map[int32]time.Time
+	// merges maps merged-away ids to the surviving ones.
+	merges        map[int32]int32
+	relations     map[int32]models.PersonRelation
+	organizations map[int32]models.Organization
+	employments   map[int32]models.Employment
+}
+
+func NewStorage() *storage {
+	return NewDB().Tenant(models.DefaultTenant)
+}
+
+// now returns strictly increasing timestamps, so (updated_at, id) order
+// matches the order of writes like it does in Postgres.
+func (s *storage) now() time.Time {
+	ts := time.Now().UTC().Truncate(time.Microsecond)
+	if !ts.After(s.db.lastTS) {
+		ts = s.db.lastTS.Add(time.Microsecond)
+	}
+	s.db.lastTS = ts
+	return ts
+}
+
+// version orders changes, as now is strictly increasing.
+func version(ts time.Time) int64 {
+	return ts.UnixMicro()
+}
+
+// horizon is the version below which all writes have finished.
+func (db *DB) horizon() int64 {
+	var horizon int64 = math.MaxInt64
+	for v := range db.open {
+		horizon = min(horizon, v)
+	}
+	return horizon
+}
+
+func (s *storage) all() []models.Person {
+	res := make([]models.Person, 0, len(s.persons))
+	for _, p := range s.persons {
+		res = append(res, p)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+func (s *storage) Session(time.Time) repositories.Storage {
+	return s
+}
+
+func (s *storage) GetAllPerson() ([]models.Person, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	return s.all(), nil
+}
+
+func (s *storage) CreatePerson(person models.Person) (models.Person, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	if s.emailTaken(person.Email, 0) {
+		return models.Person{}, fmt.Errorf("error creating person: %w", gorm.ErrDuplicatedKey)
+	}
+	return s.create(person), nil
+}
+
+// emailTaken mirrors the case-insensitive unique index on persons.email.
+func (s *storage) emailTaken(email string, exceptID int32) bool {
+	if email == "" {
+		return false
+	}
+	for id, p := range s.persons {
+		if id != exceptID && strings.EqualFold(p.Email, email) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *storage) create(person models.Person) models.Person {
+	s.db.nextID++
+	person.ID = s.db.nextID
+	person.CreatedAt = s.now()
+	person.UpdatedAt = person.CreatedAt
+	s.persons[person.ID] = person
+	s.employ(person.ID, person.Work)
+	return s.persons[person.ID]
+}
+
+func (s *storage) CreatePersons(persons []models.Person) ([]models.Person, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	emails := make(map[string]bool, len(persons))
+	for _, p := range persons {
+		email := strings.ToLower(p.Email)
+		if s.emailTaken(email, 0) || email != "" && emails[email] {
+			return nil, fmt.Errorf("error creating persons: %w", gorm.ErrDuplicatedKey)
+		}
+		emails[email] = true
+	}
+
+	for i := range persons {
+		persons[i] = s.create(persons[i])
+	}
+	return persons, nil
+}
+
+func (s *storage) GetPersonByID(id int32) (models.Person, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	p, ok := s.persons[id]
+	if !ok {
+		return models.Person{}, fmt.Errorf("error getting person by id: %w", gorm.ErrRecordNotFound)
+	}
+	return p, nil
+}
+
+func (s *storage) GetPersonsByIDs(ids []int32) ([]models.Person, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	var res []models.Person
+	for _, id := range ids {
+		if p, ok := s.persons[id]; ok {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+func (s *storage) ListPersons(q models.PersonQuery) ([]models.Person, error) {
+	if !q.SortBy.Valid() {
+		return nil, fmt.Errorf("error listing persons: unknown sort field %q", q.SortBy)
+	}
+
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	var res []models.Person
+	for _, p := range s.all() {
+		if matches(p, q.Filter) {
+			res = append(res, p)
+		}
+	}
+
+	less := func(a, b models.Person) bool {
+		if c := compare(q.SortBy, a, b); c != 0 {
+			return c < 0
+		}
+		return a.ID < b.ID
+	}
+	if q.Desc {
+		asc := less
+		less = func(a, b models.Person) bool { return asc(b, a) }
+	}
+	sort.SliceStable(res, func(i, j int) bool { return less(res[i], res[j]) })
+
+	if q.After != nil {
+		page := make([]models.Person, 0, len(res))
+		for _, p := range res {
+			if afterCursor(q, p) {
+				page = append(page, p)
+			}
+		}
+		res = page
+	}
+
+	if q.Limit > 0 && len(res) > q.Limit {
+		res = res[:q.Limit]
+	}
+	return res, nil
+}
+
+func (s *storage) DeletePersonByID(id int32) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	if _, ok := s.persons[id]; ok {
+		delete(s.persons, id)
+		s.tombstones[id] = s.now()
+	}
+	for merged, survivor := range s.merges {
+		if survivor == id {
+			delete(s.merges, merged)
+		}
+	}
+	s.deleteRelationsOf(id)
+	s.deleteEmploymentsOf(id)
+	return nil
+}
+
+func (s *storage) UpdatePersonByID(id int32, person models.Person) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	p, ok := s.persons[id]
+	if !ok {
+		return fmt.Errorf("error updating person: %w", gorm.ErrRecordNotFound)
+	}
+	if s.emailTaken(person.Email, id) {
+		return fmt.Errorf("error updating person: %w", gorm.ErrDuplicatedKey)
+	}
+	if person.Name != "" {
+		p.Name = person.Name
+	}
+	if person.Age != 0 {
+		p.Age = person.Age
+	}
+	if person.Email != "" {
+		p.Email = person.Email
+	}
+	if person.Phone != "" {
+		p.Phone = person.Phone
+	}
+	if person.BirthDate != nil {
+		p.BirthDate = person.BirthDate
+	}
+	if person.Address != "" {
+		p.Address = person.Address
+	}
+	updateString(&p.PostalAddress.Country, person.PostalAddress.Country)
+	updateString(&p.PostalAddress.City, person.PostalAddress.City)
+	updateString(&p.PostalAddress.Street, person.PostalAddress.Street)
+	updateString(&p.PostalAddress.PostalCode, person.PostalAddress.PostalCode)
+	if person.Work != "" {
+		p.Work = person.Work
+	}
+	p.UpdatedAt = s.now()
+	s.persons[id] = p
+	if person.Work != "" {
+		s.employ(id, person.Work)
+	}
+	return nil
+}
+
+func (s *storage) GetPersonsUpdatedSince(since time.Time, after *models.PersonCursor, limit int) ([]models.PersonChange, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	changes := make([]models.PersonChange, 0, len(s.persons)+len(s.tombstones))
+	for _, p := range s.persons {
+		changes = append(changes, models.PersonChange{Person: p, Version: version(p.UpdatedAt)})
+	}
+	for id, deletedAt := range s.tombstones {
+		changes = append(changes, models.PersonChange{
+			Person: models.Person{ID: id, UpdatedAt: deletedAt}, Deleted: true, Version: version(deletedAt),
+		})
+	}
+	models.SortPersonChanges(changes)
+
+	horizon := s.db.horizon()
+	res := []models.PersonChange{}
+	for _, c := range changes {
+		if c.Version >= horizon {
+			break
+		}
+		if c.UpdatedAt.Before(since) || after != nil && !c.After(*after) {
+			continue
+		}
+		res = append(res, c)
+		if len(res) == limit {
+			break
+		}
+	}
+	return res, nil
+}
+
+// updateString mimics gorm Updates, which skips zero values.
+func updateString(dst *string, src string) {
+	if src != "" {
+		*dst = src
+	}
+}
+
+// GetDuplicateCandidates returns all other persons with a similar name,
+// approximating the pg_trgm % operator.
+func (s *storage) GetDuplicateCandidates(person models.Person, limit int) ([]models.Person, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	var res []models.Person
+	for _, p := range s.all() {
+		if p.ID != person.ID && models.TrigramSimilarity(p.Name, person.Name) >= 0.3 {
+			res = append(res, p)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return models.TrigramSimilarity(res[i].Name, person.Name) > models.TrigramSimilarity(res[j].Name, person.Name)
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func (s *storage) MergePersons(merge models.PersonMerge) (models.Person, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	persons := make([]models.Person, 0, len(merge.IDs()))
+	for _, id := range merge.IDs() {
+		p, ok := s.persons[id]
+		if !ok {
+			return models.Person{}, fmt.Errorf("error merging persons: %w", gorm.ErrRecordNotFound)
+		}
+		persons = append(persons, p)
+	}
+
+	merged, err := merge.Apply(persons)
+	if err != nil {
+		return models.Person{}, fmt.Errorf("error merging persons: %w", err)
+	}
+	for _, id := range merge.SourceIDs {
+		delete(s.persons, id)
+	}
+	if s.emailTaken(merged.Email, merged.ID) {
+		for _, p := range persons[1:] {
+			s.persons[p.ID] = p
+		}
+		return models.Person{}, fmt.Errorf("error merging persons: %w", gorm.ErrDuplicatedKey)
+	}
+
+	merged.UpdatedAt = s.now()
+	s.persons[merged.ID] = merged
+	for _, id := range merge.SourceIDs {
+		s.tombstones[id] = merged.UpdatedAt
+	}
+	s.mergeRelations(merge)
+	employed := false
+	for id, e := range s.employments {
+		if slices.Contains(merge.IDs(), e.PersonID) {
+			e.PersonID = merged.ID
+			s.employments[id] = e
+			employed = true
+		}
+	}
+	if employed {
+		s.refreshWork(merged.ID)
+		merged = s.persons[merged.ID]
+	}
+	for _, source := range merge.SourceIDs {
+		for id, survivor := range s.merges {
+			if survivor == source {
+				s.merges[id] = merged.ID
+			}
+		}
+		s.merges[source] = merged.ID
+	}
+	return merged, nil
+}
+
+func (s *storage) GetMergedPersonID(id int32) (int32, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	survivor, ok := s.merges[id]
+	if !ok {
+		return 0, fmt.Errorf("error getting merged person: %w", gorm.ErrRecordNotFound)
+	}
+	return survivor, nil
+}
+
+func matches(p models.Person, f models.PersonFilter) bool {
+	contains := func(s, sub string) bool {
+		return sub == "" || strings.Contains(strings.ToLower(s), strings.ToLower(sub))
+	}
+	address := f.Address == "" ||
+		contains(p.Address, f.Address) ||
+		contains(p.PostalAddress.Country, f.Address) ||
+		contains(p.PostalAddress.City, f.Address) ||
+		contains(p.PostalAddress.Street, f.Address)
+	age := p.CurrentAge()
+	return contains(p.Name, f.Name) &&
+		address &&
+		contains(p.Work, f.Work) &&
+		(f.MinAge == 0 || age >= f.MinAge) &&
+		(f.MaxAge == 0 || age <= f.MaxAge)
+}
+
+func compare(field models.PersonSortField, a, b models.Person) int {
+	switch field {
+	case models.PersonSortByName:
+		return strings.Compare(a.Name, b.Name)
+	case models.PersonSortByAge:
+		return int(a.CurrentAge()) - int(b.CurrentAge())
+	case models.PersonSortByCreatedAt:
+		return a.CreatedAt.Compare(b.CreatedAt)
+	case models.PersonSortByUpdatedAt:
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	default:
+		return int(a.ID) - int(b.ID)
+	}
+}
+
+func afterCursor(q models.PersonQuery, p models.Person) bool {
+	value, err := q.SortBy.ParseValue(q.After.Value)
+	if err != nil {
+		return false
+	}
+
+	var anchor models.Person
+	anchor.ID = q.After.ID
+	switch v := value.(type) {
+	case string:
+		anchor.Name = v
+	case int32:
+		if q.SortBy == models.PersonSortByAge {
+			anchor.Age = v
+		} else {
+			anchor.ID = v
+		}
+	case time.Time:
+		anchor.CreatedAt, anchor.UpdatedAt = v, v
+	}
+
+	c := compare(q.SortBy, p, anchor)
+	if c == 0 {
+		c = int(p.ID) - int(q.After.ID)
+	}
+	if q.Desc {
+		return c < 0
+	}
+	return c > 0
+}
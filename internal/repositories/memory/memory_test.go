@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/conformance"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDB_tenants(t *testing.T) {
+	conformance.Run(t, func(*testing.T) (repositories.Storage, repositories.Storage) {
+		db := NewDB()
+		return db.Tenant("acme"), db.Tenant("globex")
+	})
+}
+
+func TestStorage_relations(t *testing.T) {
+	conformance.RunRelations(t, func(*testing.T) repositories.Storage {
+		return NewStorage()
+	})
+}
+
+func TestStorage_organizations(t *testing.T) {
+	conformance.RunOrganizations(t, func(*testing.T) repositories.Storage {
+		return NewStorage()
+	})
+}
+
+func TestStorage_changes(t *testing.T) {
+	conformance.RunChanges(t, func(*testing.T) repositories.Storage {
+		return NewStorage()
+	})
+}
+
+// TestStorage_lateCommit checks that a write that commits after a later one
+// is not skipped by a reader that paged past the later one.
+func TestStorage_lateCommit(t *testing.T) {
+	s := NewStorage()
+	late, err := s.CreatePerson(models.Person{Name: "late"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := s.CreatePerson(models.Person{Name: "other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The late write starts first, but commits after the other.
+	ts := s.now()
+	s.db.open[version(ts)] = true
+	if err = s.UpdatePersonByID(other.ID, models.Person{Name: "other write"}); err != nil {
+		t.Fatal(err)
+	}
+
+	page, err := s.GetPersonsUpdatedSince(time.Time{}, nil, 100)
+	if err != nil || len(page) != 1 || page[0].Name != "late" {
+		t.Fatalf("GetPersonsUpdatedSince() expected the person before the late write, but got %v, %v", page, err)
+	}
+	cursor := models.NewPersonCursor(page[0])
+	p := s.persons[late.ID]
+	p.Name, p.UpdatedAt = "late write", ts
+	s.persons[late.ID] = p
+	delete(s.db.open, version(ts))
+
+	page, err = s.GetPersonsUpdatedSince(time.Time{}, &cursor, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(page))
+	for _, p := range page {
+		names = append(names, p.Name)
+	}
+	if strings.Join(names, ",") != "late write,other write" {
+		t.Errorf("next page expected both writes, but got %v", names)
+	}
+}
+
+func TestStorage_RefreshWork(t *testing.T) {
+	s := NewStorage()
+	p, err := s.CreatePerson(models.Person{Name: "Ivan", Work: "Sber"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	employments, err := s.GetEmployments(p.ID)
+	if err != nil || len(employments) != 1 {
+		t.Fatalf("one employment expected, but got %v, %v", employments, err)
+	}
+
+	// The employment ends as days pass, without a write.
+	e := employments[0]
+	yesterday := models.Today(time.Now()).AddDate(0, 0, -1)
+	e.EndDate = &yesterday
+	s.employments[e.ID] = e
+
+	ids, err := s.RefreshWork()
+	if err != nil || len(ids) != 1 || ids[0] != p.ID {
+		t.Fatalf("RefreshWork() expected [%d], but got %v, %v", p.ID, ids, err)
+	}
+	refreshed, err := s.GetPersonByID(p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed.Work != "" {
+		t.Errorf("work expected to be empty, but got %q", refreshed.Work)
+	}
+	if !refreshed.UpdatedAt.After(p.UpdatedAt) {
+		t.Errorf("updated at expected after %v, but got %v", p.UpdatedAt, refreshed.UpdatedAt)
+	}
+}
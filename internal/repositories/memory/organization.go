@@ -0,0 +1,290 @@
+package memory
+
+import (
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"gorm.io/gorm"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+func (s *storage) ListOrganizations(name string) ([]models.Organization, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	normalized := models.NormalizeOrganizationName(name)
+	res := []models.Organization{}
+	for _, o := range s.organizations {
+		if strings.Contains(models.NormalizeOrganizationName(o.Name), normalized) {
+			res = append(res, o)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+// organizationNamed returns the organization that name normalizes to the
+// key of, mirroring the unique index on normalized names.
+func (s *storage) organizationNamed(name string) (models.Organization, bool) {
+	normalized := models.NormalizeOrganizationName(name)
+	for _, o := range s.organizations {
+		if models.NormalizeOrganizationName(o.Name) == normalized {
+			return o, true
+		}
+	}
+	return models.Organization{}, false
+}
+
+func (s *storage) CreateOrganization(organization models.Organization) (models.Organization, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if models.NormalizeOrganizationName(organization.Name) == "" {
+		return models.Organization{}, fmt.Errorf("error creating organization: %w", gorm.ErrCheckConstraintViolated)
+	}
+	if _, ok := s.organizationNamed(organization.Name); ok {
+		return models.Organization{}, fmt.Errorf("error creating organization: %w", gorm.ErrDuplicatedKey)
+	}
+	return s.createOrganization(organization), nil
+}
+
+func (s *storage) createOrganization(organization models.Organization) models.Organization {
+	s.db.nextOrganizationID++
+	organization.ID = s.db.nextOrganizationID
+	organization.CreatedAt = s.now()
+	organization.UpdatedAt = organization.CreatedAt
+	s.organizations[organization.ID] = organization
+	return organization
+}
+
+func (s *storage) GetOrganizationByID(id int32) (models.Organization, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	o, ok := s.organizations[id]
+	if !ok {
+		return models.Organization{}, fmt.Errorf("error getting organization by id: %w", gorm.ErrRecordNotFound)
+	}
+	return o, nil
+}
+
+func (s *storage) UpdateOrganizationByID(id int32, organization models.Organization) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	o, ok := s.organizations[id]
+	if !ok {
+		return fmt.Errorf("error updating organization: %w", gorm.ErrRecordNotFound)
+	}
+	if models.NormalizeOrganizationName(organization.Name) == "" {
+		return fmt.Errorf("error updating organization: %w", gorm.ErrCheckConstraintViolated)
+	}
+	if other, ok := s.organizationNamed(organization.Name); ok && other.ID != id {
+		return fmt.Errorf("error updating organization: %w", gorm.ErrDuplicatedKey)
+	}
+	o.Name = organization.Name
+	o.UpdatedAt = s.now()
+	s.organizations[id] = o
+
+	var personIDs []int32
+	for _, e := range s.employments {
+		if e.OrganizationID == id {
+			personIDs = append(personIDs, e.PersonID)
+		}
+	}
+	s.refreshWork(personIDs...)
+	return nil
+}
+
+func (s *storage) DeleteOrganizationByID(id int32) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if _, ok := s.organizations[id]; !ok {
+		return fmt.Errorf("error deleting organization: %w", gorm.ErrRecordNotFound)
+	}
+	// Mirrors the foreign key of employments, which restricts deletes.
+	for _, e := range s.employments {
+		if e.OrganizationID == id {
+			return fmt.Errorf("error deleting organization: %w", gorm.ErrForeignKeyViolated)
+		}
+	}
+	delete(s.organizations, id)
+	return nil
+}
+
+func (s *storage) GetEmployments(personID int32) ([]models.Employment, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	return s.employmentsOf(personID), nil
+}
+
+func (s *storage) employmentsOf(personID int32) []models.Employment {
+	res := []models.Employment{}
+	for _, e := range s.employments {
+		if e.PersonID == personID {
+			res = append(res, e)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+func (s *storage) GetOrganizationEmployments(organizationID int32) ([]models.Employment, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	res := []models.Employment{}
+	for _, e := range s.employments {
+		if e.OrganizationID == organizationID {
+			res = append(res, e)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+func (s *storage) CreateEmployment(employment models.Employment) (models.Employment, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	// Mirrors the foreign keys and the check of employments.
+	_, personOK := s.persons[employment.PersonID]
+	_, organizationOK := s.organizations[employment.OrganizationID]
+	if !personOK || !organizationOK {
+		return models.Employment{}, fmt.Errorf("error creating employment: %w", gorm.ErrForeignKeyViolated)
+	}
+	if employment.Validate() != nil {
+		return models.Employment{}, fmt.Errorf("error creating employment: %w", gorm.ErrCheckConstraintViolated)
+	}
+
+	employment = s.createEmployment(employment)
+	s.refreshWork(employment.PersonID)
+	return employment, nil
+}
+
+func (s *storage) createEmployment(employment models.Employment) models.Employment {
+	s.db.nextEmploymentID++
+	employment.ID = s.db.nextEmploymentID
+	employment.StartDate = date(employment.StartDate)
+	employment.EndDate = date(employment.EndDate)
+	employment.CreatedAt = time.Now().UTC()
+	s.employments[employment.ID] = employment
+	return employment
+}
+
+func date(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	d := models.Today(*t)
+	return &d
+}
+
+func (s *storage) UpdateEmployment(personID, employmentID int32, employment models.Employment) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	e, ok := s.employments[employmentID]
+	if !ok || e.PersonID != personID {
+		return fmt.Errorf("error updating employment: %w", gorm.ErrRecordNotFound)
+	}
+	if _, ok = s.organizations[employment.OrganizationID]; !ok {
+		return fmt.Errorf("error updating employment: %w", gorm.ErrForeignKeyViolated)
+	}
+	e.OrganizationID = employment.OrganizationID
+	e.Role = employment.Role
+	e.StartDate = date(employment.StartDate)
+	e.EndDate = date(employment.EndDate)
+	if e.Validate() != nil {
+		return fmt.Errorf("error updating employment: %w", gorm.ErrCheckConstraintViolated)
+	}
+	s.employments[employmentID] = e
+	s.refreshWork(personID)
+	return nil
+}
+
+func (s *storage) DeleteEmployment(personID, employmentID int32) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	e, ok := s.employments[employmentID]
+	if !ok || e.PersonID != personID {
+		return fmt.Errorf("error deleting employment: %w", gorm.ErrRecordNotFound)
+	}
+	delete(s.employments, employmentID)
+	s.refreshWork(personID)
+	return nil
+}
+
+// deleteEmploymentsOf mirrors the cascade of deleted persons to their
+// employments.
+func (s *storage) deleteEmploymentsOf(ids ...int32) {
+	for id, e := range s.employments {
+		if slices.Contains(ids, e.PersonID) {
+			delete(s.employments, id)
+		}
+	}
+}
+
+// employ links the person to the organization named by work, like the
+// Postgres storage does for clients that still write work.
+func (s *storage) employ(personID int32, work string) {
+	name := strings.TrimSpace(work)
+	if models.NormalizeOrganizationName(name) == "" {
+		return
+	}
+
+	o, ok := s.organizationNamed(name)
+	if !ok {
+		o = s.createOrganization(models.Organization{Name: name})
+	}
+	now := time.Now()
+	for _, e := range s.employments {
+		if e.PersonID == personID && e.OrganizationID == o.ID && e.CurrentAt(now) {
+			s.refreshWork(personID)
+			return
+		}
+	}
+	today := models.Today(now)
+	s.createEmployment(models.Employment{PersonID: personID, OrganizationID: o.ID, StartDate: &today})
+	s.refreshWork(personID)
+}
+
+// RefreshWork catches up with employments that ended since the work of
+// their persons was derived, which no write does.
+func (s *storage) RefreshWork() ([]int32, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	personIDs := make([]int32, 0, len(s.persons))
+	for id := range s.persons {
+		personIDs = append(personIDs, id)
+	}
+	sort.Slice(personIDs, func(i, j int) bool { return personIDs[i] < personIDs[j] })
+	return s.refreshWork(personIDs...), nil
+}
+
+// refreshWork touches only the persons whose work changes, and returns them.
+func (s *storage) refreshWork(personIDs ...int32) []int32 {
+	var changed []int32
+	for _, id := range personIDs {
+		p, ok := s.persons[id]
+		if !ok {
+			continue
+		}
+		work := ""
+		if e, ok := models.CurrentEmployment(s.employmentsOf(id), time.Now()); ok {
+			work = s.organizations[e.OrganizationID].Name
+		}
+		if p.Work != work {
+			p.Work = work
+			p.UpdatedAt = s.now()
+			s.persons[id] = p
+			changed = append(changed, id)
+		}
+	}
+	return changed
+}
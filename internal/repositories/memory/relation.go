@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"gorm.io/gorm"
+	"slices"
+	"sort"
+	"time"
+)
+
+func (s *storage) CreateRelation(relation models.PersonRelation) (models.PersonRelation, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	// Mirrors the foreign keys and unique indexes of person_relations.
+	_, fromOK := s.persons[relation.FromID]
+	_, toOK := s.persons[relation.ToID]
+	if !fromOK || !toOK {
+		return models.PersonRelation{}, fmt.Errorf("error creating relation: %w", gorm.ErrForeignKeyViolated)
+	}
+	for _, r := range s.relations {
+		if r.Type != relation.Type {
+			continue
+		}
+		if r.FromID == relation.FromID && r.ToID == relation.ToID ||
+			r.Bidirectional && relation.Bidirectional && r.Connects(relation.FromID, relation.ToID) {
+			return models.PersonRelation{}, fmt.Errorf("error creating relation: %w", gorm.ErrDuplicatedKey)
+		}
+	}
+
+	s.db.nextRelationID++
+	relation.ID = s.db.nextRelationID
+	relation.CreatedAt = time.Now().UTC()
+	s.relations[relation.ID] = relation
+	return relation, nil
+}
+
+func (s *storage) DeleteRelation(personID, relationID int32) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	r, ok := s.relations[relationID]
+	if !ok || r.FromID != personID && r.ToID != personID {
+		return fmt.Errorf("error deleting relation: %w", gorm.ErrRecordNotFound)
+	}
+	delete(s.relations, relationID)
+	return nil
+}
+
+// deleteRelationsOf mirrors the cascade of deleted persons to their
+// relations.
+func (s *storage) deleteRelationsOf(ids ...int32) {
+	for id, r := range s.relations {
+		if slices.Contains(ids, r.FromID) || slices.Contains(ids, r.ToID) {
+			delete(s.relations, id)
+		}
+	}
+}
+
+func (s *storage) mergeRelations(merge models.PersonMerge) {
+	var relations []models.PersonRelation
+	for _, r := range s.relations {
+		if slices.Contains(merge.IDs(), r.FromID) || slices.Contains(merge.IDs(), r.ToID) {
+			relations = append(relations, r)
+		}
+	}
+	changed, dropped := merge.MergeRelations(relations)
+	for _, id := range dropped {
+		delete(s.relations, id)
+	}
+	for _, r := range changed {
+		s.relations[r.ID] = r
+	}
+}
+
+func (s *storage) GetRelatedPersons(q models.RelationQuery) ([]models.RelatedPerson, error) {
+	if !q.Direction.Valid() {
+		return nil, fmt.Errorf("error getting related persons: unknown direction %q", q.Direction)
+	}
+
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	relations := make([]models.PersonRelation, 0, len(s.relations))
+	for _, r := range s.relations {
+		relations = append(relations, r)
+	}
+	sort.Slice(relations, func(i, j int) bool { return relations[i].ID < relations[j].ID })
+
+	walk := models.NewRelationWalk(q)
+	for len(walk.Frontier()) > 0 {
+		walk.Step(relations)
+	}
+	related := walk.Related()
+	for i := range related {
+		related[i].Person = s.persons[related[i].Person.ID]
+	}
+	return related, nil
+}
@@ -0,0 +1,271 @@
+package person
+
+import (
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+const (
+	organizationTable = "organizations"
+	employmentTable   = "employments"
+)
+
+type organizationRow struct {
+	models.Organization `gorm:"embedded"`
+	TenantID            string
+}
+
+type employmentRow struct {
+	models.Employment `gorm:"embedded"`
+	TenantID          string
+}
+
+func (s *storage) organizations(db *gorm.DB) *gorm.DB {
+	return db.Table(s.table(organizationTable)).Where("tenant_id = ?", s.tenant.ID)
+}
+
+func (s *storage) employments(db *gorm.DB) *gorm.DB {
+	return db.Table(s.table(employmentTable)).Where("tenant_id = ?", s.tenant.ID)
+}
+
+func (s *storage) ListOrganizations(name string) ([]models.Organization, error) {
+	query := s.organizations(s.reader(0))
+	if name != "" {
+		query = query.Where("normalized_name LIKE ?", "%"+escapeLike(models.NormalizeOrganizationName(name))+"%")
+	}
+
+	organizations := []models.Organization{}
+	if err := query.Order("id").Find(&organizations).Error; err != nil {
+		return nil, fmt.Errorf("error listing organizations: %w", err)
+	}
+	return organizations, nil
+}
+
+func (s *storage) CreateOrganization(organization models.Organization) (models.Organization, error) {
+	now := time.Now().UTC()
+	organization.ID = 0
+	organization.CreatedAt = now
+	organization.UpdatedAt = now
+
+	row := organizationRow{Organization: organization, TenantID: s.tenant.ID}
+	if err := s.db.Table(s.table(organizationTable)).Create(&row).Error; err != nil {
+		return models.Organization{}, fmt.Errorf("error creating organization: %w", err)
+	}
+	return row.Organization, nil
+}
+
+func (s *storage) GetOrganizationByID(id int32) (models.Organization, error) {
+	var organization models.Organization
+	if err := s.organizations(s.reader(0)).Where("id = ?", id).Take(&organization).Error; err != nil {
+		return models.Organization{}, fmt.Errorf("error getting organization by id: %w", err)
+	}
+	return organization, nil
+}
+
+func (s *storage) UpdateOrganizationByID(id int32, organization models.Organization) error {
+	now := time.Now().UTC()
+	var personIDs []int32
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		res := s.organizations(tx).Where("id = ?", id).
+			Updates(map[string]any{"name": organization.Name, "updated_at": now})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		err := s.employments(tx).Where("organization_id = ?", id).Distinct().Pluck("person_id", &personIDs).Error
+		if err != nil {
+			return err
+		}
+		return s.refreshWork(tx, now, personIDs...)
+	})
+	if err != nil {
+		return fmt.Errorf("error updating organization: %w", err)
+	}
+	s.written(personIDs...)
+	return nil
+}
+
+func (s *storage) DeleteOrganizationByID(id int32) error {
+	res := s.organizations(s.db).Where("id = ?", id).Delete(&models.Organization{})
+	if res.Error != nil {
+		return fmt.Errorf("error deleting organization: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("error deleting organization: %w", gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+func (s *storage) GetEmployments(personID int32) ([]models.Employment, error) {
+	employments := []models.Employment{}
+	err := s.employments(s.reader(personID)).Where("person_id = ?", personID).Order("id").Find(&employments).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting employments: %w", err)
+	}
+	return employments, nil
+}
+
+func (s *storage) GetOrganizationEmployments(organizationID int32) ([]models.Employment, error) {
+	employments := []models.Employment{}
+	err := s.employments(s.reader(0)).Where("organization_id = ?", organizationID).Order("id").Find(&employments).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting organization employments: %w", err)
+	}
+	return employments, nil
+}
+
+func (s *storage) CreateEmployment(employment models.Employment) (models.Employment, error) {
+	now := time.Now().UTC()
+	employment.ID = 0
+	employment.CreatedAt = now
+
+	row := employmentRow{Employment: employment, TenantID: s.tenant.ID}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(s.table(employmentTable)).Create(&row).Error; err != nil {
+			return err
+		}
+		return s.refreshWork(tx, now, employment.PersonID)
+	})
+	if err != nil {
+		return models.Employment{}, fmt.Errorf("error creating employment: %w", err)
+	}
+	s.written(employment.PersonID)
+	return row.Employment, nil
+}
+
+func (s *storage) UpdateEmployment(personID, employmentID int32, employment models.Employment) error {
+	now := time.Now().UTC()
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		res := s.employments(tx).Where("id = ? AND person_id = ?", employmentID, personID).
+			Select("organization_id", "role", "start_date", "end_date").Updates(&employment)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return s.refreshWork(tx, now, personID)
+	})
+	if err != nil {
+		return fmt.Errorf("error updating employment: %w", err)
+	}
+	s.written(personID)
+	return nil
+}
+
+func (s *storage) DeleteEmployment(personID, employmentID int32) error {
+	now := time.Now().UTC()
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		res := s.employments(tx).Where("id = ? AND person_id = ?", employmentID, personID).Delete(&models.Employment{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return s.refreshWork(tx, now, personID)
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting employment: %w", err)
+	}
+	s.written(personID)
+	return nil
+}
+
+// employ keeps the free-text work of clients that still write it: the
+// person is employed by the organization with that name, created if needed.
+// Work that names no organization, like punctuation only, is left as it is.
+func (s *storage) employ(tx *gorm.DB, now time.Time, personID int32, work string) (string, error) {
+	name := strings.TrimSpace(work)
+	if models.NormalizeOrganizationName(name) == "" {
+		return work, nil
+	}
+
+	err := tx.Exec("insert into "+s.table(organizationTable)+" (tenant_id, name, created_at, updated_at) values (?, ?, ?, ?)"+
+		" on conflict (tenant_id, normalized_name) do nothing", s.tenant.ID, name, now, now).Error
+	if err != nil {
+		return "", err
+	}
+	var organization models.Organization
+	err = s.organizations(tx).
+		Where("normalized_name = "+s.table("normalize_organization_name")+"(?)", name).
+		Take(&organization).Error
+	if err != nil {
+		return "", err
+	}
+
+	var current int64
+	err = s.employments(tx).
+		Where("person_id = ? AND organization_id = ?", personID, organization.ID).
+		Where("end_date IS NULL OR end_date >= ?", models.Today(now)).
+		Count(&current).Error
+	if err != nil {
+		return "", err
+	}
+	if current == 0 {
+		today := models.Today(now)
+		row := employmentRow{
+			Employment: models.Employment{PersonID: personID, OrganizationID: organization.ID, StartDate: &today, CreatedAt: now},
+			TenantID:   s.tenant.ID,
+		}
+		if err = tx.Table(s.table(employmentTable)).Create(&row).Error; err != nil {
+			return "", err
+		}
+	}
+
+	if err = s.refreshWork(tx, now, personID); err != nil {
+		return "", err
+	}
+	err = s.persons(tx).Where("id = ?", personID).Pluck("work", &work).Error
+	return work, err
+}
+
+// RefreshWork catches up with employments that ended since the work of
+// their persons was derived, which no write does.
+func (s *storage) RefreshWork() ([]int32, error) {
+	now := time.Now().UTC()
+	var personIDs []int32
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Raw(s.workUpdate("")+" returning p.id", now, models.Today(now), s.tenant.ID, s.tenant.ID).
+			Scan(&personIDs).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing work: %w", err)
+	}
+	s.written(personIDs...)
+	return personIDs, nil
+}
+
+// refreshWork touches only the persons whose work changes.
+func (s *storage) refreshWork(tx *gorm.DB, now time.Time, personIDs ...int32) error {
+	if len(personIDs) == 0 {
+		return nil
+	}
+	return tx.Exec(s.workUpdate(" and q.id in ?"), now, models.Today(now), s.tenant.ID, personIDs, s.tenant.ID).Error
+}
+
+// workUpdate derives the work of the persons of the tenant matching where
+// from their current employment.
+func (s *storage) workUpdate(where string) string {
+	return `update ` + s.table(personTable) + ` p
+set work = d.work, updated_at = ?
+from (
+	select q.id, coalesce((
+		select o.name
+		from ` + s.table(employmentTable) + ` e
+		join ` + s.table(organizationTable) + ` o on o.tenant_id = e.tenant_id and o.id = e.organization_id
+		where e.tenant_id = q.tenant_id and e.person_id = q.id and (e.end_date is null or e.end_date >= ?)
+		order by e.start_date desc nulls last, e.id desc
+		limit 1
+	), '') as work
+	from ` + s.table(personTable) + ` q
+	where q.tenant_id = ?` + where + `
+) d
+where p.tenant_id = ? and p.id = d.id and p.work <> d.work`
+}
@@ -1,24 +1,119 @@
 package person
 
 import (
+	"database/sql"
 	"fmt"
 	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/connection"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"strings"
+	"time"
 )
 
-const personTable = "persons"
+const (
+	personTable          = "persons"
+	personMergeTable     = "person_merges"
+	personTombstoneTable = "person_tombstones"
+)
+
+type personMerge struct {
+	TenantID   string
+	MergedID   int32
+	SurvivorID int32
+	MergedAt   time.Time
+}
+
+// ageExpr is the current age: derived from birth_date when it is known and
+// the legacy age column otherwise.
+const ageExpr = "coalesce(date_part('year', age(birth_date))::int, age)"
+
+// Tenant scopes a storage: every query is filtered by the id of the tenant,
+// and rows are written with it. Schema, when set, is the Postgres schema
+// with the tables of the tenant.
+type Tenant struct {
+	ID     string
+	Schema string
+}
+
+type personRow struct {
+	models.Person `gorm:"embedded"`
+	TenantID      string
+}
+
+type personChangeRow struct {
+	models.Person `gorm:"embedded"`
+	Version       int64
+}
+
+type tombstoneRow struct {
+	ID        int32
+	DeletedAt time.Time
+	Version   int64
+}
 
 type storage struct {
 	db *gorm.DB
+	// cluster routes reads by id to replicas, when there are any.
+	cluster   *connection.Cluster
+	tenant    Tenant
+	lastWrite time.Time
+}
+
+func NewStorage(db *gorm.DB, tenant Tenant) *storage {
+	return &storage{db: db, tenant: tenant}
+}
+
+func NewReplicatedStorage(cluster *connection.Cluster, tenant Tenant) *storage {
+	return &storage{db: cluster.Primary(), cluster: cluster, tenant: tenant}
+}
+
+func (s *storage) Session(lastWrite time.Time) repositories.Storage {
+	session := *s
+	session.lastWrite = lastWrite
+	return &session
+}
+
+// Id 0 stands for reads of many persons.
+func (s *storage) reader(id int32) *gorm.DB {
+	if s.cluster == nil {
+		return s.db
+	}
+	return s.cluster.Reader(id, s.lastWrite)
+}
+
+func (s *storage) written(ids ...int32) {
+	if s.cluster != nil {
+		s.cluster.Written(ids...)
+	}
+}
+
+func (s *storage) table(table string) string {
+	if s.tenant.Schema == "" {
+		return table
+	}
+	return s.tenant.Schema + "." + table
+}
+
+// Queries of persons must start with persons, so that they never reach
+// other tenants.
+func (s *storage) persons(db *gorm.DB) *gorm.DB {
+	return db.Table(s.table(personTable)).Where("tenant_id = ?", s.tenant.ID)
 }
 
-func NewStorage(db *gorm.DB) *storage {
-	return &storage{db: db}
+func (s *storage) tombstones(db *gorm.DB) *gorm.DB {
+	return db.Table(s.table(personTombstoneTable)).Where("tenant_id = ?", s.tenant.ID)
+}
+
+func (s *storage) merges(db *gorm.DB) *gorm.DB {
+	return db.Table(s.table(personMergeTable)).Where("tenant_id = ?", s.tenant.ID)
 }
 
 func (s *storage) GetAllPerson() ([]models.Person, error) {
 	var persons []models.Person
-	err := s.db.Table(personTable).Find(&persons).Error
+	err := s.persons(s.reader(0)).Find(&persons).Error
 	if err != nil {
 		return nil, fmt.Errorf("error getting all persons: %w", err)
 	}
@@ -27,16 +122,62 @@ func (s *storage) GetAllPerson() ([]models.Person, error) {
 }
 
 func (s *storage) CreatePerson(person models.Person) (models.Person, error) {
-	err := s.db.Table(personTable).Create(&person).Error
+	now := time.Now().UTC()
+	person.CreatedAt = now
+	person.UpdatedAt = now
+
+	row := personRow{Person: person, TenantID: s.tenant.ID}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(s.table(personTable)).Create(&row).Error; err != nil {
+			return err
+		}
+		var err error
+		row.Work, err = s.employ(tx, now, row.ID, row.Work)
+		return err
+	})
 	if err != nil {
 		return models.Person{}, fmt.Errorf("error creating person: %w", err)
 	}
-	return person, nil
+	s.written(row.ID)
+	return row.Person, nil
+}
+
+func (s *storage) CreatePersons(persons []models.Person) ([]models.Person, error) {
+	now := time.Now().UTC()
+	rows := make([]personRow, 0, len(persons))
+	for _, p := range persons {
+		p.CreatedAt = now
+		p.UpdatedAt = now
+		rows = append(rows, personRow{Person: p, TenantID: s.tenant.ID})
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(s.table(personTable)).Create(&rows).Error; err != nil {
+			return err
+		}
+		for i := range rows {
+			var err error
+			if rows[i].Work, err = s.employ(tx, now, rows[i].ID, rows[i].Work); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating persons: %w", err)
+	}
+	ids := make([]int32, 0, len(rows))
+	for i, row := range rows {
+		persons[i] = row.Person
+		ids = append(ids, row.ID)
+	}
+	s.written(ids...)
+	return persons, nil
 }
 
 func (s *storage) GetPersonByID(id int32) (models.Person, error) {
 	var person models.Person
-	err := s.db.Table(personTable).Where("id = ?", id).Take(&person).Error
+	err := s.persons(s.reader(id)).Where("id = ?", id).Take(&person).Error
 	if err != nil {
 		return models.Person{}, fmt.Errorf("error getting person by id: %w", err)
 	}
@@ -44,17 +185,258 @@ func (s *storage) GetPersonByID(id int32) (models.Person, error) {
 }
 
 func (s *storage) DeletePersonByID(id int32) error {
-	err := s.db.Table(personTable).Where("id = ?", id).Delete(&models.Person{}).Error
+	err := s.persons(s.db).Where("id = ?", id).Delete(&models.Person{}).Error
 	if err != nil {
 		return fmt.Errorf("error deleting person: %w", err)
 	}
+	s.written(id)
 	return nil
 }
 
 func (s *storage) UpdatePersonByID(id int32, person models.Person) error {
-	err := s.db.Table(personTable).Where("id = ?", id).Updates(&person).Error
+	person.CreatedAt = time.Time{}
+	person.UpdatedAt = time.Now().UTC()
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		res := s.persons(tx).Where("id = ?", id).Updates(&person)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if person.Work == "" {
+			return nil
+		}
+		_, err := s.employ(tx, person.UpdatedAt, id, person.Work)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("error updating person: %w", err)
 	}
+	s.written(id)
 	return nil
 }
+
+// GetPersonsUpdatedSince reads in one snapshot, below its xmin: the changes
+// of transactions that had all finished, which no later commit can precede.
+func (s *storage) GetPersonsUpdatedSince(since time.Time, after *models.PersonCursor, limit int) ([]models.PersonChange, error) {
+	var changes []models.PersonChange
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var horizon int64
+		err := tx.Raw("select pg_snapshot_xmin(pg_current_snapshot())::text::bigint").Scan(&horizon).Error
+		if err != nil {
+			return err
+		}
+
+		var persons []personChangeRow
+		err = s.changes(s.persons(tx), horizon, after).Where("updated_at >= ?", since).
+			Select("*, change_xid::text::bigint as version").Limit(limit).Find(&persons).Error
+		if err != nil {
+			return err
+		}
+		var tombstones []tombstoneRow
+		err = s.changes(s.tombstones(tx), horizon, after).Where("deleted_at >= ?", since).
+			Select("id, deleted_at, change_xid::text::bigint as version").Limit(limit).Find(&tombstones).Error
+		if err != nil {
+			return err
+		}
+
+		changes = make([]models.PersonChange, 0, len(persons)+len(tombstones))
+		for _, p := range persons {
+			changes = append(changes, models.PersonChange{Person: p.Person, Version: p.Version})
+		}
+		for _, t := range tombstones {
+			changes = append(changes, models.PersonChange{
+				Person: models.Person{ID: t.ID, UpdatedAt: t.DeletedAt}, Deleted: true, Version: t.Version,
+			})
+		}
+		return nil
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("error getting persons updated since %s: %w", since, err)
+	}
+
+	models.SortPersonChanges(changes)
+	if len(changes) > limit {
+		changes = changes[:limit]
+	}
+	return changes, nil
+}
+
+// changes pages query by (change_xid, id) below horizon.
+func (s *storage) changes(query *gorm.DB, horizon int64, after *models.PersonCursor) *gorm.DB {
+	query = query.Where("change_xid < ?::text::xid8", strconv.FormatInt(horizon, 10))
+	if after != nil {
+		query = query.Where("(change_xid, id) > (?::text::xid8, ?)", strconv.FormatInt(after.Version, 10), after.ID)
+	}
+	return query.Order("change_xid, id")
+}
+
+func (s *storage) GetPersonsByIDs(ids []int32) ([]models.Person, error) {
+	var persons []models.Person
+	err := s.persons(s.db).Where("id IN ?", ids).Find(&persons).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting persons by ids: %w", err)
+	}
+	return persons, nil
+}
+
+func (s *storage) ListPersons(q models.PersonQuery) ([]models.Person, error) {
+	if !q.SortBy.Valid() {
+		return nil, fmt.Errorf("error listing persons: unknown sort field %q", q.SortBy)
+	}
+
+	query := applyPersonFilter(s.persons(s.db), q.Filter)
+
+	op, direction := ">", "asc"
+	if q.Desc {
+		op, direction = "<", "desc"
+	}
+
+	if q.After != nil {
+		value, err := q.SortBy.ParseValue(q.After.Value)
+		if err != nil {
+			return nil, fmt.Errorf("error listing persons: %w", err)
+		}
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", sortColumn(q.SortBy), op), value, q.After.ID)
+	}
+
+	var persons []models.Person
+	err := query.Order(fmt.Sprintf("%s %s, id %s", sortColumn(q.SortBy), direction, direction)).Limit(q.Limit).Find(&persons).Error
+	if err != nil {
+		return nil, fmt.Errorf("error listing persons: %w", err)
+	}
+	return persons, nil
+}
+
+func applyPersonFilter(query *gorm.DB, f models.PersonFilter) *gorm.DB {
+	if f.Name != "" {
+		query = query.Where("name ILIKE ?", "%"+escapeLike(f.Name)+"%")
+	}
+	if f.Address != "" {
+		pattern := "%" + escapeLike(f.Address) + "%"
+		query = query.Where(
+			"address ILIKE ? OR address_country ILIKE ? OR address_city ILIKE ? OR address_street ILIKE ?",
+			pattern, pattern, pattern, pattern,
+		)
+	}
+	if f.Work != "" {
+		query = query.Where("work ILIKE ?", "%"+escapeLike(f.Work)+"%")
+	}
+	if f.MinAge > 0 {
+		query = query.Where(ageExpr+" >= ?", f.MinAge)
+	}
+	if f.MaxAge > 0 {
+		query = query.Where(ageExpr+" <= ?", f.MaxAge)
+	}
+	return query
+}
+
+func sortColumn(f models.PersonSortField) string {
+	if f == models.PersonSortByAge {
+		return ageExpr
+	}
+	return string(f)
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (s *storage) GetDuplicateCandidates(person models.Person, limit int) ([]models.Person, error) {
+	var persons []models.Person
+	err := s.persons(s.db).
+		Where("id <> ? AND name % ?", person.ID, person.Name).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "similarity(name, ?) desc, id",
+			Vars:               []any{person.Name},
+			WithoutParentheses: true,
+		}}).
+		Limit(limit).
+		Find(&persons).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting duplicate candidates: %w", err)
+	}
+	return persons, nil
+}
+
+func (s *storage) MergePersons(merge models.PersonMerge) (models.Person, error) {
+	var merged models.Person
+	now := time.Now().UTC()
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var persons []models.Person
+		err := s.persons(tx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", merge.IDs()).Order("id").Find(&persons).Error
+		if err != nil {
+			return err
+		}
+		if len(persons) != len(merge.IDs()) {
+			return gorm.ErrRecordNotFound
+		}
+
+		if merged, err = merge.Apply(persons); err != nil {
+			return err
+		}
+		merged.UpdatedAt = now
+
+		// The target takes over the employments of the sources, which would
+		// go with them otherwise.
+		var employed int64
+		err = s.employments(tx).Where("person_id IN ?", merge.IDs()).Count(&employed).Error
+		if err != nil {
+			return err
+		}
+		err = s.employments(tx).Where("person_id IN ?", merge.SourceIDs).Update("person_id", merge.TargetID).Error
+		if err != nil {
+			return err
+		}
+
+		if err = s.mergeRelations(tx, merge); err != nil {
+			return err
+		}
+
+		// Sources go first, so the target can take over their unique email.
+		if err = s.persons(tx).Where("id IN ?", merge.SourceIDs).Delete(&models.Person{}).Error; err != nil {
+			return err
+		}
+		err = s.persons(tx).Where("id = ?", merge.TargetID).
+			Select("*").Omit("id", "created_at").Updates(&merged).Error
+		if err != nil {
+			return err
+		}
+		if employed > 0 {
+			if err = s.refreshWork(tx, merged.UpdatedAt, merge.TargetID); err != nil {
+				return err
+			}
+			if err = s.persons(tx).Where("id = ?", merge.TargetID).Pluck("work", &merged.Work).Error; err != nil {
+				return err
+			}
+		}
+
+		err = s.merges(tx).Where("survivor_id IN ?", merge.SourceIDs).
+			Update("survivor_id", merge.TargetID).Error
+		if err != nil {
+			return err
+		}
+		merges := make([]personMerge, 0, len(merge.SourceIDs))
+		for _, id := range merge.SourceIDs {
+			merges = append(merges, personMerge{TenantID: s.tenant.ID, MergedID: id, SurvivorID: merge.TargetID, MergedAt: merged.UpdatedAt})
+		}
+		return tx.Table(s.table(personMergeTable)).Create(&merges).Error
+	})
+	if err != nil {
+		return models.Person{}, fmt.Errorf("error merging persons: %w", err)
+	}
+	s.written(merge.IDs()...)
+	return merged, nil
+}
+
+func (s *storage) GetMergedPersonID(id int32) (int32, error) {
+	var merge personMerge
+	err := s.merges(s.db).Where("merged_id = ?", id).Take(&merge).Error
+	if err != nil {
+		return 0, fmt.Errorf("error getting merged person: %w", err)
+	}
+	return merge.SurvivorID, nil
+}
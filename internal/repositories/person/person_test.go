@@ -0,0 +1,215 @@
+package person
+
+import (
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/conformance"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// openTestDB connects to the database of TEST_POSTGRES_DSN, and skips the
+// test when it is not set.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		TranslateError: true,
+		Logger:         logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = db.Exec("create extension if not exists pg_trgm schema public").Error; err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// migrate creates schema with the tables of the migrations, and drops it
+// when the test is done.
+func migrate(t *testing.T, db *gorm.DB, schema string) {
+	t.Helper()
+
+	files, err := filepath.Glob("../../../migration/*.sql")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no migrations found: %v", err)
+	}
+	sort.Strings(files)
+
+	t.Cleanup(func() {
+		db.Exec("drop schema if exists " + schema + " cascade")
+	})
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("create schema " + schema).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("set local search_path to " + schema + ", public").Error; err != nil {
+			return err
+		}
+		for _, file := range files {
+			sql, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			up, _, _ := strings.Cut(string(sql), "-- +goose Down")
+			if err = tx.Exec(up).Error; err != nil {
+				return fmt.Errorf("%s: %w", filepath.Base(file), err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStorage_tenants(t *testing.T) {
+	db := openTestDB(t)
+	suffix := fmt.Sprint(time.Now().UnixNano())
+
+	t.Run("column", func(t *testing.T) {
+		conformance.Run(t, func(t *testing.T) (repositories.Storage, repositories.Storage) {
+			schema := "conformance_" + suffix + "_" + fmt.Sprint(time.Now().UnixNano())
+			migrate(t, db, schema)
+			return NewStorage(db, Tenant{ID: "acme", Schema: schema}),
+				NewStorage(db, Tenant{ID: "globex", Schema: schema})
+		})
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		conformance.Run(t, func(t *testing.T) (repositories.Storage, repositories.Storage) {
+			n := suffix + "_" + fmt.Sprint(time.Now().UnixNano())
+			migrate(t, db, "tenant_acme_"+n)
+			migrate(t, db, "tenant_globex_"+n)
+			return NewStorage(db, Tenant{ID: "acme", Schema: "tenant_acme_" + n}),
+				NewStorage(db, Tenant{ID: "globex", Schema: "tenant_globex_" + n})
+		})
+	})
+}
+
+func TestStorage_relations(t *testing.T) {
+	db := openTestDB(t)
+	conformance.RunRelations(t, func(t *testing.T) repositories.Storage {
+		schema := "conformance_" + fmt.Sprint(time.Now().UnixNano())
+		migrate(t, db, schema)
+		return NewStorage(db, Tenant{ID: "acme", Schema: schema})
+	})
+}
+
+func TestStorage_organizations(t *testing.T) {
+	db := openTestDB(t)
+	conformance.RunOrganizations(t, func(t *testing.T) repositories.Storage {
+		schema := "conformance_" + fmt.Sprint(time.Now().UnixNano())
+		migrate(t, db, schema)
+		return NewStorage(db, Tenant{ID: "acme", Schema: schema})
+	})
+}
+
+// TestNormalizeOrganizationName checks that organizations are keyed alike
+// by the database and by models.NormalizeOrganizationName.
+func TestNormalizeOrganizationName(t *testing.T) {
+	db := openTestDB(t)
+	schema := "normalize_" + fmt.Sprint(time.Now().UnixNano())
+	migrate(t, db, schema)
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "Sber", expected: "sber"},
+		{name: "ООО «Сбер»", expected: "sber"},
+		{name: "Sber, LLC", expected: "sber"},
+		{name: "ПАО Сбербанк", expected: "sberbank"},
+		{name: "ООО «Яндекс»", expected: "yandeks"},
+		{name: "Щёлково-Жилстрой", expected: "shchelkovo zhilstroy"},
+		{name: "МГТУ им. Н.Э. Баумана", expected: "mgtu im n e baumana"},
+		{name: "  ООО  ", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := models.NormalizeOrganizationName(tt.name); got != tt.expected {
+				t.Errorf("NormalizeOrganizationName() expected %q, but got %q", tt.expected, got)
+			}
+			var got string
+			err := db.Raw("select "+schema+".normalize_organization_name(?)", tt.name).Scan(&got).Error
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.expected {
+				t.Errorf("normalize_organization_name() expected %q, but got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestStorage_changes(t *testing.T) {
+	db := openTestDB(t)
+	conformance.RunChanges(t, func(t *testing.T) repositories.Storage {
+		schema := "conformance_" + fmt.Sprint(time.Now().UnixNano())
+		migrate(t, db, schema)
+		return NewStorage(db, Tenant{ID: "acme", Schema: schema})
+	})
+}
+
+// TestStorage_lateCommit checks that a write that commits after a later one
+// is not skipped by a reader that paged past the later one.
+func TestStorage_lateCommit(t *testing.T) {
+	db := openTestDB(t)
+	schema := "changes_" + fmt.Sprint(time.Now().UnixNano())
+	migrate(t, db, schema)
+	s := NewStorage(db, Tenant{ID: "acme", Schema: schema})
+
+	late, err := s.CreatePerson(models.Person{Name: "late"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := s.CreatePerson(models.Person{Name: "other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The late write starts first, but commits after the other.
+	tx := db.Begin()
+	defer tx.Rollback()
+	err = tx.Table(schema+".persons").Where("id = ?", late.ID).Update("name", "late write").Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.UpdatePersonByID(other.ID, models.Person{Name: "other write"}); err != nil {
+		t.Fatal(err)
+	}
+
+	page, err := s.GetPersonsUpdatedSince(time.Time{}, nil, 100)
+	if err != nil || len(page) != 1 || page[0].Name != "late" {
+		t.Fatalf("GetPersonsUpdatedSince() expected the person before the late write, but got %v, %v", page, err)
+	}
+	cursor := models.NewPersonCursor(page[0])
+	if err = tx.Commit().Error; err != nil {
+		t.Fatal(err)
+	}
+
+	page, err = s.GetPersonsUpdatedSince(time.Time{}, &cursor, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(page))
+	for _, p := range page {
+		names = append(names, p.Name)
+	}
+	if strings.Join(names, ",") != "late write,other write" {
+		t.Errorf("next page expected both writes, but got %v", names)
+	}
+}
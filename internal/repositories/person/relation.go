@@ -0,0 +1,115 @@
+package person
+
+import (
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+const personRelationTable = "person_relations"
+
+type personRelation struct {
+	models.PersonRelation `gorm:"embedded"`
+	TenantID              string
+}
+
+func (s *storage) relations(db *gorm.DB) *gorm.DB {
+	return db.Table(s.table(personRelationTable)).Where("tenant_id = ?", s.tenant.ID)
+}
+
+func (s *storage) CreateRelation(relation models.PersonRelation) (models.PersonRelation, error) {
+	relation.ID = 0
+	relation.CreatedAt = time.Now().UTC()
+
+	row := personRelation{PersonRelation: relation, TenantID: s.tenant.ID}
+	err := s.db.Table(s.table(personRelationTable)).Create(&row).Error
+	if err != nil {
+		return models.PersonRelation{}, fmt.Errorf("error creating relation: %w", err)
+	}
+	s.written(relation.FromID, relation.ToID)
+	return row.PersonRelation, nil
+}
+
+func (s *storage) DeleteRelation(personID, relationID int32) error {
+	res := s.relations(s.db).
+		Where("id = ? AND (from_id = ? OR to_id = ?)", relationID, personID, personID).
+		Delete(&models.PersonRelation{})
+	if res.Error != nil {
+		return fmt.Errorf("error deleting relation: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("error deleting relation: %w", gorm.ErrRecordNotFound)
+	}
+	s.written(personID)
+	return nil
+}
+
+// The cascade would delete the relations of the sources with them.
+func (s *storage) mergeRelations(tx *gorm.DB, merge models.PersonMerge) error {
+	var relations []models.PersonRelation
+	err := s.relations(tx).Where("(from_id IN ? OR to_id IN ?)", merge.IDs(), merge.IDs()).Find(&relations).Error
+	if err != nil {
+		return err
+	}
+
+	changed, dropped := merge.MergeRelations(relations)
+	if len(dropped) > 0 {
+		if err = s.relations(tx).Where("id IN ?", dropped).Delete(&models.PersonRelation{}).Error; err != nil {
+			return err
+		}
+	}
+	for _, r := range changed {
+		err = s.relations(tx).Where("id = ?", r.ID).
+			Updates(map[string]any{"from_id": r.FromID, "to_id": r.ToID}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRelatedPersons walks the relations with one query per depth, for the
+// relations of the persons found at the previous one. A recursive query
+// can not tell the persons seen at other depths, and would follow every
+// path up to the depth.
+func (s *storage) GetRelatedPersons(q models.RelationQuery) ([]models.RelatedPerson, error) {
+	if !q.Direction.Valid() {
+		return nil, fmt.Errorf("error getting related persons: unknown direction %q", q.Direction)
+	}
+
+	db := s.reader(q.PersonID)
+	walk := models.NewRelationWalk(q)
+	for frontier := walk.Frontier(); len(frontier) > 0; frontier = walk.Frontier() {
+		query := s.relations(db).Where("(from_id IN ? OR to_id IN ?)", frontier, frontier)
+		if len(q.Types) > 0 {
+			query = query.Where("type IN ?", q.Types)
+		}
+		var relations []models.PersonRelation
+		if err := query.Order("id").Find(&relations).Error; err != nil {
+			return nil, fmt.Errorf("error getting related persons: %w", err)
+		}
+		walk.Step(relations)
+	}
+
+	related := walk.Related()
+	if len(related) == 0 {
+		return related, nil
+	}
+	ids := make([]int32, 0, len(related))
+	for _, r := range related {
+		ids = append(ids, r.Person.ID)
+	}
+	var persons []models.Person
+	if err := s.persons(db).Where("id IN ?", ids).Find(&persons).Error; err != nil {
+		return nil, fmt.Errorf("error getting related persons: %w", err)
+	}
+	byID := make(map[int32]models.Person, len(persons))
+	for _, p := range persons {
+		byID[p.ID] = p
+	}
+	for i := range related {
+		related[i].Person = byID[related[i].Person.ID]
+	}
+	return related, nil
+}
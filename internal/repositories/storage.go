@@ -0,0 +1,61 @@
+// Package repositories defines the person storage, which the storages
+// implement and the decorators in front of them wrap.
+package repositories
+
+import (
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"time"
+)
+
+type Storage interface {
+	// Session returns the storage for a client that last wrote at
+	// lastWrite, whose reads must see that write.
+	Session(lastWrite time.Time) Storage
+	GetAllPerson() ([]models.Person, error)
+	CreatePerson(person models.Person) (models.Person, error)
+	CreatePersons(persons []models.Person) ([]models.Person, error)
+	GetPersonByID(id int32) (models.Person, error)
+	GetPersonsByIDs(ids []int32) ([]models.Person, error)
+	ListPersons(query models.PersonQuery) ([]models.Person, error)
+	DeletePersonByID(id int32) error
+	UpdatePersonByID(id int32, person models.Person) error
+	// GetPersonsUpdatedSince lists the changes since, deletions included,
+	// ordered so that none can land behind a cursor already returned.
+	GetPersonsUpdatedSince(since time.Time, after *models.PersonCursor, limit int) ([]models.PersonChange, error)
+	// GetDuplicateCandidates returns up to limit other persons with a name
+	// similar to the one of person, most similar first.
+	GetDuplicateCandidates(person models.Person, limit int) ([]models.Person, error)
+	MergePersons(merge models.PersonMerge) (models.Person, error)
+	GetMergedPersonID(id int32) (int32, error)
+	// CreateRelation fails with gorm.ErrForeignKeyViolated when either
+	// person is missing.
+	CreateRelation(relation models.PersonRelation) (models.PersonRelation, error)
+	DeleteRelation(personID, relationID int32) error
+	// GetRelatedPersons returns the nearest persons first.
+	GetRelatedPersons(query models.RelationQuery) ([]models.RelatedPerson, error)
+	// ListOrganizations returns the organizations whose normalized name
+	// contains the normalized name, all of them for an empty one.
+	ListOrganizations(name string) ([]models.Organization, error)
+	// CreateOrganization fails with gorm.ErrDuplicatedKey when the name
+	// normalizes like the name of another organization.
+	CreateOrganization(organization models.Organization) (models.Organization, error)
+	GetOrganizationByID(id int32) (models.Organization, error)
+	// UpdateOrganizationByID renames the organization, and so changes the
+	// work of the persons currently employed there.
+	UpdateOrganizationByID(id int32, organization models.Organization) error
+	// DeleteOrganizationByID fails with gorm.ErrForeignKeyViolated while
+	// the organization has employments.
+	DeleteOrganizationByID(id int32) error
+	GetEmployments(personID int32) ([]models.Employment, error)
+	GetOrganizationEmployments(organizationID int32) ([]models.Employment, error)
+	// CreateEmployment fails with gorm.ErrForeignKeyViolated when the
+	// person or the organization is missing.
+	CreateEmployment(employment models.Employment) (models.Employment, error)
+	// UpdateEmployment fails with gorm.ErrForeignKeyViolated when the
+	// organization is missing.
+	UpdateEmployment(personID, employmentID int32, employment models.Employment) error
+	DeleteEmployment(personID, employmentID int32) error
+	// RefreshWork derives the work of all persons again, as it changes when
+	// an employment ends, and returns the persons whose work changed.
+	RefreshWork() ([]int32, error)
+}
@@ -0,0 +1,69 @@
+// Package tenant keeps one storage per tenant. The storages are made for
+// their tenant, so nothing that serves one tenant can reach the data of
+// another.
+package tenant
+
+import (
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"sort"
+	"sync"
+)
+
+// Registry makes the storage of a tenant on first use and keeps it. Only
+// the tenants it was made with are served.
+type Registry[S any] struct {
+	tenants    map[string]bool
+	newStorage func(tenant string) S
+
+	mu       sync.Mutex
+	storages map[string]S
+}
+
+func NewRegistry[S any](tenants []string, newStorage func(tenant string) S) *Registry[S] {
+	r := &Registry[S]{
+		tenants:    make(map[string]bool, len(tenants)),
+		newStorage: newStorage,
+		storages:   make(map[string]S, len(tenants)),
+	}
+	for _, t := range tenants {
+		r.tenants[t] = true
+	}
+	return r
+}
+
+// Storage fails with models.ErrUnknownTenant for tenants that are not served.
+func (r *Registry[S]) Storage(tenant string) (S, error) {
+	if !r.tenants[tenant] {
+		var zero S
+		return zero, fmt.Errorf("tenant %q: %w", tenant, models.ErrUnknownTenant)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.storages[tenant]
+	if !ok {
+		s = r.newStorage(tenant)
+		r.storages[tenant] = s
+	}
+	return s, nil
+}
+
+// Each is ordered by tenant.
+func (r *Registry[S]) Each(f func(tenant string, s S)) {
+	r.mu.Lock()
+	storages := make(map[string]S, len(r.storages))
+	for t, s := range r.storages {
+		storages[t] = s
+	}
+	r.mu.Unlock()
+
+	tenants := make([]string, 0, len(storages))
+	for t := range storages {
+		tenants = append(tenants, t)
+	}
+	sort.Strings(tenants)
+	for _, t := range tenants {
+		f(t, storages[t])
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"expvar"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/buildinfo"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/charmbracelet/log"
+	"github.com/labstack/echo/v4"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// Admin serves the operational endpoints on a port of their own, so that
+// they are not reachable through the public API.
+type Admin struct {
+	echo        *echo.Echo
+	maintenance *Maintenance
+	// config returns the effective config, which changes on reload.
+	config  func() config.Config
+	started time.Time
+}
+
+type runtimeStats struct {
+	Uptime         string `json:"uptime"`
+	Goroutines     int    `json:"goroutines"`
+	GOMAXPROCS     int    `json:"gomaxprocs"`
+	HeapAllocBytes uint64 `json:"heap_alloc_bytes"`
+	HeapObjects    uint64 `json:"heap_objects"`
+	SysBytes       uint64 `json:"sys_bytes"`
+	NumGC          uint32 `json:"num_gc"`
+	GCPauseTotal   string `json:"gc_pause_total"`
+}
+
+type maintenanceState struct {
+	Enabled *bool `json:"enabled"`
+}
+
+func NewAdmin(maintenance *Maintenance, cfg func() config.Config) *Admin {
+	a := &Admin{
+		echo:        echo.New(),
+		maintenance: maintenance,
+		config:      cfg,
+		started:     time.Now(),
+	}
+	a.echo.HideBanner = true
+
+	a.echo.GET("/debug/vars", echo.WrapHandler(expvar.Handler()))
+	a.echo.GET("/debug/pprof/", echo.WrapHandler(http.HandlerFunc(pprof.Index)))
+	a.echo.GET("/debug/pprof/cmdline", echo.WrapHandler(http.HandlerFunc(pprof.Cmdline)))
+	a.echo.GET("/debug/pprof/profile", echo.WrapHandler(http.HandlerFunc(pprof.Profile)))
+	a.echo.Any("/debug/pprof/symbol", echo.WrapHandler(http.HandlerFunc(pprof.Symbol)))
+	a.echo.GET("/debug/pprof/trace", echo.WrapHandler(http.HandlerFunc(pprof.Trace)))
+	// Index serves the named profiles, like heap and goroutine.
+	a.echo.GET("/debug/pprof/*", echo.WrapHandler(http.HandlerFunc(pprof.Index)))
+
+	a.echo.GET("/buildinfo", a.buildInfo)
+	a.echo.GET("/runtime", a.runtime)
+	a.echo.GET("/config", a.effectiveConfig)
+	a.echo.GET("/maintenance", a.getMaintenance)
+	a.echo.PUT("/maintenance", a.setMaintenance)
+	return a
+}
+
+func (a *Admin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.echo.ServeHTTP(w, r)
+}
+
+// Run listens on host only, as the endpoints are not authenticated.
+func (a *Admin) Run(host string, port int) error {
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	log.Info("admin server starting on", "addr", addr)
+	a.echo.HidePort = true
+	err := a.echo.Start(addr)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func (a *Admin) Shutdown(ctx context.Context) error {
+	log.Info("admin server shutting down")
+	return a.echo.Shutdown(ctx)
+}
+
+func (a *Admin) buildInfo(c echo.Context) error {
+	return c.JSON(http.StatusOK, buildinfo.Get())
+}
+
+func (a *Admin) runtime(c echo.Context) error {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	return c.JSON(http.StatusOK, runtimeStats{
+		Uptime:         time.Since(a.started).Round(time.Second).String(),
+		Goroutines:     runtime.NumGoroutine(),
+		GOMAXPROCS:     runtime.GOMAXPROCS(0),
+		HeapAllocBytes: mem.HeapAlloc,
+		HeapObjects:    mem.HeapObjects,
+		SysBytes:       mem.Sys,
+		NumGC:          mem.NumGC,
+		GCPauseTotal:   time.Duration(mem.PauseTotalNs).String(),
+	})
+}
+
+// effectiveConfig redacts secrets.
+func (a *Admin) effectiveConfig(c echo.Context) error {
+	var buf bytes.Buffer
+	if err := config.Print(&buf, a.config()); err != nil {
+		log.Errorf("can not print config: %v", err)
+		return internalError(c)
+	}
+	return c.Blob(http.StatusOK, "application/yaml", buf.Bytes())
+}
+
+func (a *Admin) getMaintenance(c echo.Context) error {
+	enabled := a.maintenance.Enabled()
+	return c.JSON(http.StatusOK, maintenanceState{Enabled: &enabled})
+}
+
+func (a *Admin) setMaintenance(c echo.Context) error {
+	var state maintenanceState
+	if err := c.Bind(&state); err != nil || state.Enabled == nil {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: `body must be {"enabled": true|false}`,
+		})
+	}
+	a.maintenance.Set(*state.Enabled)
+	return a.getMaintenance(c)
+}
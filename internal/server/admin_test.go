@@ -0,0 +1,142 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/buildinfo"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/gojuno/minimock/v3"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAdmin(t *testing.T) {
+	cfg := config.Config{PostgresDSN: "postgres://user:secret@db/persons", AdminPort: 8081}
+	s := newTestServer(t, cfg, NewPersonRepositoryMock(minimock.NewController(t)))
+	admin := NewAdmin(s.Maintenance(), func() config.Config { return cfg })
+
+	tests := []struct {
+		name               string
+		handler            http.Handler
+		method             string
+		path               string
+		body               string
+		expectedHTTPStatus int
+		expectedBody       string
+	}{
+		{
+			name:               "http-200: build info",
+			handler:            admin,
+			method:             http.MethodGet,
+			path:               "/buildinfo",
+			expectedHTTPStatus: 200,
+			expectedBody:       `"version":"` + buildinfo.Version + `"`,
+		},
+		{
+			name:               "http-200: runtime stats",
+			handler:            admin,
+			method:             http.MethodGet,
+			path:               "/runtime",
+			expectedHTTPStatus: 200,
+			expectedBody:       `"goroutines":`,
+		},
+		{
+			name:               "http-200: config with secrets redacted",
+			handler:            admin,
+			method:             http.MethodGet,
+			path:               "/config",
+			expectedHTTPStatus: 200,
+			expectedBody:       "postgres_dsn: REDACTED\n",
+		},
+		{
+			name:               "http-200: pprof profiles",
+			handler:            admin,
+			method:             http.MethodGet,
+			path:               "/debug/pprof/",
+			expectedHTTPStatus: 200,
+			expectedBody:       "goroutine",
+		},
+		{
+			name:               "http-200: named pprof profile",
+			handler:            admin,
+			method:             http.MethodGet,
+			path:               "/debug/pprof/heap",
+			expectedHTTPStatus: 200,
+		},
+		{
+			name:               "http-200: expvar metrics",
+			handler:            admin,
+			method:             http.MethodGet,
+			path:               "/debug/vars",
+			expectedHTTPStatus: 200,
+			expectedBody:       `"memstats"`,
+		},
+		{
+			name:               "http-400: bad maintenance body",
+			handler:            admin,
+			method:             http.MethodPut,
+			path:               "/maintenance",
+			body:               `{}`,
+			expectedHTTPStatus: 400,
+		},
+		{
+			name:               "http-404: no metrics on the public port",
+			handler:            s,
+			method:             http.MethodGet,
+			path:               "/debug/vars",
+			expectedHTTPStatus: 404,
+		},
+		{
+			name:               "http-404: no pprof on the public port",
+			handler:            s,
+			method:             http.MethodGet,
+			path:               "/debug/pprof/",
+			expectedHTTPStatus: 404,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rw := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rw, req)
+
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Errorf("%s http-code expected %d, but got %d", tt.path, tt.expectedHTTPStatus, rw.Code)
+			}
+			if !strings.Contains(rw.Body.String(), tt.expectedBody) {
+				t.Errorf("%s body expected to contain %q, but got %q", tt.path, tt.expectedBody, rw.Body.String())
+			}
+		})
+	}
+}
+
+func TestAdmin_maintenance(t *testing.T) {
+	m := &Maintenance{}
+	admin := NewAdmin(m, func() config.Config { return config.Config{} })
+	do := func(method, body string) maintenanceState {
+		req := httptest.NewRequest(method, "/maintenance", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rw := httptest.NewRecorder()
+		admin.ServeHTTP(rw, req)
+		if rw.Code != http.StatusOK {
+			t.Fatalf("%s /maintenance http-code expected 200, but got %d", method, rw.Code)
+		}
+		var state maintenanceState
+		if err := json.Unmarshal(rw.Body.Bytes(), &state); err != nil || state.Enabled == nil {
+			t.Fatalf("/maintenance body expected a state, but got %q", rw.Body.String())
+		}
+		return state
+	}
+
+	if state := do(http.MethodGet, ""); *state.Enabled {
+		t.Errorf("maintenance expected disabled at start")
+	}
+	if state := do(http.MethodPut, `{"enabled": true}`); !*state.Enabled || !m.Enabled() {
+		t.Errorf("maintenance expected enabled")
+	}
+	if state := do(http.MethodPut, `{"enabled": false}`); *state.Enabled || m.Enabled() {
+		t.Errorf("maintenance expected disabled")
+	}
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// cacheControl is the Cache-Control of read endpoints. Without a max-age
+// clients have to revalidate every time, which is cheap with the ETag.
+func (s *Server) cacheControl() string {
+	maxAge := s.settings().cacheMaxAge
+	if maxAge <= 0 {
+		return "no-cache"
+	}
+	return fmt.Sprintf("max-age=%d", int(maxAge.Seconds()))
+}
+
+// respondCached derives the ETag from v, so it changes whenever the response
+// does.
+func (s *Server) respondCached(c echo.Context, rep *representation, v any, lastModified time.Time) error {
+	etag, err := entityTag(rep, v)
+	if err != nil {
+		return err
+	}
+
+	h := c.Response().Header()
+	h.Set(echo.HeaderCacheControl, s.cacheControl())
+	h.Set(headerETag, etag)
+	if !lastModified.IsZero() {
+		h.Set(echo.HeaderLastModified, lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(c.Request(), etag, lastModified) {
+		h.Add(echo.HeaderVary, echo.HeaderAccept)
+		return c.NoContent(http.StatusNotModified)
+	}
+	return rep.respond(c, http.StatusOK, v)
+}
+
+// entityTag is a weak ETag of v in the representation: it is the same for
+// equal values, not for equal bytes, which also differ by compression.
+func entityTag(rep *representation, v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(rep.mediaType))
+	h.Write([]byte{0})
+	h.Write(data)
+	return fmt.Sprintf(`W/"%s"`, hex.EncodeToString(h.Sum(nil)[:16])), nil
+}
+
+// If-None-Match takes precedence over If-Modified-Since.
+func notModified(req *http.Request, etag string, lastModified time.Time) bool {
+	if inm := req.Header.Get(headerIfNoneMatch); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(req.Header.Get(echo.HeaderIfModifiedSince))
+	if err != nil {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(since)
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/gojuno/minimock/v3"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestServer_listConditional(t *testing.T) {
+	mc := minimock.NewController(t)
+	changed := regularPerson
+	changed.Name = "changed"
+
+	pr := NewPersonRepositoryMock(mc)
+	s := newTestServer(t, config.Config{CacheMaxAge: time.Minute}, pr)
+	list := func(persons []models.Person, accept, etag string) *httptest.ResponseRecorder {
+		pr.GetAllPersonMock.Return(persons, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/persons", nil)
+		req.Header.Set("Accept", accept)
+		req.Header.Set("If-None-Match", etag)
+		rw := httptest.NewRecorder()
+		s.ServeHTTP(rw, req)
+		return rw
+	}
+
+	rw := list([]models.Person{regularPerson}, "", "")
+	etag := rw.Header().Get("ETag")
+	if rw.Code != http.StatusOK || etag == "" {
+		t.Fatalf("list expected 200 with an ETag, but got %d %q", rw.Code, etag)
+	}
+	if cc := rw.Header().Get("Cache-Control"); cc != "max-age=60" {
+		t.Errorf("Cache-Control expected %q, but got %q", "max-age=60", cc)
+	}
+
+	rw = list([]models.Person{regularPerson}, "", etag)
+	if rw.Code != http.StatusNotModified || rw.Body.Len() != 0 {
+		t.Errorf("unchanged list expected 304 without body, but got %d %s", rw.Code, rw.Body)
+	}
+	if rw.Header().Get("ETag") != etag {
+		t.Errorf("304 expected ETag %q, but got %q", etag, rw.Header().Get("ETag"))
+	}
+
+	rw = list([]models.Person{regularPerson}, "", `"other", `+etag)
+	if rw.Code != http.StatusNotModified {
+		t.Errorf("list with matching ETag among others expected 304, but got %d", rw.Code)
+	}
+
+	rw = list([]models.Person{changed}, "", etag)
+	if rw.Code != http.StatusOK || rw.Header().Get("ETag") == etag {
+		t.Errorf("changed list expected 200 with a new ETag, but got %d %q", rw.Code, rw.Header().Get("ETag"))
+	}
+
+	rw = list(nil, "", etag)
+	if rw.Code != http.StatusOK {
+		t.Errorf("list after deletion expected 200, but got %d", rw.Code)
+	}
+
+	rw = list([]models.Person{regularPerson}, "application/xml", etag)
+	if rw.Code != http.StatusOK || rw.Header().Get("ETag") == etag {
+		t.Errorf("list in another representation expected 200 with another ETag, but got %d %q", rw.Code, rw.Header().Get("ETag"))
+	}
+}
+
+func TestServer_getConditional(t *testing.T) {
+	mc := minimock.NewController(t)
+	person := regularPerson
+	person.UpdatedAt = time.Date(2024, 10, 1, 12, 0, 0, 500, time.UTC)
+
+	tests := []struct {
+		name               string
+		header             string
+		value              string
+		expectedHTTPStatus int
+	}{
+		{name: "unconditional", expectedHTTPStatus: http.StatusOK},
+		{name: "not modified since", header: "If-Modified-Since", value: "Tue, 01 Oct 2024 12:00:00 GMT", expectedHTTPStatus: http.StatusNotModified},
+		{name: "modified since", header: "If-Modified-Since", value: "Tue, 01 Oct 2024 11:59:59 GMT", expectedHTTPStatus: http.StatusOK},
+		{name: "bad date", header: "If-Modified-Since", value: "yesterday", expectedHTTPStatus: http.StatusOK},
+		{name: "any etag", header: "If-None-Match", value: "*", expectedHTTPStatus: http.StatusNotModified},
+		{name: "other etag", header: "If-None-Match", value: `W/"other"`, expectedHTTPStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, config.Config{}, NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(person, nil))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/1", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			rw := httptest.NewRecorder()
+			s.ServeHTTP(rw, req)
+
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Errorf("http-code expected %d, but got %d", tt.expectedHTTPStatus, rw.Code)
+			}
+			if lm := rw.Header().Get("Last-Modified"); lm != "Tue, 01 Oct 2024 12:00:00 GMT" {
+				t.Errorf("Last-Modified expected %q, but got %q", "Tue, 01 Oct 2024 12:00:00 GMT", lm)
+			}
+			if cc := rw.Header().Get("Cache-Control"); cc != "no-cache" {
+				t.Errorf("Cache-Control expected %q, but got %q", "no-cache", cc)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"github.com/labstack/echo/v4"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	encodingGzip = "gzip"
+	encodingZstd = "zstd"
+)
+
+// contentEncodings are in the order of preference.
+var contentEncodings = []string{encodingZstd, encodingGzip}
+
+var (
+	gzipWriters = sync.Pool{New: func() any {
+		return gzip.NewWriter(io.Discard)
+	}}
+	zstdWriters = sync.Pool{New: func() any {
+		w, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		return w
+	}}
+)
+
+func (s *Server) compress(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Response().Header().Add(echo.HeaderVary, echo.HeaderAcceptEncoding)
+
+		encoding := negotiateEncoding(c.Request().Header.Get(echo.HeaderAcceptEncoding))
+		if encoding == "" || c.Request().Method == http.MethodHead {
+			return next(c)
+		}
+
+		w := &compressWriter{ResponseWriter: c.Response().Writer, encoding: encoding, minSize: s.settings().compressionMinSize}
+		c.Response().Writer = w
+		defer func() {
+			c.Response().Writer = w.ResponseWriter
+		}()
+
+		if err := next(c); err != nil {
+			c.Error(err)
+		}
+		return w.Close()
+	}
+}
+
+// An empty encoding means the response is sent as is.
+func negotiateEncoding(acceptEncoding string) string {
+	qualities := make(map[string]float64)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding == "" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		qualities[coding] = q
+	}
+
+	candidates := make([]string, 0, len(contentEncodings))
+	for _, coding := range contentEncodings {
+		q, ok := qualities[coding]
+		if !ok {
+			q, ok = qualities["*"]
+		}
+		if ok && q > 0 {
+			candidates = append(candidates, coding)
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return quality(qualities, candidates[i]) > quality(qualities, candidates[j])
+	})
+	return candidates[0]
+}
+
+func quality(qualities map[string]float64, coding string) float64 {
+	if q, ok := qualities[coding]; ok {
+		return q
+	}
+	return qualities["*"]
+}
+
+// compressWriter holds the response back until it is known to be at least
+// minSize bytes long.
+type compressWriter struct {
+	http.ResponseWriter
+	encoding string
+	minSize  int
+
+	status  int
+	buf     bytes.Buffer
+	encoder io.WriteCloser
+	done    bool
+}
+
+func (w *compressWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if w.encoder != nil {
+		return w.encoder.Write(b)
+	}
+	if w.done {
+		return w.ResponseWriter.Write(b)
+	}
+
+	w.buf.Write(b)
+	if w.buf.Len() < w.minSize {
+		return len(b), nil
+	}
+	if err := w.start(); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (w *compressWriter) start() error {
+	h := w.Header()
+	if w.buf.Len() >= w.minSize && h.Get(echo.HeaderContentEncoding) == "" && compressible(h.Get(echo.HeaderContentType)) {
+		h.Set(echo.HeaderContentEncoding, w.encoding)
+		h.Del(echo.HeaderContentLength)
+		switch w.encoding {
+		case encodingZstd:
+			zw := zstdWriters.Get().(*zstd.Encoder)
+			zw.Reset(w.ResponseWriter)
+			w.encoder = zw
+		default:
+			gw := gzipWriters.Get().(*gzip.Writer)
+			gw.Reset(w.ResponseWriter)
+			w.encoder = gw
+		}
+	}
+	w.done = true
+
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	var err error
+	if w.encoder != nil {
+		_, err = w.encoder.Write(w.buf.Bytes())
+	} else {
+		_, err = w.ResponseWriter.Write(w.buf.Bytes())
+	}
+	w.buf.Reset()
+	return err
+}
+
+func (w *compressWriter) Close() error {
+	if !w.done {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+	if w.encoder == nil {
+		return nil
+	}
+
+	err := w.encoder.Close()
+	switch e := w.encoder.(type) {
+	case *zstd.Encoder:
+		zstdWriters.Put(e)
+	case *gzip.Writer:
+		gzipWriters.Put(e)
+	}
+	w.encoder = nil
+	return err
+}
+
+// compressible reports whether responses of the content type are worth
+// encoding: MessagePack is compact, but still repeats every field name.
+func compressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	switch {
+	case strings.HasPrefix(mediaType, "text/"),
+		strings.HasSuffix(mediaType, "json"),
+		strings.HasSuffix(mediaType, "xml"),
+		strings.HasSuffix(mediaType, "yaml"),
+		strings.HasSuffix(mediaType, "javascript"),
+		mediaType == mimeApplicationMsgpack:
+		return true
+	}
+	return false
+}
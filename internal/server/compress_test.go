@@ -0,0 +1,135 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/gojuno/minimock/v3"
+	"github.com/klauspost/compress/zstd"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		expected       string
+	}{
+		{acceptEncoding: "", expected: ""},
+		{acceptEncoding: "identity", expected: ""},
+		{acceptEncoding: "gzip", expected: encodingGzip},
+		{acceptEncoding: "gzip, deflate, br, zstd", expected: encodingZstd},
+		{acceptEncoding: "zstd;q=0.5, gzip", expected: encodingGzip},
+		{acceptEncoding: "GZIP", expected: encodingGzip},
+		{acceptEncoding: "*", expected: encodingZstd},
+		{acceptEncoding: "*, zstd;q=0", expected: encodingGzip},
+		{acceptEncoding: "gzip;q=0", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.acceptEncoding, func(t *testing.T) {
+			if got := negotiateEncoding(tt.acceptEncoding); got != tt.expected {
+				t.Errorf("negotiateEncoding(%q) expected %q, but got %q", tt.acceptEncoding, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestServer_compress(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	persons := make([]models.Person, 0, 50)
+	for i := int32(1); i <= 50; i++ {
+		persons = append(persons, models.Person{ID: i, Name: fmt.Sprintf("person %d", i), Work: "test"})
+	}
+
+	tests := []struct {
+		name             string
+		minSize          int
+		acceptEncoding   string
+		persons          []models.Person
+		expectedEncoding string
+		decode           func(r io.Reader) (io.Reader, error)
+	}{
+		{
+			name:             "gzip",
+			minSize:          1024,
+			acceptEncoding:   "gzip",
+			persons:          persons,
+			expectedEncoding: encodingGzip,
+			decode: func(r io.Reader) (io.Reader, error) {
+				return gzip.NewReader(r)
+			},
+		},
+		{
+			name:             "zstd",
+			minSize:          1024,
+			acceptEncoding:   "gzip, zstd",
+			persons:          persons,
+			expectedEncoding: encodingZstd,
+			decode: func(r io.Reader) (io.Reader, error) {
+				return zstd.NewReader(r)
+			},
+		},
+		{
+			name:           "below threshold",
+			minSize:        1024,
+			acceptEncoding: "gzip",
+			persons:        persons[:1],
+		},
+		{
+			name:             "no threshold",
+			acceptEncoding:   "gzip",
+			persons:          persons[:1],
+			expectedEncoding: encodingGzip,
+			decode: func(r io.Reader) (io.Reader, error) {
+				return gzip.NewReader(r)
+			},
+		},
+		{
+			name:    "not accepted",
+			minSize: 1024,
+			persons: persons,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, config.Config{CompressionMinSize: tt.minSize}, NewPersonRepositoryMock(mc).GetAllPersonMock.Return(tt.persons, nil))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/persons", nil)
+			req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			rw := httptest.NewRecorder()
+			s.ServeHTTP(rw, req)
+
+			if rw.Code != http.StatusOK {
+				t.Fatalf("http-code expected %d, but got %d", http.StatusOK, rw.Code)
+			}
+			if enc := rw.Header().Get("Content-Encoding"); enc != tt.expectedEncoding {
+				t.Fatalf("Content-Encoding expected %q, but got %q", tt.expectedEncoding, enc)
+			}
+			if !slices.Contains(rw.Header().Values("Vary"), "Accept-Encoding") {
+				t.Errorf("expected Vary: Accept-Encoding, but got %q", rw.Header().Values("Vary"))
+			}
+
+			var body io.Reader = bytes.NewReader(rw.Body.Bytes())
+			if tt.decode != nil {
+				var err error
+				if body, err = tt.decode(body); err != nil {
+					t.Fatalf("can not decode %s body: %v", tt.expectedEncoding, err)
+				}
+			}
+			var res []map[string]any
+			if err := json.NewDecoder(body).Decode(&res); err != nil {
+				t.Fatalf("can not decode response: %v", err)
+			}
+			if len(res) != len(tt.persons) {
+				t.Errorf("expected %d persons, but got %d", len(tt.persons), len(res))
+			}
+		})
+	}
+}
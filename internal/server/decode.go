@@ -0,0 +1,183 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/charmbracelet/log"
+	"github.com/labstack/echo/v4"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+const defaultMaxBodySize = 1 << 20
+
+var personReadOnlyFields = []string{"id", "created_at", "updated_at"}
+
+type decodeError struct {
+	status   int
+	message  string
+	field    string
+	position *openapi.JSONPosition
+}
+
+func (e *decodeError) Error() string {
+	if e.field != "" {
+		return fmt.Sprintf("%s: %s", e.field, e.message)
+	}
+	return e.message
+}
+
+func (s *Server) decodeJSON(c echo.Context, v any, readOnly ...string) error {
+	return s.decodeBody(c, v, []*representation{jsonRepresentation}, readOnly)
+}
+
+func (s *Server) decodePerson(c echo.Context, v any) error {
+	return s.decodeBody(c, v, personRepresentations, personReadOnlyFields)
+}
+
+// decodeBody is strict unless LENIENT_DECODING is set: other media types
+// than reps, unknown and read-only fields are rejected.
+func (s *Server) decodeBody(c echo.Context, v any, reps []*representation, readOnly []string) error {
+	st := s.settings()
+	req := c.Request()
+	rep, ok := requestRepresentation(req.Header.Get(echo.HeaderContentType), reps)
+	if !ok {
+		if st.strictDecoding {
+			return &decodeError{
+				status:  http.StatusUnsupportedMediaType,
+				message: fmt.Sprintf("content type must be one of %s", mediaTypes(reps)),
+			}
+		}
+		rep = jsonRepresentation
+	}
+
+	limit := st.maxBodySize
+	if limit <= 0 {
+		limit = defaultMaxBodySize
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(c.Response(), req.Body, limit))
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return &decodeError{
+				status:  http.StatusRequestEntityTooLarge,
+				message: fmt.Sprintf("request body must not be larger than %d bytes", limit),
+			}
+		}
+		return &decodeError{status: http.StatusBadRequest, message: fmt.Sprintf("can not read request body: %v", err)}
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return &decodeError{status: http.StatusBadRequest, message: "request body is empty"}
+	}
+
+	if rep.toJSON != nil {
+		if body, err = rep.toJSON(body, reflect.TypeOf(v)); err != nil {
+			return &decodeError{status: http.StatusBadRequest, message: fmt.Sprintf("invalid %s: %v", rep.mediaType, err)}
+		}
+	}
+
+	err = unmarshalJSON(body, v, readOnly, st.strictDecoding)
+	var derr *decodeError
+	if rep != jsonRepresentation && errors.As(err, &derr) {
+		// Positions are in the converted JSON, not in the body.
+		derr.position = nil
+	}
+	return err
+}
+
+func unmarshalJSON(body []byte, v any, readOnly []string, strict bool) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(v); err != nil {
+		return jsonDecodeError(err, body, readOnly)
+	}
+	if dec.More() {
+		return &decodeError{
+			status:   http.StatusBadRequest,
+			message:  "unexpected data after the JSON value",
+			position: jsonPosition(body, dec.InputOffset()),
+		}
+	}
+	return nil
+}
+
+func jsonDecodeError(err error, body []byte, readOnly []string) error {
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+	)
+	switch {
+	case errors.As(err, &syntaxErr):
+		// Offset is past the offending byte, point at the byte itself.
+		return &decodeError{
+			status:   http.StatusBadRequest,
+			message:  fmt.Sprintf("invalid JSON: %v", syntaxErr),
+			position: jsonPosition(body, max(syntaxErr.Offset-1, 0)),
+		}
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return &decodeError{
+			status:   http.StatusBadRequest,
+			message:  "invalid JSON: unexpected end of input",
+			position: jsonPosition(body, int64(len(body))),
+		}
+	case errors.As(err, &typeErr):
+		return &decodeError{
+			status:   http.StatusBadRequest,
+			message:  fmt.Sprintf("must be %s, not %s", typeErr.Type, typeErr.Value),
+			field:    typeErr.Field,
+			position: jsonPosition(body, typeErr.Offset),
+		}
+	}
+
+	// encoding/json has no error type for unknown fields.
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		field = strings.Trim(field, `"`)
+		for _, ro := range readOnly {
+			if field == ro {
+				return &decodeError{status: http.StatusBadRequest, message: "field is read-only", field: field}
+			}
+		}
+		return &decodeError{status: http.StatusBadRequest, message: "unknown field", field: field}
+	}
+
+	return &decodeError{status: http.StatusBadRequest, message: err.Error()}
+}
+
+func jsonPosition(body []byte, offset int64) *openapi.JSONPosition {
+	if offset > int64(len(body)) {
+		offset = int64(len(body))
+	}
+	before := body[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := len(before) - bytes.LastIndexByte(before, '\n')
+	return &openapi.JSONPosition{Offset: offset, Line: int32(line), Column: int32(column)}
+}
+
+func badRequestBody(c echo.Context, err error) error {
+	log.Errorf("can not decode request: %v", err)
+
+	var derr *decodeError
+	if !errors.As(err, &derr) {
+		return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{Message: "bad json request"})
+	}
+	if derr.status != http.StatusBadRequest {
+		return c.JSON(derr.status, openapi.ErrorResponse{Message: derr.message})
+	}
+
+	res := openapi.ValidationErrorResponse{
+		Message:  "bad json request: " + derr.message,
+		Position: derr.position,
+	}
+	if derr.field != "" {
+		res.Message = "bad json request"
+		res.Errors = map[string]string{derr.field: derr.message}
+	}
+	return c.JSON(http.StatusBadRequest, res)
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/gojuno/minimock/v3"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestServer_strictDecoding(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name               string
+		cfg                config.Config
+		pr                 PersonRepository
+		contentType        string
+		body               string
+		expectedHTTPStatus int
+		expectedErrors     map[string]string
+		expectedPosition   *openapi.JSONPosition
+	}{
+		{
+			name:               "valid",
+			pr:                 NewPersonRepositoryMock(mc).CreatePersonMock.Return(regularPerson, nil),
+			contentType:        "application/json; charset=utf-8",
+			body:               `{"name":"test"}`,
+			expectedHTTPStatus: http.StatusCreated,
+		},
+		{
+			name:               "unknown field",
+			contentType:        "application/json",
+			body:               `{"name":"test","nickname":"t"}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+			expectedErrors:     map[string]string{"nickname": "unknown field"},
+		},
+		{
+			name:               "read-only id",
+			contentType:        "application/json",
+			body:               `{"id":5,"name":"test"}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+			expectedErrors:     map[string]string{"id": "field is read-only"},
+		},
+		{
+			name:               "wrong type",
+			contentType:        "application/json",
+			body:               `{"name":"test","age":"ten"}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+			expectedErrors:     map[string]string{"age": "must be int32, not string"},
+			expectedPosition:   &openapi.JSONPosition{Offset: 26, Line: 1, Column: 27},
+		},
+		{
+			name:               "syntax error",
+			contentType:        "application/json",
+			body:               "{\n  \"name\": \"test\",\n  \"age\": 1,\n}",
+			expectedHTTPStatus: http.StatusBadRequest,
+			expectedPosition:   &openapi.JSONPosition{Offset: 32, Line: 4, Column: 1},
+		},
+		{
+			name:               "truncated",
+			contentType:        "application/json",
+			body:               `{"name":`,
+			expectedHTTPStatus: http.StatusBadRequest,
+			expectedPosition:   &openapi.JSONPosition{Offset: 8, Line: 1, Column: 9},
+		},
+		{
+			name:               "trailing data",
+			contentType:        "application/json",
+			body:               `{"name":"test"} {"name":"test"}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+			expectedPosition:   &openapi.JSONPosition{Offset: 16, Line: 1, Column: 17},
+		},
+		{
+			name:               "empty body",
+			contentType:        "application/json",
+			body:               "",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "no content type",
+			body:               `{"name":"test"}`,
+			expectedHTTPStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:               "form content type",
+			contentType:        "application/x-www-form-urlencoded",
+			body:               "name=test",
+			expectedHTTPStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:               "too large",
+			cfg:                config.Config{MaxBodySize: 16},
+			contentType:        "application/json",
+			body:               `{"name":"test","work":"test"}`,
+			expectedHTTPStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:               "lenient",
+			cfg:                config.Config{LenientDecoding: true},
+			pr:                 NewPersonRepositoryMock(mc).CreatePersonMock.Return(regularPerson, nil),
+			body:               `{"id":5,"name":"test","nickname":"t"}`,
+			expectedHTTPStatus: http.StatusCreated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, tt.cfg, tt.pr)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			rw := httptest.NewRecorder()
+			s.ServeHTTP(rw, req)
+
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Fatalf("http-code expected %d, but got %d: %s", tt.expectedHTTPStatus, rw.Code, rw.Body)
+			}
+			if rw.Code != http.StatusBadRequest {
+				return
+			}
+
+			var res openapi.ValidationErrorResponse
+			if err := json.NewDecoder(rw.Body).Decode(&res); err != nil {
+				t.Fatalf("can not decode response: %v", err)
+			}
+			if tt.expectedErrors != nil && !reflect.DeepEqual(res.Errors, tt.expectedErrors) {
+				t.Errorf("errors expected %v, but got %v", tt.expectedErrors, res.Errors)
+			}
+			if tt.expectedPosition != nil && !reflect.DeepEqual(res.Position, tt.expectedPosition) {
+				t.Errorf("position expected %+v, but got %+v", tt.expectedPosition, res.Position)
+			}
+		})
+	}
+}
+
+func TestServer_strictDecodingEdit(t *testing.T) {
+	s := newTestServer(t, config.Config{}, NewPersonRepositoryMock(minimock.NewController(t)))
+
+	body := `{"name":"test","created_at":"2024-01-01T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/persons/1", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rw := httptest.NewRecorder()
+	s.ServeHTTP(rw, req)
+
+	var res openapi.ValidationErrorResponse
+	if err := json.NewDecoder(rw.Body).Decode(&res); err != nil {
+		t.Fatalf("can not decode response: %v", err)
+	}
+	if rw.Code != http.StatusBadRequest || res.Errors["created_at"] != "field is read-only" {
+		t.Errorf("expected created_at to be rejected as read-only, but got %d %+v", rw.Code, res)
+	}
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	swaggerFiles "github.com/swaggo/files/v2"
+	"gopkg.in/yaml.v3"
+	"net/http"
+)
+
+const (
+	docsPath        = "/docs"
+	specJSONPath    = "/api/v1/openapi.json"
+	mimeYAML        = "application/yaml"
+	swaggerInitJS   = "swagger-initializer.js"
+	swaggerInitTmpl = `window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: %q,
+    dom_id: '#swagger-ui',
+    deepLinking: true,
+    presets: [
+      SwaggerUIBundle.presets.apis,
+      SwaggerUIStandalonePreset
+    ],
+    plugins: [
+      SwaggerUIBundle.plugins.DownloadUrl
+    ],
+    layout: "StandaloneLayout"
+  });
+};
+`
+)
+
+type apiDocs struct {
+	specJSON []byte
+	specYAML []byte
+}
+
+func newAPIDocs(cfg config.Config) (*apiDocs, error) {
+	spec, err := openapi.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("load openapi spec error: %w", err)
+	}
+
+	spec.Servers = openapi3.Servers{{URL: cfg.PublicBaseURL()}}
+	if cfg.Version != "" {
+		spec.Info.Version = cfg.Version
+	}
+
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal openapi spec to json error: %w", err)
+	}
+
+	specYAML, err := yaml.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal openapi spec to yaml error: %w", err)
+	}
+
+	return &apiDocs{specJSON: specJSON, specYAML: specYAML}, nil
+}
+
+func (s *Server) GetOpenAPIJSON(c echo.Context) error {
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, s.docs.specJSON)
+}
+
+func (s *Server) GetOpenAPIYAML(c echo.Context) error {
+	return c.Blob(http.StatusOK, mimeYAML, s.docs.specYAML)
+}
+
+func (s *Server) registerDocs() {
+	s.echo.GET(docsPath, func(c echo.Context) error {
+		return c.Redirect(http.StatusMovedPermanently, docsPath+"/")
+	})
+	s.echo.GET(docsPath+"/"+swaggerInitJS, func(c echo.Context) error {
+		return c.Blob(http.StatusOK, echo.MIMEApplicationJavaScript, []byte(fmt.Sprintf(swaggerInitTmpl, specJSONPath)))
+	})
+	s.echo.StaticFS(docsPath+"/", swaggerFiles.FS)
+}
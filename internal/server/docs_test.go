@@ -0,0 +1,104 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"gopkg.in/yaml.v3"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServer_docs(t *testing.T) {
+	s := newTestServer(t, config.Config{PublicURL: "https://persons.example.com/", Version: "1.2.3"}, nil)
+
+	type specHead struct {
+		Info struct {
+			Version string `json:"version" yaml:"version"`
+		} `json:"info" yaml:"info"`
+		Servers []struct {
+			URL string `json:"url" yaml:"url"`
+		} `json:"servers" yaml:"servers"`
+	}
+
+	tests := []struct {
+		name                string
+		target              string
+		expectedHTTPStatus  int
+		expectedContentType string
+		expectedBody        string
+		unmarshal           func([]byte, any) error
+	}{
+		{
+			name:                "spec as json",
+			target:              "/api/v1/openapi.json",
+			expectedHTTPStatus:  http.StatusOK,
+			expectedContentType: "application/json",
+			unmarshal:           json.Unmarshal,
+		},
+		{
+			name:                "spec as yaml",
+			target:              "/api/v1/openapi.yaml",
+			expectedHTTPStatus:  http.StatusOK,
+			expectedContentType: "application/yaml",
+			unmarshal:           yaml.Unmarshal,
+		},
+		{
+			name:               "docs redirect",
+			target:             "/docs",
+			expectedHTTPStatus: http.StatusMovedPermanently,
+		},
+		{
+			name:                "swagger ui page",
+			target:              "/docs/",
+			expectedHTTPStatus:  http.StatusOK,
+			expectedContentType: "text/html",
+			expectedBody:        "swagger-ui-bundle.js",
+		},
+		{
+			name:                "swagger ui bundle is embedded",
+			target:              "/docs/swagger-ui-bundle.js",
+			expectedHTTPStatus:  http.StatusOK,
+			expectedContentType: "javascript",
+		},
+		{
+			name:                "swagger ui points to served spec",
+			target:              "/docs/swagger-initializer.js",
+			expectedHTTPStatus:  http.StatusOK,
+			expectedContentType: "javascript",
+			expectedBody:        `"/api/v1/openapi.json"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			rw := httptest.NewRecorder()
+			s.echo.ServeHTTP(rw, req)
+
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Fatalf("GET %s http-code expected %d, but got %d", tt.target, tt.expectedHTTPStatus, rw.Code)
+			}
+			if ct := rw.Header().Get("Content-Type"); !strings.Contains(ct, tt.expectedContentType) {
+				t.Errorf("GET %s content type expected %q, but got %q", tt.target, tt.expectedContentType, ct)
+			}
+			if !strings.Contains(rw.Body.String(), tt.expectedBody) {
+				t.Errorf("GET %s expected body to contain %q", tt.target, tt.expectedBody)
+			}
+
+			if tt.unmarshal == nil {
+				return
+			}
+			var spec specHead
+			if err := tt.unmarshal(rw.Body.Bytes(), &spec); err != nil {
+				t.Fatalf("GET %s unmarshal error = %v", tt.target, err)
+			}
+			if spec.Info.Version != "1.2.3" {
+				t.Errorf("GET %s version expected %q, but got %q", tt.target, "1.2.3", spec.Info.Version)
+			}
+			if len(spec.Servers) != 1 || spec.Servers[0].URL != "https://persons.example.com" {
+				t.Errorf("GET %s servers expected %q, but got %v", tt.target, "https://persons.example.com", spec.Servers)
+			}
+		})
+	}
+}
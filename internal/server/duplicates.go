@@ -0,0 +1,133 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/charmbracelet/log"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultDuplicatesLimit    = 10
+	maxDuplicatesLimit        = 100
+	defaultDuplicatesMinScore = 0.5
+	// duplicateCandidatesFactor is how many more persons than requested are
+	// fetched by name before they are scored on all fields.
+	duplicateCandidatesFactor = 10
+)
+
+func (s *Server) FindPersonDuplicates(c echo.Context, id openapi.PersonID, params openapi.FindPersonDuplicatesParams) error {
+	if id <= 0 {
+		log.Errorf("bad id %v", id)
+		return badIDError(c)
+	}
+
+	limit := defaultDuplicatesLimit
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+		if limit <= 0 || limit > maxDuplicatesLimit {
+			log.Errorf("bad limit %v", limit)
+			return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+				Message: fmt.Sprintf("limit must be between 1 and %d", maxDuplicatesLimit),
+			})
+		}
+	}
+
+	minScore := defaultDuplicatesMinScore
+	if params.MinScore != nil {
+		minScore = float64(*params.MinScore)
+		if minScore < 0 || minScore > 1 {
+			log.Errorf("bad min_score %v", minScore)
+			return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+				Message: "min_score must be between 0 and 1",
+			})
+		}
+	}
+
+	person, err := s.repo(c).GetPersonByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("person not found, id=%v", id)
+			return notFoundError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	candidates, err := s.repo(c).GetDuplicateCandidates(person, limit*duplicateCandidatesFactor)
+	if err != nil {
+		log.Errorf("database error: %v", err)
+		return databaseError(c, err)
+	}
+
+	duplicates := models.RankDuplicates(person, candidates, minScore, limit)
+	res := make([]openapi.DuplicateCandidate, 0, len(duplicates))
+	for _, d := range duplicates {
+		res = append(res, openapi.DuplicateCandidate{
+			Score:  float32(d.Score),
+			Person: toPersonResponse(d.Person),
+		})
+	}
+	return s.respondCached(c, jsonRepresentation, res, time.Time{})
+}
+
+func (s *Server) MergePersons(c echo.Context) error {
+	var req openapi.MergeRequest
+	err := s.decodeJSON(c, &req)
+	if err != nil {
+		return badRequestBody(c, err)
+	}
+
+	merge := models.PersonMerge{
+		TargetID:  req.TargetId,
+		SourceIDs: req.SourceIds,
+		Fields:    req.Fields,
+	}
+	if err = merge.Validate(); err != nil {
+		log.Errorf("validation error: %v", err)
+		return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	person, err := s.repo(c).MergePersons(merge)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			log.Errorf("person not found, ids=%v", merge.IDs())
+			return notFoundError(c)
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			log.Errorf("merged email is already used, ids=%v", merge.IDs())
+			return conflictError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toPersonResponse(person))
+}
+
+// personGone redirects requests for merged-away persons to the survivor.
+func (s *Server) personGone(c echo.Context, id int32) error {
+	survivor, err := s.repo(c).GetMergedPersonID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("person not found, id=%v", id)
+			return notFoundError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	target := *c.Request().URL
+	target.Path = path.Join(path.Dir(target.Path), strconv.Itoa(int(survivor)))
+	target.RawPath = ""
+	return c.Redirect(http.StatusPermanentRedirect, target.RequestURI())
+}
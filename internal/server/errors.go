@@ -0,0 +1,65 @@
+package server
+
+import (
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/breaker"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/validation"
+	"github.com/labstack/echo/v4"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+const (
+	headerAcceptLanguage  = "Accept-Language"
+	headerContentLanguage = "Content-Language"
+	headerRetryAfter      = "Retry-After"
+)
+
+func internalError(c echo.Context) error {
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: "internal server error",
+	})
+}
+
+// databaseError answers with 503 and Retry-After while the database is
+// unavailable, and with 500 otherwise.
+func databaseError(c echo.Context, err error) error {
+	var unavailable *breaker.UnavailableError
+	if !errors.As(err, &unavailable) {
+		return internalError(c)
+	}
+	retryAfter := int(math.Ceil(unavailable.RetryAfter.Seconds()))
+	c.Response().Header().Set(headerRetryAfter, strconv.Itoa(max(retryAfter, 1)))
+	return c.JSON(http.StatusServiceUnavailable, openapi.ErrorResponse{
+		Message: "database is unavailable, retry later",
+	})
+}
+
+func notFoundError(c echo.Context) error {
+	return c.JSON(http.StatusNotFound, openapi.ErrorResponse{
+		Message: "person not found",
+	})
+}
+
+func conflictError(c echo.Context) error {
+	return c.JSON(http.StatusConflict, openapi.ErrorResponse{
+		Message: "email is already used by another person",
+	})
+}
+
+func badIDError(c echo.Context) error {
+	return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+		Message: "id must be a positive integer",
+	})
+}
+
+func validationError(c echo.Context, err error) error {
+	acceptLanguage := c.Request().Header.Get(headerAcceptLanguage)
+	c.Response().Header().Set(headerContentLanguage, validation.Translator(acceptLanguage).Locale())
+	return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
+		Message: "validation error",
+		Errors:  validation.FieldErrors(err, acceptLanguage),
+	})
+}
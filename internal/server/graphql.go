@@ -0,0 +1,509 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/validation"
+	"github.com/charmbracelet/log"
+	"github.com/go-playground/validator/v10"
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	graphqlPath     = "/graphql"
+	defaultPageSize = 20
+	maxPageSize     = 100
+	loaderWait      = 2 * time.Millisecond
+)
+
+type graphqlRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// mutates reports whether the operation req runs is a mutation. A query that
+// does not parse runs nothing, graphql.Do reports it.
+func (req graphqlRequest) mutates() bool {
+	doc, err := parser.Parse(parser.ParseParams{Source: req.Query})
+	if err != nil {
+		return false
+	}
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok || op.Operation != ast.OperationTypeMutation {
+			continue
+		}
+		if req.OperationName == "" || op.Name != nil && op.Name.Value == req.OperationName {
+			return true
+		}
+	}
+	return false
+}
+
+type loaderCtxKey struct{}
+
+type acceptLanguageCtxKey struct{}
+
+type graphqlResolver struct {
+	validate    *validator.Validate
+	maintenance *Maintenance
+}
+
+func (s *Server) graphql(c echo.Context) error {
+	var req graphqlRequest
+	if c.Request().Method == http.MethodGet {
+		req.Query = c.QueryParam("query")
+		req.OperationName = c.QueryParam("operationName")
+		// A GET can be sent cross-site by a link or an image, so it must
+		// not write.
+		if req.mutates() {
+			c.Response().Header().Set(echo.HeaderAllow, http.MethodPost)
+			return c.JSON(http.StatusMethodNotAllowed, echo.Map{
+				"errors": "mutations must be sent with POST",
+			})
+		}
+	} else if err := c.Bind(&req); err != nil {
+		log.Errorf("can not bind request: %v", err)
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"errors": "bad json request",
+		})
+	}
+
+	repo := s.repo(c)
+	ctx := context.WithValue(c.Request().Context(), repositoryCtxKey{}, repo)
+	ctx = context.WithValue(ctx, loaderCtxKey{}, newPersonLoader(repo))
+	ctx = context.WithValue(ctx, acceptLanguageCtxKey{}, c.Request().Header.Get(headerAcceptLanguage))
+	var w atomic.Bool
+	ctx = context.WithValue(ctx, wroteCtxKey{}, &w)
+	res := graphql.Do(graphql.Params{
+		Schema:         s.gqlSchema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        ctx,
+	})
+
+	if w.Load() {
+		c.Response().Header().Set(headerLastWrite, lastWriteNow())
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+// newPersonLoader collapses every person(id) lookup made while resolving one
+// request into a single GetPersonsByIDs call.
+func newPersonLoader(pr PersonRepository) *dataloader.Loader[int32, models.Person] {
+	batch := func(_ context.Context, ids []int32) []*dataloader.Result[models.Person] {
+		results := make([]*dataloader.Result[models.Person], len(ids))
+
+		persons, err := pr.GetPersonsByIDs(ids)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[models.Person]{Error: err}
+			}
+			return results
+		}
+
+		byID := make(map[int32]models.Person, len(persons))
+		for _, p := range persons {
+			byID[p.ID] = p
+		}
+		for i, id := range ids {
+			p, ok := byID[id]
+			if !ok {
+				results[i] = &dataloader.Result[models.Person]{Error: gorm.ErrRecordNotFound}
+				continue
+			}
+			results[i] = &dataloader.Result[models.Person]{Data: p}
+		}
+		return results
+	}
+
+	return dataloader.NewBatchedLoader(batch, dataloader.WithWait[int32, models.Person](loaderWait))
+}
+
+func newGraphQLSchema(v *validator.Validate, m *Maintenance) (graphql.Schema, error) {
+	r := &graphqlResolver{validate: v, maintenance: m}
+
+	postalAddressType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PostalAddress",
+		Fields: graphql.Fields{
+			"country": &graphql.Field{Type: graphql.String},
+			"city":    &graphql.Field{Type: graphql.String},
+			"street":  &graphql.Field{Type: graphql.String},
+			"postalCode": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*openapi.PostalAddress).PostalCode, nil
+				},
+			},
+		},
+	})
+
+	personType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Person",
+		Fields: graphql.Fields{
+			"id":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"name":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"age":     &graphql.Field{Type: graphql.Int},
+			"email":   &graphql.Field{Type: graphql.String},
+			"phone":   &graphql.Field{Type: graphql.String},
+			"address": &graphql.Field{Type: graphql.String},
+			"work":    &graphql.Field{Type: graphql.String},
+			"birthDate": &graphql.Field{
+				Type:        graphql.String,
+				Description: "YYYY-MM-DD",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if d := p.Source.(openapi.PersonResponse).BirthDate; d != nil {
+						return d.Format(time.DateOnly), nil
+					}
+					return nil, nil
+				},
+			},
+			"postalAddress": &graphql.Field{
+				Type: postalAddressType,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if a := p.Source.(openapi.PersonResponse).PostalAddress; a != nil {
+						return a, nil
+					}
+					return nil, nil
+				},
+			},
+			"createdAt": &graphql.Field{
+				Type: graphql.DateTime,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(openapi.PersonResponse).CreatedAt, nil
+				},
+			},
+			"updatedAt": &graphql.Field{
+				Type: graphql.DateTime,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(openapi.PersonResponse).UpdatedAt, nil
+				},
+			},
+		},
+	})
+
+	edgeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PersonEdge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: graphql.NewNonNull(personType)},
+		},
+	})
+
+	pageInfoType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: graphql.Fields{
+			"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"endCursor":   &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	connectionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PersonConnection",
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edgeType)))},
+			"pageInfo": &graphql.Field{Type: graphql.NewNonNull(pageInfoType)},
+		},
+	})
+
+	filterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "PersonFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"address": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"work":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"minAge":  &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"maxAge":  &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		},
+	})
+
+	sortFieldType := graphql.NewEnum(graphql.EnumConfig{
+		Name: "PersonSortField",
+		Values: graphql.EnumValueConfigMap{
+			"ID":         &graphql.EnumValueConfig{Value: models.PersonSortByID},
+			"NAME":       &graphql.EnumValueConfig{Value: models.PersonSortByName},
+			"AGE":        &graphql.EnumValueConfig{Value: models.PersonSortByAge},
+			"CREATED_AT": &graphql.EnumValueConfig{Value: models.PersonSortByCreatedAt},
+			"UPDATED_AT": &graphql.EnumValueConfig{Value: models.PersonSortByUpdatedAt},
+		},
+	})
+
+	sortDirectionType := graphql.NewEnum(graphql.EnumConfig{
+		Name: "SortDirection",
+		Values: graphql.EnumValueConfigMap{
+			"ASC":  &graphql.EnumValueConfig{Value: false},
+			"DESC": &graphql.EnumValueConfig{Value: true},
+		},
+	})
+
+	sortType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "PersonSort",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"field":     &graphql.InputObjectFieldConfig{Type: sortFieldType, DefaultValue: models.PersonSortByID},
+			"direction": &graphql.InputObjectFieldConfig{Type: sortDirectionType, DefaultValue: false},
+		},
+	})
+
+	postalAddressInputType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "PostalAddressInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"country":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"city":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"street":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"postalCode": &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	})
+
+	personInputType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "PersonInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":          &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"age":           &graphql.InputObjectFieldConfig{Type: graphql.Int, Description: "Ignored when birthDate is set."},
+			"email":         &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"phone":         &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"birthDate":     &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "YYYY-MM-DD"},
+			"address":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"postalAddress": &graphql.InputObjectFieldConfig{Type: postalAddressInputType},
+			"work":          &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"person": &graphql.Field{
+				Type: personType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: r.person,
+			},
+			"persons": &graphql.Field{
+				Type: graphql.NewNonNull(connectionType),
+				Args: graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: filterType},
+					"sort":   &graphql.ArgumentConfig{Type: sortType},
+					"first":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultPageSize},
+					"after":  &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: r.persons,
+			},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createPerson": &graphql.Field{
+				Type: graphql.NewNonNull(personType),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(personInputType)},
+				},
+				Resolve: r.write(r.createPerson),
+			},
+			"updatePerson": &graphql.Field{
+				Type: graphql.NewNonNull(personType),
+				Args: graphql.FieldConfigArgument{
+					"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(personInputType)},
+				},
+				Resolve: r.write(r.updatePerson),
+			},
+			"deletePerson": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: r.write(r.deletePerson),
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query, Mutation: mutation})
+}
+
+func (r *graphqlResolver) person(p graphql.ResolveParams) (any, error) {
+	loader, ok := p.Context.Value(loaderCtxKey{}).(*dataloader.Loader[int32, models.Person])
+	if !ok {
+		return nil, errors.New("person loader is not set")
+	}
+
+	thunk := loader.Load(p.Context, int32(p.Args["id"].(int)))
+	return func() (any, error) {
+		person, err := thunk()
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil
+			}
+			log.Errorf("database error %v", err)
+			return nil, errors.New("internal server error")
+		}
+		return toPersonResponse(person), nil
+	}, nil
+}
+
+func (r *graphqlResolver) persons(p graphql.ResolveParams) (any, error) {
+	first, _ := p.Args["first"].(int)
+	if first <= 0 || first > maxPageSize {
+		return nil, fmt.Errorf("first must be between 1 and %d", maxPageSize)
+	}
+
+	q := models.PersonQuery{SortBy: models.PersonSortByID, Limit: first + 1}
+	if sort, ok := p.Args["sort"].(map[string]any); ok {
+		q.SortBy, _ = sort["field"].(models.PersonSortField)
+		q.Desc, _ = sort["direction"].(bool)
+		if q.SortBy == "" {
+			q.SortBy = models.PersonSortByID
+		}
+	}
+	if filter, ok := p.Args["filter"].(map[string]any); ok {
+		q.Filter.Name, _ = filter["name"].(string)
+		q.Filter.Address, _ = filter["address"].(string)
+		q.Filter.Work, _ = filter["work"].(string)
+		if minAge, ok := filter["minAge"].(int); ok {
+			q.Filter.MinAge = int32(minAge)
+		}
+		if maxAge, ok := filter["maxAge"].(int); ok {
+			q.Filter.MaxAge = int32(maxAge)
+		}
+	}
+	if after, ok := p.Args["after"].(string); ok && after != "" {
+		cursor, err := models.DecodePersonPageCursor(after)
+		if err != nil || cursor.SortBy != q.SortBy {
+			return nil, errors.New("bad cursor")
+		}
+		q.After = &cursor
+	}
+
+	persons, err := repositoryFrom(p.Context).ListPersons(q)
+	if err != nil {
+		log.Errorf("database error %v", err)
+		return nil, errors.New("internal server error")
+	}
+
+	hasNext := len(persons) > first
+	if hasNext {
+		persons = persons[:first]
+	}
+
+	edges := make([]map[string]any, 0, len(persons))
+	var endCursor any
+	for _, person := range persons {
+		cursor := models.NewPersonPageCursor(q.SortBy, person).Encode()
+		edges = append(edges, map[string]any{
+			"cursor": cursor,
+			"node":   toPersonResponse(person),
+		})
+		endCursor = cursor
+	}
+
+	return map[string]any{
+		"edges": edges,
+		"pageInfo": map[string]any{
+			"hasNextPage": hasNext,
+			"endCursor":   endCursor,
+		},
+	}, nil
+}
+
+func (r *graphqlResolver) createPerson(p graphql.ResolveParams) (any, error) {
+	person, err := personFromInput(p.Args["input"])
+	if err != nil {
+		return nil, err
+	}
+	if err = r.validate.Struct(person); err != nil {
+		acceptLanguage, _ := p.Context.Value(acceptLanguageCtxKey{}).(string)
+		return nil, errors.New(validation.Message(err, acceptLanguage))
+	}
+
+	person, err = repositoryFrom(p.Context).CreatePerson(person)
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("email is already used by another person")
+		}
+		log.Errorf("database error: %v", err)
+		return nil, errors.New("internal server error")
+	}
+	return toPersonResponse(person), nil
+}
+
+func (r *graphqlResolver) updatePerson(p graphql.ResolveParams) (any, error) {
+	id := int32(p.Args["id"].(int))
+	person, err := personFromInput(p.Args["input"])
+	if err != nil {
+		return nil, err
+	}
+	if err = r.validate.Struct(person); err != nil {
+		acceptLanguage, _ := p.Context.Value(acceptLanguageCtxKey{}).(string)
+		return nil, errors.New(validation.Message(err, acceptLanguage))
+	}
+
+	person.ID = id
+	if err = repositoryFrom(p.Context).UpdatePersonByID(id, person); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("person not found")
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("email is already used by another person")
+		}
+		log.Errorf("database error %v", err)
+		return nil, errors.New("internal server error")
+	}
+
+	person, err = repositoryFrom(p.Context).GetPersonByID(id)
+	if err != nil {
+		log.Errorf("database error %v", err)
+		return nil, errors.New("internal server error")
+	}
+	return toPersonResponse(person), nil
+}
+
+func (r *graphqlResolver) deletePerson(p graphql.ResolveParams) (any, error) {
+	if err := repositoryFrom(p.Context).DeletePersonByID(int32(p.Args["id"].(int))); err != nil {
+		log.Errorf("database error %v", err)
+		return nil, errors.New("internal server error")
+	}
+	return true, nil
+}
+
+func personFromInput(raw any) (models.Person, error) {
+	in, _ := raw.(map[string]any)
+
+	var person models.Person
+	person.Name, _ = in["name"].(string)
+	person.Email, _ = in["email"].(string)
+	person.Phone, _ = in["phone"].(string)
+	person.Address, _ = in["address"].(string)
+	person.Work, _ = in["work"].(string)
+	if age, ok := in["age"].(int); ok {
+		person.Age = int32(age)
+	}
+	if raw, ok := in["birthDate"].(string); ok && raw != "" {
+		birthDate, err := time.Parse(time.DateOnly, raw)
+		if err != nil {
+			return models.Person{}, fmt.Errorf("bad birthDate: %w", err)
+		}
+		person.BirthDate = &birthDate
+		person.Age = 0
+	}
+	if address, ok := in["postalAddress"].(map[string]any); ok {
+		person.PostalAddress.Country, _ = address["country"].(string)
+		person.PostalAddress.City, _ = address["city"].(string)
+		person.PostalAddress.Street, _ = address["street"].(string)
+		person.PostalAddress.PostalCode, _ = address["postalCode"].(string)
+	}
+	return person, nil
+}
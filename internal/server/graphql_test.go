@@ -0,0 +1,270 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/validation"
+	"github.com/gojuno/minimock/v3"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type graphqlResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func doGraphQL(t *testing.T, pr PersonRepository, query string) graphqlResponse {
+	t.Helper()
+
+	schema, err := newGraphQLSchema(validation.Default(), nil)
+	if err != nil {
+		t.Fatalf("newGraphQLSchema() error = %v", err)
+	}
+	s := &Server{
+		echo:      echo.New(),
+		pr:        pr,
+		gqlSchema: schema,
+	}
+
+	body, err := json.Marshal(graphqlRequest{Query: query})
+	if err != nil {
+		t.Fatalf("json marshal error")
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	req.Header.Set("Content-type", "application/json")
+	rw := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rw)
+
+	if err = s.graphql(c); err != nil {
+		t.Fatalf("graphql() error = %v", err)
+	}
+	if rw.Code != http.StatusOK {
+		t.Fatalf("graphql() http-code expected %d, but got %d", http.StatusOK, rw.Code)
+	}
+
+	var res graphqlResponse
+	if err = json.Unmarshal(rw.Body.Bytes(), &res); err != nil {
+		t.Fatalf("json unmarshal error")
+	}
+	return res
+}
+
+func TestServer_graphqlPersonBatching(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	second := regularPerson
+	second.ID = 2
+	second.Name = "second"
+
+	pr := NewPersonRepositoryMock(mc)
+	pr.GetPersonsByIDsMock.
+		Times(1).
+		Inspect(func(ids []int32) {
+			if len(ids) != 3 {
+				t.Errorf("GetPersonsByIDs() expected 3 ids in one batch, but got %v", ids)
+			}
+		}).
+		Return([]models.Person{regularPerson, second}, nil)
+
+	res := doGraphQL(t, pr, `{
+		a: person(id: 1) { id name }
+		b: person(id: 2) { id name }
+		c: person(id: 3) { id name }
+	}`)
+
+	if len(res.Errors) != 0 {
+		t.Fatalf("graphql() unexpected errors %v", res.Errors)
+	}
+
+	var b openapi.PersonResponse
+	if err := json.Unmarshal(res.Data["b"], &b); err != nil {
+		t.Fatalf("json unmarshal error")
+	}
+	if b.Name != second.Name {
+		t.Errorf("graphql() expected %v, but got %v", second.Name, b.Name)
+	}
+	if string(res.Data["c"]) != "null" {
+		t.Errorf("graphql() expected missing person to be null, but got %s", res.Data["c"])
+	}
+}
+
+func TestServer_graphqlPersons(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	second := regularPerson
+	second.ID = 2
+
+	tests := []struct {
+		name          string
+		pr            PersonRepository
+		query         string
+		expectedError bool
+		expectedNext  bool
+	}{
+		{
+			name: "has next page",
+			pr: NewPersonRepositoryMock(mc).ListPersonsMock.
+				Expect(models.PersonQuery{
+					Filter: models.PersonFilter{Name: "te", MinAge: 1},
+					SortBy: models.PersonSortByName,
+					Desc:   true,
+					Limit:  2,
+				}).
+				Return([]models.Person{regularPerson, second}, nil),
+			query:        `{ persons(first: 1, filter: {name: "te", minAge: 1}, sort: {field: NAME, direction: DESC}) { edges { cursor node { id } } pageInfo { hasNextPage endCursor } } }`,
+			expectedNext: true,
+		},
+		{
+			name:         "last page",
+			pr:           NewPersonRepositoryMock(mc).ListPersonsMock.Return([]models.Person{regularPerson}, nil),
+			query:        `{ persons { edges { node { id } } pageInfo { hasNextPage } } }`,
+			expectedNext: false,
+		},
+		{
+			name:          "bad cursor",
+			pr:            nil,
+			query:         `{ persons(after: "qwerty") { pageInfo { hasNextPage } } }`,
+			expectedError: true,
+		},
+		{
+			name:          "database error",
+			pr:            NewPersonRepositoryMock(mc).ListPersonsMock.Return(nil, errors.New("database error")),
+			query:         `{ persons { pageInfo { hasNextPage } } }`,
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := doGraphQL(t, tt.pr, tt.query)
+			if (len(res.Errors) != 0) != tt.expectedError {
+				t.Fatalf("graphql() expected error %v, but got %v", tt.expectedError, res.Errors)
+			}
+			if tt.expectedError {
+				return
+			}
+
+			var persons struct {
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			}
+			if err := json.Unmarshal(res.Data["persons"], &persons); err != nil {
+				t.Fatalf("json unmarshal error")
+			}
+			if persons.PageInfo.HasNextPage != tt.expectedNext {
+				t.Errorf("graphql() hasNextPage expected %v, but got %v", tt.expectedNext, persons.PageInfo.HasNextPage)
+			}
+		})
+	}
+}
+
+func TestServer_graphqlMutations(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name          string
+		pr            PersonRepository
+		query         string
+		expectedError bool
+	}{
+		{
+			name:  "create",
+			pr:    NewPersonRepositoryMock(mc).CreatePersonMock.Return(regularPerson, nil),
+			query: `mutation { createPerson(input: {name: "test", age: 1}) { id } }`,
+		},
+		{
+			name:          "create validation error",
+			pr:            nil,
+			query:         `mutation { createPerson(input: {name: "test", age: -10}) { id } }`,
+			expectedError: true,
+		},
+		{
+			name: "update",
+			pr: NewPersonRepositoryMock(mc).UpdatePersonByIDMock.Return(nil).
+				GetPersonByIDMock.Return(regularPerson, nil),
+			query: `mutation { updatePerson(id: 1, input: {name: "test"}) { id } }`,
+		},
+		{
+			name:  "delete",
+			pr:    NewPersonRepositoryMock(mc).DeletePersonByIDMock.Expect(1).Return(nil),
+			query: `mutation { deletePerson(id: 1) }`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := doGraphQL(t, tt.pr, tt.query)
+			if (len(res.Errors) != 0) != tt.expectedError {
+				t.Errorf("graphql() expected error %v, but got %v", tt.expectedError, res.Errors)
+			}
+		})
+	}
+}
+
+func TestServer_graphqlGET(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	schema, err := newGraphQLSchema(validation.Default(), nil)
+	if err != nil {
+		t.Fatalf("newGraphQLSchema() error = %v", err)
+	}
+
+	tests := []struct {
+		name               string
+		pr                 PersonRepository
+		query              string
+		operationName      string
+		expectedHTTPStatus int
+	}{
+		{
+			name:               "query",
+			pr:                 NewPersonRepositoryMock(mc).ListPersonsMock.Return([]models.Person{regularPerson}, nil),
+			query:              `{ persons { pageInfo { hasNextPage } } }`,
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "mutation",
+			pr:                 nil,
+			query:              `mutation { deletePerson(id: 1) }`,
+			expectedHTTPStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:               "named mutation",
+			pr:                 nil,
+			query:              `query list { persons { pageInfo { hasNextPage } } } mutation remove { deletePerson(id: 1) }`,
+			operationName:      "remove",
+			expectedHTTPStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:               "query next to a mutation",
+			pr:                 NewPersonRepositoryMock(mc).ListPersonsMock.Return([]models.Person{regularPerson}, nil),
+			query:              `query list { persons { pageInfo { hasNextPage } } } mutation remove { deletePerson(id: 1) }`,
+			operationName:      "list",
+			expectedHTTPStatus: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{echo: echo.New(), pr: tt.pr, gqlSchema: schema}
+			params := url.Values{"query": {tt.query}, "operationName": {tt.operationName}}
+			req := httptest.NewRequest(http.MethodGet, "/graphql?"+params.Encode(), nil)
+			rw := httptest.NewRecorder()
+
+			if err := s.graphql(s.echo.NewContext(req, rw)); err != nil {
+				t.Fatalf("graphql() error = %v", err)
+			}
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Errorf("graphql() http-code expected %d, but got %d", tt.expectedHTTPStatus, rw.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/breaker"
+	personv1 "github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/person/v1"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/validation"
+	"github.com/charmbracelet/log"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"net"
+	"time"
+)
+
+const maxBatchCreateSize = 1000
+
+type GRPCServer struct {
+	personv1.UnimplementedPersonServiceServer
+
+	grpc        *grpc.Server
+	repos       PersonRepositories
+	tenants     *tenantResolver
+	validate    *validator.Validate
+	maintenance *Maintenance
+}
+
+// NewGRPC accepts a nil maintenance.
+func NewGRPC(pr PersonRepository, maintenance *Maintenance) *GRPCServer {
+	return NewMultiTenantGRPC(config.Config{}, singleTenant(pr), maintenance, nil)
+}
+
+// NewMultiTenantGRPC serves plain connections when creds are nil.
+func NewMultiTenantGRPC(cfg config.Config, repos PersonRepositories, maintenance *Maintenance, creds credentials.TransportCredentials) *GRPCServer {
+	s := &GRPCServer{
+		repos:       repos,
+		tenants:     newTenantResolver(cfg),
+		validate:    validation.Default(),
+		maintenance: maintenance,
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(logUnary, s.refuseWrites, markWritesUnary, s.scopeTenantUnary),
+		grpc.ChainStreamInterceptor(s.scopeTenantStream),
+	}
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+	}
+	s.grpc = grpc.NewServer(opts...)
+	personv1.RegisterPersonServiceServer(s.grpc, s)
+	return s
+}
+
+func (s *GRPCServer) Serve(lis net.Listener) error {
+	log.Info("grpc server starting on", "addr", lis.Addr().String())
+	return s.grpc.Serve(lis)
+}
+
+func (s *GRPCServer) Run(port int) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("grpc listen error: %w", err)
+	}
+	return s.Serve(lis)
+}
+
+// Shutdown waits for the calls in flight until ctx is done, and then cancels
+// the remaining ones.
+func (s *GRPCServer) Shutdown(ctx context.Context) error {
+	log.Info("grpc server shutting down")
+	done := make(chan struct{})
+	go func() {
+		s.grpc.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.grpc.Stop()
+		return ctx.Err()
+	}
+}
+
+func (s *GRPCServer) GetPerson(ctx context.Context, req *personv1.GetPersonRequest) (*personv1.Person, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id must be positive")
+	}
+
+	person, err := repositoryFrom(ctx).GetPersonByID(req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoPerson(person), nil
+}
+
+func (s *GRPCServer) ListPersons(req *personv1.ListPersonsRequest, stream personv1.PersonService_ListPersonsServer) error {
+	pr := repositoryFrom(stream.Context())
+	if req.GetUpdatedSince() == nil {
+		persons, err := pr.GetAllPerson()
+		if err != nil {
+			return toStatus(err)
+		}
+		return sendPersons(stream, persons)
+	}
+
+	if err := req.GetUpdatedSince().CheckValid(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "bad updated_since: %v", err)
+	}
+
+	since := req.GetUpdatedSince().AsTime()
+	var after *models.PersonCursor
+	for {
+		changes, err := pr.GetPersonsUpdatedSince(since, after, maxChangesLimit)
+		if err != nil {
+			return toStatus(err)
+		}
+		for _, c := range changes {
+			p := &personv1.Person{Id: c.ID, UpdatedAt: timestamppb.New(c.UpdatedAt), Deleted: true}
+			if !c.Deleted {
+				p = toProtoPerson(c.Person)
+			}
+			if err = stream.Send(p); err != nil {
+				return err
+			}
+		}
+		if len(changes) < maxChangesLimit {
+			return nil
+		}
+
+		cursor := models.NewPersonCursor(changes[len(changes)-1])
+		after = &cursor
+	}
+}
+
+func (s *GRPCServer) CreatePerson(ctx context.Context, req *personv1.CreatePersonRequest) (*personv1.Person, error) {
+	person, err := s.personFromInput(ctx, req.GetPerson())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	person, err = repositoryFrom(ctx).CreatePerson(person)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoPerson(person), nil
+}
+
+func (s *GRPCServer) UpdatePerson(ctx context.Context, req *personv1.UpdatePersonRequest) (*personv1.Person, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id must be positive")
+	}
+
+	person, err := s.personFromInput(ctx, req.GetPerson())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	person.ID = req.GetId()
+	if err = repositoryFrom(ctx).UpdatePersonByID(person.ID, person); err != nil {
+		return nil, toStatus(err)
+	}
+
+	person, err = repositoryFrom(ctx).GetPersonByID(req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoPerson(person), nil
+}
+
+func (s *GRPCServer) DeletePerson(ctx context.Context, req *personv1.DeletePersonRequest) (*personv1.DeletePersonResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id must be positive")
+	}
+
+	if err := repositoryFrom(ctx).DeletePersonByID(req.GetId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &personv1.DeletePersonResponse{}, nil
+}
+
+func (s *GRPCServer) BatchCreatePersons(ctx context.Context, req *personv1.BatchCreatePersonsRequest) (*personv1.BatchCreatePersonsResponse, error) {
+	if len(req.GetPersons()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "persons must not be empty")
+	}
+	if len(req.GetPersons()) > maxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d persons can be created at once", maxBatchCreateSize)
+	}
+
+	persons := make([]models.Person, 0, len(req.GetPersons()))
+	for i, in := range req.GetPersons() {
+		person, err := s.personFromInput(ctx, in)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "persons[%d]: %v", i, err)
+		}
+		persons = append(persons, person)
+	}
+
+	persons, err := repositoryFrom(ctx).CreatePersons(persons)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := &personv1.BatchCreatePersonsResponse{Persons: make([]*personv1.Person, 0, len(persons))}
+	for _, p := range persons {
+		res.Persons = append(res.Persons, toProtoPerson(p))
+	}
+	return res, nil
+}
+
+func sendPersons(stream personv1.PersonService_ListPersonsServer, persons []models.Person) error {
+	for _, p := range persons {
+		if err := stream.Send(toProtoPerson(p)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toStatus maps repository errors to the same classes the REST handlers
+// answer with: 404 -> NotFound, constraint violations -> FailedPrecondition,
+// 503 -> Unavailable.
+func toStatus(err error) error {
+	var unavailable *breaker.UnavailableError
+	switch {
+	case errors.As(err, &unavailable):
+		return status.Error(codes.Unavailable, "database is unavailable, retry later")
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "person not found")
+	case errors.Is(err, gorm.ErrDuplicatedKey), errors.Is(err, gorm.ErrForeignKeyViolated), errors.Is(err, gorm.ErrCheckConstraintViolated):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		log.Errorf("database error %v", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+func logUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	logStr := fmt.Sprintf("%s %s", info.FullMethod, status.Code(err))
+	if p, ok := grpcPrincipal(ctx); ok {
+		logStr = fmt.Sprintf("%s, client: %s", logStr, p)
+	}
+	if err != nil {
+		log.Error(fmt.Sprintf("%s, err: %v", logStr, err))
+		return resp, err
+	}
+	log.Info(logStr)
+	return resp, err
+}
+
+// Validation errors are in the language of the accept-language metadata.
+func (s *GRPCServer) personFromInput(ctx context.Context, in *personv1.PersonInput) (models.Person, error) {
+	person := models.Person{
+		Name:    in.GetName(),
+		Age:     in.GetAge(),
+		Email:   in.GetEmail(),
+		Phone:   in.GetPhone(),
+		Address: in.GetAddress(),
+		Work:    in.GetWork(),
+	}
+	if in.GetBirthDate() != "" {
+		birthDate, err := time.Parse(time.DateOnly, in.GetBirthDate())
+		if err != nil {
+			return models.Person{}, fmt.Errorf("bad birth_date: %w", err)
+		}
+		person.BirthDate = &birthDate
+		person.Age = 0
+	}
+	if a := in.GetPostalAddress(); a != nil {
+		person.PostalAddress = models.PostalAddress{
+			Country:    a.GetCountry(),
+			City:       a.GetCity(),
+			Street:     a.GetStreet(),
+			PostalCode: a.GetPostalCode(),
+		}
+	}
+
+	if err := s.validate.Struct(person); err != nil {
+		var acceptLanguage string
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("accept-language")) > 0 {
+			acceptLanguage = md.Get("accept-language")[0]
+		}
+		return models.Person{}, errors.New(validation.Message(err, acceptLanguage))
+	}
+	return person, nil
+}
+
+func toProtoPerson(p models.Person) *personv1.Person {
+	res := &personv1.Person{
+		Id:        p.ID,
+		Name:      p.Name,
+		Age:       p.CurrentAge(),
+		Email:     p.Email,
+		Phone:     p.Phone,
+		Address:   p.DisplayAddress(),
+		Work:      p.Work,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+		UpdatedAt: timestamppb.New(p.UpdatedAt),
+	}
+	if p.BirthDate != nil {
+		res.BirthDate = p.BirthDate.Format(time.DateOnly)
+	}
+	if !p.PostalAddress.IsZero() {
+		res.PostalAddress = &personv1.PostalAddress{
+			Country:    p.PostalAddress.Country,
+			City:       p.PostalAddress.City,
+			Street:     p.PostalAddress.Street,
+			PostalCode: p.PostalAddress.PostalCode,
+		}
+	}
+	return res
+}
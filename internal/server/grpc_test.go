@@ -0,0 +1,262 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/breaker"
+	personv1 "github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/person/v1"
+	"github.com/gojuno/minimock/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/gorm"
+	"io"
+	"net"
+	"testing"
+)
+
+func newBufconnClient(t *testing.T, pr PersonRepository) personv1.PersonServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := NewGRPC(pr, nil)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(func() {
+		if err := s.Shutdown(context.Background()); err != nil {
+			t.Error(err)
+		}
+	})
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc dial error: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return personv1.NewPersonServiceClient(conn)
+}
+
+func TestGRPCServer_GetPerson(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name         string
+		pr           PersonRepository
+		id           int32
+		expectedCode codes.Code
+	}{
+		{
+			name:         "OK: person found",
+			pr:           NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(regularPerson, nil),
+			id:           1,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "InvalidArgument: bad id",
+			pr:           nil,
+			id:           -1,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "NotFound: person not found",
+			pr:           NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, gorm.ErrRecordNotFound),
+			id:           1,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "Internal: database error",
+			pr:           NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, errors.New("database error")),
+			id:           1,
+			expectedCode: codes.Internal,
+		},
+		{
+			name:         "Unavailable: database is down",
+			pr:           NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, &breaker.UnavailableError{Err: breaker.ErrOpen}),
+			id:           1,
+			expectedCode: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newBufconnClient(t, tt.pr)
+
+			res, err := client.GetPerson(context.Background(), &personv1.GetPersonRequest{Id: tt.id})
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Errorf("GetPerson() code expected %v, but got %v", tt.expectedCode, code)
+			}
+
+			if tt.expectedCode == codes.OK && res.GetName() != regularPerson.Name {
+				t.Errorf("GetPerson() expected %v, but got %v", regularPerson.Name, res.GetName())
+			}
+		})
+	}
+}
+
+func TestGRPCServer_ListPersons(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	pr := NewPersonRepositoryMock(mc).GetAllPersonMock.Return([]models.Person{regularPerson, regularPerson}, nil)
+	client := newBufconnClient(t, pr)
+
+	stream, err := client.ListPersons(context.Background(), &personv1.ListPersonsRequest{})
+	if err != nil {
+		t.Fatalf("ListPersons() error = %v", err)
+	}
+
+	var count int
+	for {
+		_, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("ListPersons() recv error = %v", err)
+		}
+		count++
+	}
+
+	if count != 2 {
+		t.Errorf("ListPersons() expected %d persons, but got %d", 2, count)
+	}
+}
+
+func TestGRPCServer_CreatePerson(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name         string
+		pr           PersonRepository
+		person       *personv1.PersonInput
+		expectedCode codes.Code
+	}{
+		{
+			name:         "OK: created",
+			pr:           NewPersonRepositoryMock(mc).CreatePersonMock.Return(regularPerson, nil),
+			person:       &personv1.PersonInput{Name: "test", Age: 1},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "InvalidArgument: validation error",
+			pr:           nil,
+			person:       &personv1.PersonInput{Name: "test", Age: -10},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "FailedPrecondition: constraint violation",
+			pr:           NewPersonRepositoryMock(mc).CreatePersonMock.Return(models.Person{}, gorm.ErrDuplicatedKey),
+			person:       &personv1.PersonInput{Name: "test"},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newBufconnClient(t, tt.pr)
+
+			_, err := client.CreatePerson(context.Background(), &personv1.CreatePersonRequest{Person: tt.person})
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Errorf("CreatePerson() code expected %v, but got %v", tt.expectedCode, code)
+			}
+		})
+	}
+}
+
+func TestGRPCServer_UpdatePerson(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name         string
+		pr           PersonRepository
+		expectedCode codes.Code
+	}{
+		{
+			name: "OK: updated",
+			pr: NewPersonRepositoryMock(mc).UpdatePersonByIDMock.Return(nil).
+				GetPersonByIDMock.Return(regularPerson, nil),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "NotFound: person not found",
+			pr:           NewPersonRepositoryMock(mc).UpdatePersonByIDMock.Return(gorm.ErrRecordNotFound),
+			expectedCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newBufconnClient(t, tt.pr)
+
+			_, err := client.UpdatePerson(context.Background(), &personv1.UpdatePersonRequest{
+				Id:     1,
+				Person: &personv1.PersonInput{Name: "test"},
+			})
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Errorf("UpdatePerson() code expected %v, but got %v", tt.expectedCode, code)
+			}
+		})
+	}
+}
+
+func TestGRPCServer_DeletePerson(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	client := newBufconnClient(t, NewPersonRepositoryMock(mc).DeletePersonByIDMock.Expect(1).Return(nil))
+
+	_, err := client.DeletePerson(context.Background(), &personv1.DeletePersonRequest{Id: 1})
+	if err != nil {
+		t.Errorf("DeletePerson() error = %v", err)
+	}
+}
+
+func TestGRPCServer_BatchCreatePersons(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name         string
+		pr           PersonRepository
+		persons      []*personv1.PersonInput
+		expectedCode codes.Code
+		expectedLen  int
+	}{
+		{
+			name:         "OK: created",
+			pr:           NewPersonRepositoryMock(mc).CreatePersonsMock.Return([]models.Person{regularPerson, regularPerson}, nil),
+			persons:      []*personv1.PersonInput{{Name: "first"}, {Name: "second"}},
+			expectedCode: codes.OK,
+			expectedLen:  2,
+		},
+		{
+			name:         "InvalidArgument: empty batch",
+			pr:           nil,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "InvalidArgument: one person is invalid",
+			pr:           nil,
+			persons:      []*personv1.PersonInput{{Name: "first"}, {}},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newBufconnClient(t, tt.pr)
+
+			res, err := client.BatchCreatePersons(context.Background(), &personv1.BatchCreatePersonsRequest{Persons: tt.persons})
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Errorf("BatchCreatePersons() code expected %v, but got %v", tt.expectedCode, code)
+			}
+
+			if len(res.GetPersons()) != tt.expectedLen {
+				t.Errorf("BatchCreatePersons() expected %d persons, but got %d", tt.expectedLen, len(res.GetPersons()))
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	personv1 "github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/person/v1"
+	"github.com/charmbracelet/log"
+	"github.com/graphql-go/graphql"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"sync/atomic"
+)
+
+const maintenanceMessage = "service is in maintenance, only reads are served"
+
+// Maintenance mode refuses writes and keeps serving reads.
+type Maintenance struct {
+	on atomic.Bool
+}
+
+// Enabled is false for a nil Maintenance.
+func (m *Maintenance) Enabled() bool {
+	return m != nil && m.on.Load()
+}
+
+func (m *Maintenance) Set(on bool) {
+	if m.on.Swap(on) != on {
+		log.Warn("maintenance mode switched", "enabled", on)
+	}
+}
+
+var grpcWrites = map[string]bool{
+	personv1.PersonService_CreatePerson_FullMethodName:       true,
+	personv1.PersonService_UpdatePerson_FullMethodName:       true,
+	personv1.PersonService_DeletePerson_FullMethodName:       true,
+	personv1.PersonService_BatchCreatePersons_FullMethodName: true,
+}
+
+// GraphQL queries are posted too, so mutations are refused by their resolvers.
+func (s *Server) refuseWrites(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		switch {
+		case !s.maintenance.Enabled(), c.Path() == graphqlPath:
+			return next(c)
+		}
+		switch c.Request().Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return next(c)
+		}
+		return c.JSON(http.StatusServiceUnavailable, openapi.ErrorResponse{
+			Message: maintenanceMessage,
+		})
+	}
+}
+
+func (s *GRPCServer) refuseWrites(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if s.maintenance.Enabled() && grpcWrites[info.FullMethod] {
+		return nil, status.Error(codes.Unavailable, maintenanceMessage)
+	}
+	return handler(ctx, req)
+}
+
+func (r *graphqlResolver) write(resolve graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		if r.maintenance.Enabled() {
+			return nil, errors.New(maintenanceMessage)
+		}
+		res, err := resolve(p)
+		if err == nil {
+			wrote(p.Context)
+		}
+		return res, err
+	}
+}
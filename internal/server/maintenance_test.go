@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	personv1 "github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/person/v1"
+	"github.com/gojuno/minimock/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServer_maintenance(t *testing.T) {
+	mc := minimock.NewController(t)
+	pr := NewPersonRepositoryMock(mc).
+		GetAllPersonMock.Return([]models.Person{regularPerson}, nil).
+		GetPersonsByIDsMock.Return([]models.Person{regularPerson}, nil)
+	s := newTestServer(t, config.Config{}, pr)
+	s.Maintenance().Set(true)
+
+	tests := []struct {
+		name               string
+		method             string
+		path               string
+		body               string
+		expectedHTTPStatus int
+		expectedBody       string
+	}{
+		{
+			name:               "http-200: reads are served",
+			method:             http.MethodGet,
+			path:               "/api/v1/persons",
+			expectedHTTPStatus: 200,
+		},
+		{
+			name:               "http-503: create",
+			method:             http.MethodPost,
+			path:               "/api/v1/persons",
+			body:               `{"name": "Ivan"}`,
+			expectedHTTPStatus: 503,
+			expectedBody:       maintenanceMessage,
+		},
+		{
+			name:               "http-503: update",
+			method:             http.MethodPatch,
+			path:               "/api/v1/persons/1",
+			body:               `{"name": "Ivan"}`,
+			expectedHTTPStatus: 503,
+			expectedBody:       maintenanceMessage,
+		},
+		{
+			name:               "http-503: delete",
+			method:             http.MethodDelete,
+			path:               "/api/v1/persons/1",
+			expectedHTTPStatus: 503,
+			expectedBody:       maintenanceMessage,
+		},
+		{
+			name:               "http-200: graphql query",
+			method:             http.MethodPost,
+			path:               "/graphql",
+			body:               `{"query": "{ person(id: 1) { name } }"}`,
+			expectedHTTPStatus: 200,
+			expectedBody:       regularPerson.Name,
+		},
+		{
+			name:               "http-200: graphql mutation refused",
+			method:             http.MethodPost,
+			path:               "/graphql",
+			body:               `{"query": "mutation { deletePerson(id: 1) }"}`,
+			expectedHTTPStatus: 200,
+			expectedBody:       maintenanceMessage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rw := httptest.NewRecorder()
+			s.ServeHTTP(rw, req)
+
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Errorf("%s %s http-code expected %d, but got %d", tt.method, tt.path, tt.expectedHTTPStatus, rw.Code)
+			}
+			if !strings.Contains(rw.Body.String(), tt.expectedBody) {
+				t.Errorf("%s %s body expected to contain %q, but got %q", tt.method, tt.path, tt.expectedBody, rw.Body.String())
+			}
+		})
+	}
+}
+
+func TestGRPCServer_maintenance(t *testing.T) {
+	m := &Maintenance{}
+	m.Set(true)
+	s := NewGRPC(NewPersonRepositoryMock(minimock.NewController(t)), m)
+	handler := func(context.Context, any) (any, error) { return &personv1.Person{}, nil }
+
+	tests := []struct {
+		method       string
+		expectedCode codes.Code
+	}{
+		{method: personv1.PersonService_GetPerson_FullMethodName, expectedCode: codes.OK},
+		{method: personv1.PersonService_CreatePerson_FullMethodName, expectedCode: codes.Unavailable},
+		{method: personv1.PersonService_UpdatePerson_FullMethodName, expectedCode: codes.Unavailable},
+		{method: personv1.PersonService_DeletePerson_FullMethodName, expectedCode: codes.Unavailable},
+		{method: personv1.PersonService_BatchCreatePersons_FullMethodName, expectedCode: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			_, err := s.refuseWrites(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Errorf("%s code expected %s, but got %s", tt.method, tt.expectedCode, code)
+			}
+		})
+	}
+}
@@ -5,17 +5,33 @@ package server
 import (
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories"
 	"github.com/gojuno/minimock/v3"
 )
 
-// PersonRepositoryMock implements personRepository
+// PersonRepositoryMock implements PersonRepository
 type PersonRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCreateEmployment          func(employment models.Employment) (e1 models.Employment, err error)
+	funcCreateEmploymentOrigin    string
+	inspectFuncCreateEmployment   func(employment models.Employment)
+	afterCreateEmploymentCounter  uint64
+	beforeCreateEmploymentCounter uint64
+	CreateEmploymentMock          mPersonRepositoryMockCreateEmployment
+
+	funcCreateOrganization          func(organization models.Organization) (o1 models.Organization, err error)
+	funcCreateOrganizationOrigin    string
+	inspectFuncCreateOrganization   func(organization models.Organization)
+	afterCreateOrganizationCounter  uint64
+	beforeCreateOrganizationCounter uint64
+	CreateOrganizationMock          mPersonRepositoryMockCreateOrganization
+
 	funcCreatePerson          func(person models.Person) (p1 models.Person, err error)
 	funcCreatePersonOrigin    string
 	inspectFuncCreatePerson   func(person models.Person)
@@ -23,6 +39,34 @@ type PersonRepositoryMock struct {
 	beforeCreatePersonCounter uint64
 	CreatePersonMock          mPersonRepositoryMockCreatePerson
 
+	funcCreatePersons          func(persons []models.Person) (pa1 []models.Person, err error)
+	funcCreatePersonsOrigin    string
+	inspectFuncCreatePersons   func(persons []models.Person)
+	afterCreatePersonsCounter  uint64
+	beforeCreatePersonsCounter uint64
+	CreatePersonsMock          mPersonRepositoryMockCreatePersons
+
+	funcCreateRelation          func(relation models.PersonRelation) (p1 models.PersonRelation, err error)
+	funcCreateRelationOrigin    string
+	inspectFuncCreateRelation   func(relation models.PersonRelation)
+	afterCreateRelationCounter  uint64
+	beforeCreateRelationCounter uint64
+	CreateRelationMock          mPersonRepositoryMockCreateRelation
+
+	funcDeleteEmployment          func(personID int32, employmentID int32) (err error)
+	funcDeleteEmploymentOrigin    string
+	inspectFuncDeleteEmployment   func(personID int32, employmentID int32)
+	afterDeleteEmploymentCounter  uint64
+	beforeDeleteEmploymentCounter uint64
+	DeleteEmploymentMock          mPersonRepositoryMockDeleteEmployment
+
+	funcDeleteOrganizationByID          func(id int32) (err error)
+	funcDeleteOrganizationByIDOrigin    string
+	inspectFuncDeleteOrganizationByID   func(id int32)
+	afterDeleteOrganizationByIDCounter  uint64
+	beforeDeleteOrganizationByIDCounter uint64
+	DeleteOrganizationByIDMock          mPersonRepositoryMockDeleteOrganizationByID
+
 	funcDeletePersonByID          func(id int32) (err error)
 	funcDeletePersonByIDOrigin    string
 	inspectFuncDeletePersonByID   func(id int32)
@@ -30,6 +74,13 @@ type PersonRepositoryMock struct {
 	beforeDeletePersonByIDCounter uint64
 	DeletePersonByIDMock          mPersonRepositoryMockDeletePersonByID
 
+	funcDeleteRelation          func(personID int32, relationID int32) (err error)
+	funcDeleteRelationOrigin    string
+	inspectFuncDeleteRelation   func(personID int32, relationID int32)
+	afterDeleteRelationCounter  uint64
+	beforeDeleteRelationCounter uint64
+	DeleteRelationMock          mPersonRepositoryMockDeleteRelation
+
 	funcGetAllPerson          func() (pa1 []models.Person, err error)
 	funcGetAllPersonOrigin    string
 	inspectFuncGetAllPerson   func()
@@ -37,6 +88,41 @@ type PersonRepositoryMock struct {
 	beforeGetAllPersonCounter uint64
 	GetAllPersonMock          mPersonRepositoryMockGetAllPerson
 
+	funcGetDuplicateCandidates          func(person models.Person, limit int) (pa1 []models.Person, err error)
+	funcGetDuplicateCandidatesOrigin    string
+	inspectFuncGetDuplicateCandidates   func(person models.Person, limit int)
+	afterGetDuplicateCandidatesCounter  uint64
+	beforeGetDuplicateCandidatesCounter uint64
+	GetDuplicateCandidatesMock          mPersonRepositoryMockGetDuplicateCandidates
+
+	funcGetEmployments          func(personID int32) (ea1 []models.Employment, err error)
+	funcGetEmploymentsOrigin    string
+	inspectFuncGetEmployments   func(personID int32)
+	afterGetEmploymentsCounter  uint64
+	beforeGetEmploymentsCounter uint64
+	GetEmploymentsMock          mPersonRepositoryMockGetEmployments
+
+	funcGetMergedPersonID          func(id int32) (i1 int32, err error)
+	funcGetMergedPersonIDOrigin    string
+	inspectFuncGetMergedPersonID   func(id int32)
+	afterGetMergedPersonIDCounter  uint64
+	beforeGetMergedPersonIDCounter uint64
+	GetMergedPersonIDMock          mPersonRepositoryMockGetMergedPersonID
+
+	funcGetOrganizationByID          func(id int32) (o1 models.Organization, err error)
+	funcGetOrganizationByIDOrigin    string
+	inspectFuncGetOrganizationByID   func(id int32)
+	afterGetOrganizationByIDCounter  uint64
+	beforeGetOrganizationByIDCounter uint64
+	GetOrganizationByIDMock          mPersonRepositoryMockGetOrganizationByID
+
+	funcGetOrganizationEmployments          func(organizationID int32) (ea1 []models.Employment, err error)
+	funcGetOrganizationEmploymentsOrigin    string
+	inspectFuncGetOrganizationEmployments   func(organizationID int32)
+	afterGetOrganizationEmploymentsCounter  uint64
+	beforeGetOrganizationEmploymentsCounter uint64
+	GetOrganizationEmploymentsMock          mPersonRepositoryMockGetOrganizationEmployments
+
 	funcGetPersonByID          func(id int32) (p1 models.Person, err error)
 	funcGetPersonByIDOrigin    string
 	inspectFuncGetPersonByID   func(id int32)
@@ -44,6 +130,76 @@ type PersonRepositoryMock struct {
 	beforeGetPersonByIDCounter uint64
 	GetPersonByIDMock          mPersonRepositoryMockGetPersonByID
 
+	funcGetPersonsByIDs          func(ids []int32) (pa1 []models.Person, err error)
+	funcGetPersonsByIDsOrigin    string
+	inspectFuncGetPersonsByIDs   func(ids []int32)
+	afterGetPersonsByIDsCounter  uint64
+	beforeGetPersonsByIDsCounter uint64
+	GetPersonsByIDsMock          mPersonRepositoryMockGetPersonsByIDs
+
+	funcGetPersonsUpdatedSince          func(since time.Time, after *models.PersonCursor, limit int) (pa1 []models.PersonChange, err error)
+	funcGetPersonsUpdatedSinceOrigin    string
+	inspectFuncGetPersonsUpdatedSince   func(since time.Time, after *models.PersonCursor, limit int)
+	afterGetPersonsUpdatedSinceCounter  uint64
+	beforeGetPersonsUpdatedSinceCounter uint64
+	GetPersonsUpdatedSinceMock          mPersonRepositoryMockGetPersonsUpdatedSince
+
+	funcGetRelatedPersons          func(query models.RelationQuery) (ra1 []models.RelatedPerson, err error)
+	funcGetRelatedPersonsOrigin    string
+	inspectFuncGetRelatedPersons   func(query models.RelationQuery)
+	afterGetRelatedPersonsCounter  uint64
+	beforeGetRelatedPersonsCounter uint64
+	GetRelatedPersonsMock          mPersonRepositoryMockGetRelatedPersons
+
+	funcListOrganizations          func(name string) (oa1 []models.Organization, err error)
+	funcListOrganizationsOrigin    string
+	inspectFuncListOrganizations   func(name string)
+	afterListOrganizationsCounter  uint64
+	beforeListOrganizationsCounter uint64
+	ListOrganizationsMock          mPersonRepositoryMockListOrganizations
+
+	funcListPersons          func(query models.PersonQuery) (pa1 []models.Person, err error)
+	funcListPersonsOrigin    string
+	inspectFuncListPersons   func(query models.PersonQuery)
+	afterListPersonsCounter  uint64
+	beforeListPersonsCounter uint64
+	ListPersonsMock          mPersonRepositoryMockListPersons
+
+	funcMergePersons          func(merge models.PersonMerge) (p1 models.Person, err error)
+	funcMergePersonsOrigin    string
+	inspectFuncMergePersons   func(merge models.PersonMerge)
+	afterMergePersonsCounter  uint64
+	beforeMergePersonsCounter uint64
+	MergePersonsMock          mPersonRepositoryMockMergePersons
+
+	funcRefreshWork          func() (ia1 []int32, err error)
+	funcRefreshWorkOrigin    string
+	inspectFuncRefreshWork   func()
+	afterRefreshWorkCounter  uint64
+	beforeRefreshWorkCounter uint64
+	RefreshWorkMock          mPersonRepositoryMockRefreshWork
+
+	funcSession          func(lastWrite time.Time) (s1 repositories.Storage)
+	funcSessionOrigin    string
+	inspectFuncSession   func(lastWrite time.Time)
+	afterSessionCounter  uint64
+	beforeSessionCounter uint64
+	SessionMock          mPersonRepositoryMockSession
+
+	funcUpdateEmployment          func(personID int32, employmentID int32, employment models.Employment) (err error)
+	funcUpdateEmploymentOrigin    string
+	inspectFuncUpdateEmployment   func(personID int32, employmentID int32, employment models.Employment)
+	afterUpdateEmploymentCounter  uint64
+	beforeUpdateEmploymentCounter uint64
+	UpdateEmploymentMock          mPersonRepositoryMockUpdateEmployment
+
+	funcUpdateOrganizationByID          func(id int32, organization models.Organization) (err error)
+	funcUpdateOrganizationByIDOrigin    string
+	inspectFuncUpdateOrganizationByID   func(id int32, organization models.Organization)
+	afterUpdateOrganizationByIDCounter  uint64
+	beforeUpdateOrganizationByIDCounter uint64
+	UpdateOrganizationByIDMock          mPersonRepositoryMockUpdateOrganizationByID
+
 	funcUpdatePersonByID          func(id int32, person models.Person) (err error)
 	funcUpdatePersonByIDOrigin    string
 	inspectFuncUpdatePersonByID   func(id int32, person models.Person)
@@ -52,7 +208,7 @@ type PersonRepositoryMock struct {
 	UpdatePersonByIDMock          mPersonRepositoryMockUpdatePersonByID
 }
 
-// NewPersonRepositoryMock returns a mock for personRepository
+// NewPersonRepositoryMock returns a mock for PersonRepository
 func NewPersonRepositoryMock(t minimock.Tester) *PersonRepositoryMock {
 	m := &PersonRepositoryMock{t: t}
 
@@ -60,17 +216,82 @@ func NewPersonRepositoryMock(t minimock.Tester) *PersonRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CreateEmploymentMock = mPersonRepositoryMockCreateEmployment{mock: m}
+	m.CreateEmploymentMock.callArgs = []*PersonRepositoryMockCreateEmploymentParams{}
+
+	m.CreateOrganizationMock = mPersonRepositoryMockCreateOrganization{mock: m}
+	m.CreateOrganizationMock.callArgs = []*PersonRepositoryMockCreateOrganizationParams{}
+
 	m.CreatePersonMock = mPersonRepositoryMockCreatePerson{mock: m}
 	m.CreatePersonMock.callArgs = []*PersonRepositoryMockCreatePersonParams{}
 
+	m.CreatePersonsMock = mPersonRepositoryMockCreatePersons{mock: m}
+	m.CreatePersonsMock.callArgs = []*PersonRepositoryMockCreatePersonsParams{}
+
+	m.CreateRelationMock = mPersonRepositoryMockCreateRelation{mock: m}
+	m.CreateRelationMock.callArgs = []*PersonRepositoryMockCreateRelationParams{}
+
+	m.DeleteEmploymentMock = mPersonRepositoryMockDeleteEmployment{mock: m}
+	m.DeleteEmploymentMock.callArgs = []*PersonRepositoryMockDeleteEmploymentParams{}
+
+	m.DeleteOrganizationByIDMock = mPersonRepositoryMockDeleteOrganizationByID{mock: m}
+	m.DeleteOrganizationByIDMock.callArgs = []*PersonRepositoryMockDeleteOrganizationByIDParams{}
+
 	m.DeletePersonByIDMock = mPersonRepositoryMockDeletePersonByID{mock: m}
 	m.DeletePersonByIDMock.callArgs = []*PersonRepositoryMockDeletePersonByIDParams{}
 
+	m.DeleteRelationMock = mPersonRepositoryMockDeleteRelation{mock: m}
+	m.DeleteRelationMock.callArgs = []*PersonRepositoryMockDeleteRelationParams{}
+
 	m.GetAllPersonMock = mPersonRepositoryMockGetAllPerson{mock: m}
 
+	m.GetDuplicateCandidatesMock = mPersonRepositoryMockGetDuplicateCandidates{mock: m}
+	m.GetDuplicateCandidatesMock.callArgs = []*PersonRepositoryMockGetDuplicateCandidatesParams{}
+
+	m.GetEmploymentsMock = mPersonRepositoryMockGetEmployments{mock: m}
+	m.GetEmploymentsMock.callArgs = []*PersonRepositoryMockGetEmploymentsParams{}
+
+	m.GetMergedPersonIDMock = mPersonRepositoryMockGetMergedPersonID{mock: m}
+	m.GetMergedPersonIDMock.callArgs = []*PersonRepositoryMockGetMergedPersonIDParams{}
+
+	m.GetOrganizationByIDMock = mPersonRepositoryMockGetOrganizationByID{mock: m}
+	m.GetOrganizationByIDMock.callArgs = []*PersonRepositoryMockGetOrganizationByIDParams{}
+
+	m.GetOrganizationEmploymentsMock = mPersonRepositoryMockGetOrganizationEmployments{mock: m}
+	m.GetOrganizationEmploymentsMock.callArgs = []*PersonRepositoryMockGetOrganizationEmploymentsParams{}
+
 	m.GetPersonByIDMock = mPersonRepositoryMockGetPersonByID{mock: m}
 	m.GetPersonByIDMock.callArgs = []*PersonRepositoryMockGetPersonByIDParams{}
 
+	m.GetPersonsByIDsMock = mPersonRepositoryMockGetPersonsByIDs{mock: m}
+	m.GetPersonsByIDsMock.callArgs = []*PersonRepositoryMockGetPersonsByIDsParams{}
+
+	m.GetPersonsUpdatedSinceMock = mPersonRepositoryMockGetPersonsUpdatedSince{mock: m}
+	m.GetPersonsUpdatedSinceMock.callArgs = []*PersonRepositoryMockGetPersonsUpdatedSinceParams{}
+
+	m.GetRelatedPersonsMock = mPersonRepositoryMockGetRelatedPersons{mock: m}
+	m.GetRelatedPersonsMock.callArgs = []*PersonRepositoryMockGetRelatedPersonsParams{}
+
+	m.ListOrganizationsMock = mPersonRepositoryMockListOrganizations{mock: m}
+	m.ListOrganizationsMock.callArgs = []*PersonRepositoryMockListOrganizationsParams{}
+
+	m.ListPersonsMock = mPersonRepositoryMockListPersons{mock: m}
+	m.ListPersonsMock.callArgs = []*PersonRepositoryMockListPersonsParams{}
+
+	m.MergePersonsMock = mPersonRepositoryMockMergePersons{mock: m}
+	m.MergePersonsMock.callArgs = []*PersonRepositoryMockMergePersonsParams{}
+
+	m.RefreshWorkMock = mPersonRepositoryMockRefreshWork{mock: m}
+
+	m.SessionMock = mPersonRepositoryMockSession{mock: m}
+	m.SessionMock.callArgs = []*PersonRepositoryMockSessionParams{}
+
+	m.UpdateEmploymentMock = mPersonRepositoryMockUpdateEmployment{mock: m}
+	m.UpdateEmploymentMock.callArgs = []*PersonRepositoryMockUpdateEmploymentParams{}
+
+	m.UpdateOrganizationByIDMock = mPersonRepositoryMockUpdateOrganizationByID{mock: m}
+	m.UpdateOrganizationByIDMock.callArgs = []*PersonRepositoryMockUpdateOrganizationByIDParams{}
+
 	m.UpdatePersonByIDMock = mPersonRepositoryMockUpdatePersonByID{mock: m}
 	m.UpdatePersonByIDMock.callArgs = []*PersonRepositoryMockUpdatePersonByIDParams{}
 
@@ -79,50 +300,50 @@ func NewPersonRepositoryMock(t minimock.Tester) *PersonRepositoryMock {
 	return m
 }
 
-type mPersonRepositoryMockCreatePerson struct {
+type mPersonRepositoryMockCreateEmployment struct {
 	optional           bool
 	mock               *PersonRepositoryMock
-	defaultExpectation *PersonRepositoryMockCreatePersonExpectation
-	expectations       []*PersonRepositoryMockCreatePersonExpectation
+	defaultExpectation *PersonRepositoryMockCreateEmploymentExpectation
+	expectations       []*PersonRepositoryMockCreateEmploymentExpectation
 
-	callArgs []*PersonRepositoryMockCreatePersonParams
+	callArgs []*PersonRepositoryMockCreateEmploymentParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// PersonRepositoryMockCreatePersonExpectation specifies expectation struct of the personRepository.CreatePerson
-type PersonRepositoryMockCreatePersonExpectation struct {
+// PersonRepositoryMockCreateEmploymentExpectation specifies expectation struct of the PersonRepository.CreateEmployment
+type PersonRepositoryMockCreateEmploymentExpectation struct {
 	mock               *PersonRepositoryMock
-	params             *PersonRepositoryMockCreatePersonParams
-	paramPtrs          *PersonRepositoryMockCreatePersonParamPtrs
-	expectationOrigins PersonRepositoryMockCreatePersonExpectationOrigins
-	results            *PersonRepositoryMockCreatePersonResults
+	params             *PersonRepositoryMockCreateEmploymentParams
+	paramPtrs          *PersonRepositoryMockCreateEmploymentParamPtrs
+	expectationOrigins PersonRepositoryMockCreateEmploymentExpectationOrigins
+	results            *PersonRepositoryMockCreateEmploymentResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// PersonRepositoryMockCreatePersonParams contains parameters of the personRepository.CreatePerson
-type PersonRepositoryMockCreatePersonParams struct {
-	person models.Person
+// PersonRepositoryMockCreateEmploymentParams contains parameters of the PersonRepository.CreateEmployment
+type PersonRepositoryMockCreateEmploymentParams struct {
+	employment models.Employment
 }
 
-// PersonRepositoryMockCreatePersonParamPtrs contains pointers to parameters of the personRepository.CreatePerson
-type PersonRepositoryMockCreatePersonParamPtrs struct {
-	person *models.Person
+// PersonRepositoryMockCreateEmploymentParamPtrs contains pointers to parameters of the PersonRepository.CreateEmployment
+type PersonRepositoryMockCreateEmploymentParamPtrs struct {
+	employment *models.Employment
 }
 
-// PersonRepositoryMockCreatePersonResults contains results of the personRepository.CreatePerson
-type PersonRepositoryMockCreatePersonResults struct {
-	p1  models.Person
+// PersonRepositoryMockCreateEmploymentResults contains results of the PersonRepository.CreateEmployment
+type PersonRepositoryMockCreateEmploymentResults struct {
+	e1  models.Employment
 	err error
 }
 
-// PersonRepositoryMockCreatePersonOrigins contains origins of expectations of the personRepository.CreatePerson
-type PersonRepositoryMockCreatePersonExpectationOrigins struct {
-	origin       string
-	originPerson string
+// PersonRepositoryMockCreateEmploymentOrigins contains origins of expectations of the PersonRepository.CreateEmployment
+type PersonRepositoryMockCreateEmploymentExpectationOrigins struct {
+	origin           string
+	originEmployment string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -130,310 +351,311 @@ type PersonRepositoryMockCreatePersonExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Optional() *mPersonRepositoryMockCreatePerson {
-	mmCreatePerson.optional = true
-	return mmCreatePerson
+func (mmCreateEmployment *mPersonRepositoryMockCreateEmployment) Optional() *mPersonRepositoryMockCreateEmployment {
+	mmCreateEmployment.optional = true
+	return mmCreateEmployment
 }
 
-// Expect sets up expected params for personRepository.CreatePerson
-func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Expect(person models.Person) *mPersonRepositoryMockCreatePerson {
-	if mmCreatePerson.mock.funcCreatePerson != nil {
-		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by Set")
+// Expect sets up expected params for PersonRepository.CreateEmployment
+func (mmCreateEmployment *mPersonRepositoryMockCreateEmployment) Expect(employment models.Employment) *mPersonRepositoryMockCreateEmployment {
+	if mmCreateEmployment.mock.funcCreateEmployment != nil {
+		mmCreateEmployment.mock.t.Fatalf("PersonRepositoryMock.CreateEmployment mock is already set by Set")
 	}
 
-	if mmCreatePerson.defaultExpectation == nil {
-		mmCreatePerson.defaultExpectation = &PersonRepositoryMockCreatePersonExpectation{}
+	if mmCreateEmployment.defaultExpectation == nil {
+		mmCreateEmployment.defaultExpectation = &PersonRepositoryMockCreateEmploymentExpectation{}
 	}
 
-	if mmCreatePerson.defaultExpectation.paramPtrs != nil {
-		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by ExpectParams functions")
+	if mmCreateEmployment.defaultExpectation.paramPtrs != nil {
+		mmCreateEmployment.mock.t.Fatalf("PersonRepositoryMock.CreateEmployment mock is already set by ExpectParams functions")
 	}
 
-	mmCreatePerson.defaultExpectation.params = &PersonRepositoryMockCreatePersonParams{person}
-	mmCreatePerson.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreatePerson.expectations {
-		if minimock.Equal(e.params, mmCreatePerson.defaultExpectation.params) {
-			mmCreatePerson.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePerson.defaultExpectation.params)
+	mmCreateEmployment.defaultExpectation.params = &PersonRepositoryMockCreateEmploymentParams{employment}
+	mmCreateEmployment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateEmployment.expectations {
+		if minimock.Equal(e.params, mmCreateEmployment.defaultExpectation.params) {
+			mmCreateEmployment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateEmployment.defaultExpectation.params)
 		}
 	}
 
-	return mmCreatePerson
+	return mmCreateEmployment
 }
 
-// ExpectPersonParam1 sets up expected param person for personRepository.CreatePerson
-func (mmCreatePerson *mPersonRepositoryMockCreatePerson) ExpectPersonParam1(person models.Person) *mPersonRepositoryMockCreatePerson {
-	if mmCreatePerson.mock.funcCreatePerson != nil {
-		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by Set")
+// ExpectEmploymentParam1 sets up expected param employment for PersonRepository.CreateEmployment
+func (mmCreateEmployment *mPersonRepositoryMockCreateEmployment) ExpectEmploymentParam1(employment models.Employment) *mPersonRepositoryMockCreateEmployment {
+	if mmCreateEmployment.mock.funcCreateEmployment != nil {
+		mmCreateEmployment.mock.t.Fatalf("PersonRepositoryMock.CreateEmployment mock is already set by Set")
 	}
 
-	if mmCreatePerson.defaultExpectation == nil {
-		mmCreatePerson.defaultExpectation = &PersonRepositoryMockCreatePersonExpectation{}
+	if mmCreateEmployment.defaultExpectation == nil {
+		mmCreateEmployment.defaultExpectation = &PersonRepositoryMockCreateEmploymentExpectation{}
 	}
 
-	if mmCreatePerson.defaultExpectation.params != nil {
-		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by Expect")
+	if mmCreateEmployment.defaultExpectation.params != nil {
+		mmCreateEmployment.mock.t.Fatalf("PersonRepositoryMock.CreateEmployment mock is already set by Expect")
 	}
 
-	if mmCreatePerson.defaultExpectation.paramPtrs == nil {
-		mmCreatePerson.defaultExpectation.paramPtrs = &PersonRepositoryMockCreatePersonParamPtrs{}
+	if mmCreateEmployment.defaultExpectation.paramPtrs == nil {
+		mmCreateEmployment.defaultExpectation.paramPtrs = &PersonRepositoryMockCreateEmploymentParamPtrs{}
 	}
-	mmCreatePerson.defaultExpectation.paramPtrs.person = &person
-	mmCreatePerson.defaultExpectation.expectationOrigins.originPerson = minimock.CallerInfo(1)
+	mmCreateEmployment.defaultExpectation.paramPtrs.employment = &employment
+	mmCreateEmployment.defaultExpectation.expectationOrigins.originEmployment = minimock.CallerInfo(1)
 
-	return mmCreatePerson
+	return mmCreateEmployment
 }
 
-// Inspect accepts an inspector function that has same arguments as the personRepository.CreatePerson
-func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Inspect(f func(person models.Person)) *mPersonRepositoryMockCreatePerson {
-	if mmCreatePerson.mock.inspectFuncCreatePerson != nil {
-		mmCreatePerson.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.CreatePerson")
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.CreateEmployment
+func (mmCreateEmployment *mPersonRepositoryMockCreateEmployment) Inspect(f func(employment models.Employment)) *mPersonRepositoryMockCreateEmployment {
+	if mmCreateEmployment.mock.inspectFuncCreateEmployment != nil {
+		mmCreateEmployment.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.CreateEmployment")
 	}
 
-	mmCreatePerson.mock.inspectFuncCreatePerson = f
+	mmCreateEmployment.mock.inspectFuncCreateEmployment = f
 
-	return mmCreatePerson
+	return mmCreateEmployment
 }
 
-// Return sets up results that will be returned by personRepository.CreatePerson
-func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Return(p1 models.Person, err error) *PersonRepositoryMock {
-	if mmCreatePerson.mock.funcCreatePerson != nil {
-		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by Set")
+// Return sets up results that will be returned by PersonRepository.CreateEmployment
+func (mmCreateEmployment *mPersonRepositoryMockCreateEmployment) Return(e1 models.Employment, err error) *PersonRepositoryMock {
+	if mmCreateEmployment.mock.funcCreateEmployment != nil {
+		mmCreateEmployment.mock.t.Fatalf("PersonRepositoryMock.CreateEmployment mock is already set by Set")
 	}
 
-	if mmCreatePerson.defaultExpectation == nil {
-		mmCreatePerson.defaultExpectation = &PersonRepositoryMockCreatePersonExpectation{mock: mmCreatePerson.mock}
+	if mmCreateEmployment.defaultExpectation == nil {
+		mmCreateEmployment.defaultExpectation = &PersonRepositoryMockCreateEmploymentExpectation{mock: mmCreateEmployment.mock}
 	}
-	mmCreatePerson.defaultExpectation.results = &PersonRepositoryMockCreatePersonResults{p1, err}
-	mmCreatePerson.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreatePerson.mock
+	mmCreateEmployment.defaultExpectation.results = &PersonRepositoryMockCreateEmploymentResults{e1, err}
+	mmCreateEmployment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateEmployment.mock
 }
 
-// Set uses given function f to mock the personRepository.CreatePerson method
-func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Set(f func(person models.Person) (p1 models.Person, err error)) *PersonRepositoryMock {
-	if mmCreatePerson.defaultExpectation != nil {
-		mmCreatePerson.mock.t.Fatalf("Default expectation is already set for the personRepository.CreatePerson method")
+// Set uses given function f to mock the PersonRepository.CreateEmployment method
+func (mmCreateEmployment *mPersonRepositoryMockCreateEmployment) Set(f func(employment models.Employment) (e1 models.Employment, err error)) *PersonRepositoryMock {
+	if mmCreateEmployment.defaultExpectation != nil {
+		mmCreateEmployment.mock.t.Fatalf("Default expectation is already set for the PersonRepository.CreateEmployment method")
 	}
 
-	if len(mmCreatePerson.expectations) > 0 {
-		mmCreatePerson.mock.t.Fatalf("Some expectations are already set for the personRepository.CreatePerson method")
+	if len(mmCreateEmployment.expectations) > 0 {
+		mmCreateEmployment.mock.t.Fatalf("Some expectations are already set for the PersonRepository.CreateEmployment method")
 	}
 
-	mmCreatePerson.mock.funcCreatePerson = f
-	mmCreatePerson.mock.funcCreatePersonOrigin = minimock.CallerInfo(1)
-	return mmCreatePerson.mock
+	mmCreateEmployment.mock.funcCreateEmployment = f
+	mmCreateEmployment.mock.funcCreateEmploymentOrigin = minimock.CallerInfo(1)
+	return mmCreateEmployment.mock
 }
 
-// When sets expectation for the personRepository.CreatePerson which will trigger the result defined by the following
+// When sets expectation for the PersonRepository.CreateEmployment which will trigger the result defined by the following
 // Then helper
-func (mmCreatePerson *mPersonRepositoryMockCreatePerson) When(person models.Person) *PersonRepositoryMockCreatePersonExpectation {
-	if mmCreatePerson.mock.funcCreatePerson != nil {
-		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by Set")
+func (mmCreateEmployment *mPersonRepositoryMockCreateEmployment) When(employment models.Employment) *PersonRepositoryMockCreateEmploymentExpectation {
+	if mmCreateEmployment.mock.funcCreateEmployment != nil {
+		mmCreateEmployment.mock.t.Fatalf("PersonRepositoryMock.CreateEmployment mock is already set by Set")
 	}
 
-	expectation := &PersonRepositoryMockCreatePersonExpectation{
-		mock:               mmCreatePerson.mock,
-		params:             &PersonRepositoryMockCreatePersonParams{person},
-		expectationOrigins: PersonRepositoryMockCreatePersonExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &PersonRepositoryMockCreateEmploymentExpectation{
+		mock:               mmCreateEmployment.mock,
+		params:             &PersonRepositoryMockCreateEmploymentParams{employment},
+		expectationOrigins: PersonRepositoryMockCreateEmploymentExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreatePerson.expectations = append(mmCreatePerson.expectations, expectation)
+	mmCreateEmployment.expectations = append(mmCreateEmployment.expectations, expectation)
 	return expectation
 }
 
-// Then sets up personRepository.CreatePerson return parameters for the expectation previously defined by the When method
-func (e *PersonRepositoryMockCreatePersonExpectation) Then(p1 models.Person, err error) *PersonRepositoryMock {
-	e.results = &PersonRepositoryMockCreatePersonResults{p1, err}
+// Then sets up PersonRepository.CreateEmployment return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockCreateEmploymentExpectation) Then(e1 models.Employment, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockCreateEmploymentResults{e1, err}
 	return e.mock
 }
 
-// Times sets number of times personRepository.CreatePerson should be invoked
-func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Times(n uint64) *mPersonRepositoryMockCreatePerson {
+// Times sets number of times PersonRepository.CreateEmployment should be invoked
+func (mmCreateEmployment *mPersonRepositoryMockCreateEmployment) Times(n uint64) *mPersonRepositoryMockCreateEmployment {
 	if n == 0 {
-		mmCreatePerson.mock.t.Fatalf("Times of PersonRepositoryMock.CreatePerson mock can not be zero")
+		mmCreateEmployment.mock.t.Fatalf("Times of PersonRepositoryMock.CreateEmployment mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreatePerson.expectedInvocations, n)
-	mmCreatePerson.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreatePerson
+	mm_atomic.StoreUint64(&mmCreateEmployment.expectedInvocations, n)
+	mmCreateEmployment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateEmployment
 }
 
-func (mmCreatePerson *mPersonRepositoryMockCreatePerson) invocationsDone() bool {
-	if len(mmCreatePerson.expectations) == 0 && mmCreatePerson.defaultExpectation == nil && mmCreatePerson.mock.funcCreatePerson == nil {
+func (mmCreateEmployment *mPersonRepositoryMockCreateEmployment) invocationsDone() bool {
+	if len(mmCreateEmployment.expectations) == 0 && mmCreateEmployment.defaultExpectation == nil && mmCreateEmployment.mock.funcCreateEmployment == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreatePerson.mock.afterCreatePersonCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePerson.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateEmployment.mock.afterCreateEmploymentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateEmployment.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreatePerson implements personRepository
-func (mmCreatePerson *PersonRepositoryMock) CreatePerson(person models.Person) (p1 models.Person, err error) {
-	mm_atomic.AddUint64(&mmCreatePerson.beforeCreatePersonCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreatePerson.afterCreatePersonCounter, 1)
+// CreateEmployment implements PersonRepository
+func (mmCreateEmployment *PersonRepositoryMock) CreateEmployment(employment models.Employment) (e1 models.Employment, err error) {
+	mm_atomic.AddUint64(&mmCreateEmployment.beforeCreateEmploymentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateEmployment.afterCreateEmploymentCounter, 1)
 
-	mmCreatePerson.t.Helper()
+	mmCreateEmployment.t.Helper()
 
-	if mmCreatePerson.inspectFuncCreatePerson != nil {
-		mmCreatePerson.inspectFuncCreatePerson(person)
+	if mmCreateEmployment.inspectFuncCreateEmployment != nil {
+		mmCreateEmployment.inspectFuncCreateEmployment(employment)
 	}
 
-	mm_params := PersonRepositoryMockCreatePersonParams{person}
+	mm_params := PersonRepositoryMockCreateEmploymentParams{employment}
 
 	// Record call args
-	mmCreatePerson.CreatePersonMock.mutex.Lock()
-	mmCreatePerson.CreatePersonMock.callArgs = append(mmCreatePerson.CreatePersonMock.callArgs, &mm_params)
-	mmCreatePerson.CreatePersonMock.mutex.Unlock()
+	mmCreateEmployment.CreateEmploymentMock.mutex.Lock()
+	mmCreateEmployment.CreateEmploymentMock.callArgs = append(mmCreateEmployment.CreateEmploymentMock.callArgs, &mm_params)
+	mmCreateEmployment.CreateEmploymentMock.mutex.Unlock()
 
-	for _, e := range mmCreatePerson.CreatePersonMock.expectations {
+	for _, e := range mmCreateEmployment.CreateEmploymentMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.p1, e.results.err
+			return e.results.e1, e.results.err
 		}
 	}
 
-	if mmCreatePerson.CreatePersonMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreatePerson.CreatePersonMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreatePerson.CreatePersonMock.defaultExpectation.params
-		mm_want_ptrs := mmCreatePerson.CreatePersonMock.defaultExpectation.paramPtrs
+	if mmCreateEmployment.CreateEmploymentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateEmployment.CreateEmploymentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateEmployment.CreateEmploymentMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateEmployment.CreateEmploymentMock.defaultExpectation.paramPtrs
 
-		mm_got := PersonRepositoryMockCreatePersonParams{person}
+		mm_got := PersonRepositoryMockCreateEmploymentParams{employment}
 
 		if mm_want_ptrs != nil {
 
-			if mm_want_ptrs.person != nil && !minimock.Equal(*mm_want_ptrs.person, mm_got.person) {
-				mmCreatePerson.t.Errorf("PersonRepositoryMock.CreatePerson got unexpected parameter person, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreatePerson.CreatePersonMock.defaultExpectation.expectationOrigins.originPerson, *mm_want_ptrs.person, mm_got.person, minimock.Diff(*mm_want_ptrs.person, mm_got.person))
+			if mm_want_ptrs.employment != nil && !minimock.Equal(*mm_want_ptrs.employment, mm_got.employment) {
+				mmCreateEmployment.t.Errorf("PersonRepositoryMock.CreateEmployment got unexpected parameter employment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateEmployment.CreateEmploymentMock.defaultExpectation.expectationOrigins.originEmployment, *mm_want_ptrs.employment, mm_got.employment, minimock.Diff(*mm_want_ptrs.employment, mm_got.employment))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreatePerson.t.Errorf("PersonRepositoryMock.CreatePerson got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreatePerson.CreatePersonMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreateEmployment.t.Errorf("PersonRepositoryMock.CreateEmployment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateEmployment.CreateEmploymentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreatePerson.CreatePersonMock.defaultExpectation.results
+		mm_results := mmCreateEmployment.CreateEmploymentMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreatePerson.t.Fatal("No results are set for the PersonRepositoryMock.CreatePerson")
+			mmCreateEmployment.t.Fatal("No results are set for the PersonRepositoryMock.CreateEmployment")
 		}
-		return (*mm_results).p1, (*mm_results).err
+		return (*mm_results).e1, (*mm_results).err
 	}
-	if mmCreatePerson.funcCreatePerson != nil {
-		return mmCreatePerson.funcCreatePerson(person)
+	if mmCreateEmployment.funcCreateEmployment != nil {
+		return mmCreateEmployment.funcCreateEmployment(employment)
 	}
-	mmCreatePerson.t.Fatalf("Unexpected call to PersonRepositoryMock.CreatePerson. %v", person)
+	mmCreateEmployment.t.Fatalf("Unexpected call to PersonRepositoryMock.CreateEmployment. %v", employment)
 	return
 }
 
-// CreatePersonAfterCounter returns a count of finished PersonRepositoryMock.CreatePerson invocations
-func (mmCreatePerson *PersonRepositoryMock) CreatePersonAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreatePerson.afterCreatePersonCounter)
+// CreateEmploymentAfterCounter returns a count of finished PersonRepositoryMock.CreateEmployment invocations
+func (mmCreateEmployment *PersonRepositoryMock) CreateEmploymentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateEmployment.afterCreateEmploymentCounter)
 }
 
-// CreatePersonBeforeCounter returns a count of PersonRepositoryMock.CreatePerson invocations
-func (mmCreatePerson *PersonRepositoryMock) CreatePersonBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreatePerson.beforeCreatePersonCounter)
+// CreateEmploymentBeforeCounter returns a count of PersonRepositoryMock.CreateEmployment invocations
+func (mmCreateEmployment *PersonRepositoryMock) CreateEmploymentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateEmployment.beforeCreateEmploymentCounter)
 }
 
-// Calls returns a list of arguments used in each call to PersonRepositoryMock.CreatePerson.
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.CreateEmployment.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Calls() []*PersonRepositoryMockCreatePersonParams {
-	mmCreatePerson.mutex.RLock()
+func (mmCreateEmployment *mPersonRepositoryMockCreateEmployment) Calls() []*PersonRepositoryMockCreateEmploymentParams {
+	mmCreateEmployment.mutex.RLock()
 
-	argCopy := make([]*PersonRepositoryMockCreatePersonParams, len(mmCreatePerson.callArgs))
-	copy(argCopy, mmCreatePerson.callArgs)
+	argCopy := make([]*PersonRepositoryMockCreateEmploymentParams, len(mmCreateEmployment.callArgs))
+	copy(argCopy, mmCreateEmployment.callArgs)
 
-	mmCreatePerson.mutex.RUnlock()
+	mmCreateEmployment.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreatePersonDone returns true if the count of the CreatePerson invocations corresponds
+// MinimockCreateEmploymentDone returns true if the count of the CreateEmployment invocations corresponds
 // the number of defined expectations
-func (m *PersonRepositoryMock) MinimockCreatePersonDone() bool {
-	if m.CreatePersonMock.optional {
+func (m *PersonRepositoryMock) MinimockCreateEmploymentDone() bool {
+	if m.CreateEmploymentMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreatePersonMock.expectations {
+	for _, e := range m.CreateEmploymentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreatePersonMock.invocationsDone()
+	return m.CreateEmploymentMock.invocationsDone()
 }
 
-// MinimockCreatePersonInspect logs each unmet expectation
-func (m *PersonRepositoryMock) MinimockCreatePersonInspect() {
-	for _, e := range m.CreatePersonMock.expectations {
+// MinimockCreateEmploymentInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockCreateEmploymentInspect() {
+	for _, e := range m.CreateEmploymentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to PersonRepositoryMock.CreatePerson at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreateEmployment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreatePersonCounter := mm_atomic.LoadUint64(&m.afterCreatePersonCounter)
+	afterCreateEmploymentCounter := mm_atomic.LoadUint64(&m.afterCreateEmploymentCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreatePersonMock.defaultExpectation != nil && afterCreatePersonCounter < 1 {
-		if m.CreatePersonMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to PersonRepositoryMock.CreatePerson at\n%s", m.CreatePersonMock.defaultExpectation.returnOrigin)
+	if m.CreateEmploymentMock.defaultExpectation != nil && afterCreateEmploymentCounter < 1 {
+		if m.CreateEmploymentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreateEmployment at\n%s", m.CreateEmploymentMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to PersonRepositoryMock.CreatePerson at\n%s with params: %#v", m.CreatePersonMock.defaultExpectation.expectationOrigins.origin, *m.CreatePersonMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreateEmployment at\n%s with params: %#v", m.CreateEmploymentMock.defaultExpectation.expectationOrigins.origin, *m.CreateEmploymentMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreatePerson != nil && afterCreatePersonCounter < 1 {
-		m.t.Errorf("Expected call to PersonRepositoryMock.CreatePerson at\n%s", m.funcCreatePersonOrigin)
+	if m.funcCreateEmployment != nil && afterCreateEmploymentCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.CreateEmployment at\n%s", m.funcCreateEmploymentOrigin)
 	}
 
-	if !m.CreatePersonMock.invocationsDone() && afterCreatePersonCounter > 0 {
-		m.t.Errorf("Expected %d calls to PersonRepositoryMock.CreatePerson at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreatePersonMock.expectedInvocations), m.CreatePersonMock.expectedInvocationsOrigin, afterCreatePersonCounter)
+	if !m.CreateEmploymentMock.invocationsDone() && afterCreateEmploymentCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.CreateEmployment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateEmploymentMock.expectedInvocations), m.CreateEmploymentMock.expectedInvocationsOrigin, afterCreateEmploymentCounter)
 	}
 }
 
-type mPersonRepositoryMockDeletePersonByID struct {
+type mPersonRepositoryMockCreateOrganization struct {
 	optional           bool
 	mock               *PersonRepositoryMock
-	defaultExpectation *PersonRepositoryMockDeletePersonByIDExpectation
-	expectations       []*PersonRepositoryMockDeletePersonByIDExpectation
+	defaultExpectation *PersonRepositoryMockCreateOrganizationExpectation
+	expectations       []*PersonRepositoryMockCreateOrganizationExpectation
 
-	callArgs []*PersonRepositoryMockDeletePersonByIDParams
+	callArgs []*PersonRepositoryMockCreateOrganizationParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// PersonRepositoryMockDeletePersonByIDExpectation specifies expectation struct of the personRepository.DeletePersonByID
-type PersonRepositoryMockDeletePersonByIDExpectation struct {
+// PersonRepositoryMockCreateOrganizationExpectation specifies expectation struct of the PersonRepository.CreateOrganization
+type PersonRepositoryMockCreateOrganizationExpectation struct {
 	mock               *PersonRepositoryMock
-	params             *PersonRepositoryMockDeletePersonByIDParams
-	paramPtrs          *PersonRepositoryMockDeletePersonByIDParamPtrs
-	expectationOrigins PersonRepositoryMockDeletePersonByIDExpectationOrigins
-	results            *PersonRepositoryMockDeletePersonByIDResults
+	params             *PersonRepositoryMockCreateOrganizationParams
+	paramPtrs          *PersonRepositoryMockCreateOrganizationParamPtrs
+	expectationOrigins PersonRepositoryMockCreateOrganizationExpectationOrigins
+	results            *PersonRepositoryMockCreateOrganizationResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// PersonRepositoryMockDeletePersonByIDParams contains parameters of the personRepository.DeletePersonByID
-type PersonRepositoryMockDeletePersonByIDParams struct {
-	id int32
+// PersonRepositoryMockCreateOrganizationParams contains parameters of the PersonRepository.CreateOrganization
+type PersonRepositoryMockCreateOrganizationParams struct {
+	organization models.Organization
 }
 
-// PersonRepositoryMockDeletePersonByIDParamPtrs contains pointers to parameters of the personRepository.DeletePersonByID
-type PersonRepositoryMockDeletePersonByIDParamPtrs struct {
-	id *int32
+// PersonRepositoryMockCreateOrganizationParamPtrs contains pointers to parameters of the PersonRepository.CreateOrganization
+type PersonRepositoryMockCreateOrganizationParamPtrs struct {
+	organization *models.Organization
 }
 
-// PersonRepositoryMockDeletePersonByIDResults contains results of the personRepository.DeletePersonByID
-type PersonRepositoryMockDeletePersonByIDResults struct {
+// PersonRepositoryMockCreateOrganizationResults contains results of the PersonRepository.CreateOrganization
+type PersonRepositoryMockCreateOrganizationResults struct {
+	o1  models.Organization
 	err error
 }
 
-// PersonRepositoryMockDeletePersonByIDOrigins contains origins of expectations of the personRepository.DeletePersonByID
-type PersonRepositoryMockDeletePersonByIDExpectationOrigins struct {
-	origin   string
-	originId string
+// PersonRepositoryMockCreateOrganizationOrigins contains origins of expectations of the PersonRepository.CreateOrganization
+type PersonRepositoryMockCreateOrganizationExpectationOrigins struct {
+	origin             string
+	originOrganization string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -441,498 +663,7450 @@ type PersonRepositoryMockDeletePersonByIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Optional() *mPersonRepositoryMockDeletePersonByID {
-	mmDeletePersonByID.optional = true
-	return mmDeletePersonByID
+func (mmCreateOrganization *mPersonRepositoryMockCreateOrganization) Optional() *mPersonRepositoryMockCreateOrganization {
+	mmCreateOrganization.optional = true
+	return mmCreateOrganization
 }
 
-// Expect sets up expected params for personRepository.DeletePersonByID
-func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Expect(id int32) *mPersonRepositoryMockDeletePersonByID {
-	if mmDeletePersonByID.mock.funcDeletePersonByID != nil {
-		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by Set")
+// Expect sets up expected params for PersonRepository.CreateOrganization
+func (mmCreateOrganization *mPersonRepositoryMockCreateOrganization) Expect(organization models.Organization) *mPersonRepositoryMockCreateOrganization {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("PersonRepositoryMock.CreateOrganization mock is already set by Set")
 	}
 
-	if mmDeletePersonByID.defaultExpectation == nil {
-		mmDeletePersonByID.defaultExpectation = &PersonRepositoryMockDeletePersonByIDExpectation{}
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &PersonRepositoryMockCreateOrganizationExpectation{}
 	}
 
-	if mmDeletePersonByID.defaultExpectation.paramPtrs != nil {
-		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by ExpectParams functions")
+	if mmCreateOrganization.defaultExpectation.paramPtrs != nil {
+		mmCreateOrganization.mock.t.Fatalf("PersonRepositoryMock.CreateOrganization mock is already set by ExpectParams functions")
 	}
 
-	mmDeletePersonByID.defaultExpectation.params = &PersonRepositoryMockDeletePersonByIDParams{id}
-	mmDeletePersonByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeletePersonByID.expectations {
-		if minimock.Equal(e.params, mmDeletePersonByID.defaultExpectation.params) {
-			mmDeletePersonByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePersonByID.defaultExpectation.params)
+	mmCreateOrganization.defaultExpectation.params = &PersonRepositoryMockCreateOrganizationParams{organization}
+	mmCreateOrganization.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateOrganization.expectations {
+		if minimock.Equal(e.params, mmCreateOrganization.defaultExpectation.params) {
+			mmCreateOrganization.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrganization.defaultExpectation.params)
 		}
 	}
 
-	return mmDeletePersonByID
+	return mmCreateOrganization
 }
 
-// ExpectIdParam1 sets up expected param id for personRepository.DeletePersonByID
-func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) ExpectIdParam1(id int32) *mPersonRepositoryMockDeletePersonByID {
-	if mmDeletePersonByID.mock.funcDeletePersonByID != nil {
-		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by Set")
+// ExpectOrganizationParam1 sets up expected param organization for PersonRepository.CreateOrganization
+func (mmCreateOrganization *mPersonRepositoryMockCreateOrganization) ExpectOrganizationParam1(organization models.Organization) *mPersonRepositoryMockCreateOrganization {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("PersonRepositoryMock.CreateOrganization mock is already set by Set")
 	}
 
-	if mmDeletePersonByID.defaultExpectation == nil {
-		mmDeletePersonByID.defaultExpectation = &PersonRepositoryMockDeletePersonByIDExpectation{}
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &PersonRepositoryMockCreateOrganizationExpectation{}
 	}
 
-	if mmDeletePersonByID.defaultExpectation.params != nil {
-		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by Expect")
+	if mmCreateOrganization.defaultExpectation.params != nil {
+		mmCreateOrganization.mock.t.Fatalf("PersonRepositoryMock.CreateOrganization mock is already set by Expect")
 	}
 
-	if mmDeletePersonByID.defaultExpectation.paramPtrs == nil {
-		mmDeletePersonByID.defaultExpectation.paramPtrs = &PersonRepositoryMockDeletePersonByIDParamPtrs{}
+	if mmCreateOrganization.defaultExpectation.paramPtrs == nil {
+		mmCreateOrganization.defaultExpectation.paramPtrs = &PersonRepositoryMockCreateOrganizationParamPtrs{}
 	}
-	mmDeletePersonByID.defaultExpectation.paramPtrs.id = &id
-	mmDeletePersonByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmCreateOrganization.defaultExpectation.paramPtrs.organization = &organization
+	mmCreateOrganization.defaultExpectation.expectationOrigins.originOrganization = minimock.CallerInfo(1)
 
-	return mmDeletePersonByID
+	return mmCreateOrganization
 }
 
-// Inspect accepts an inspector function that has same arguments as the personRepository.DeletePersonByID
-func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Inspect(f func(id int32)) *mPersonRepositoryMockDeletePersonByID {
-	if mmDeletePersonByID.mock.inspectFuncDeletePersonByID != nil {
-		mmDeletePersonByID.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.DeletePersonByID")
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.CreateOrganization
+func (mmCreateOrganization *mPersonRepositoryMockCreateOrganization) Inspect(f func(organization models.Organization)) *mPersonRepositoryMockCreateOrganization {
+	if mmCreateOrganization.mock.inspectFuncCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.CreateOrganization")
 	}
 
-	mmDeletePersonByID.mock.inspectFuncDeletePersonByID = f
+	mmCreateOrganization.mock.inspectFuncCreateOrganization = f
 
-	return mmDeletePersonByID
+	return mmCreateOrganization
 }
 
-// Return sets up results that will be returned by personRepository.DeletePersonByID
-func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Return(err error) *PersonRepositoryMock {
-	if mmDeletePersonByID.mock.funcDeletePersonByID != nil {
-		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by Set")
+// Return sets up results that will be returned by PersonRepository.CreateOrganization
+func (mmCreateOrganization *mPersonRepositoryMockCreateOrganization) Return(o1 models.Organization, err error) *PersonRepositoryMock {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("PersonRepositoryMock.CreateOrganization mock is already set by Set")
 	}
 
-	if mmDeletePersonByID.defaultExpectation == nil {
-		mmDeletePersonByID.defaultExpectation = &PersonRepositoryMockDeletePersonByIDExpectation{mock: mmDeletePersonByID.mock}
+	if mmCreateOrganization.defaultExpectation == nil {
+		mmCreateOrganization.defaultExpectation = &PersonRepositoryMockCreateOrganizationExpectation{mock: mmCreateOrganization.mock}
 	}
-	mmDeletePersonByID.defaultExpectation.results = &PersonRepositoryMockDeletePersonByIDResults{err}
-	mmDeletePersonByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeletePersonByID.mock
+	mmCreateOrganization.defaultExpectation.results = &PersonRepositoryMockCreateOrganizationResults{o1, err}
+	mmCreateOrganization.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateOrganization.mock
 }
 
-// Set uses given function f to mock the personRepository.DeletePersonByID method
-func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Set(f func(id int32) (err error)) *PersonRepositoryMock {
-	if mmDeletePersonByID.defaultExpectation != nil {
-		mmDeletePersonByID.mock.t.Fatalf("Default expectation is already set for the personRepository.DeletePersonByID method")
+// Set uses given function f to mock the PersonRepository.CreateOrganization method
+func (mmCreateOrganization *mPersonRepositoryMockCreateOrganization) Set(f func(organization models.Organization) (o1 models.Organization, err error)) *PersonRepositoryMock {
+	if mmCreateOrganization.defaultExpectation != nil {
+		mmCreateOrganization.mock.t.Fatalf("Default expectation is already set for the PersonRepository.CreateOrganization method")
 	}
 
-	if len(mmDeletePersonByID.expectations) > 0 {
-		mmDeletePersonByID.mock.t.Fatalf("Some expectations are already set for the personRepository.DeletePersonByID method")
+	if len(mmCreateOrganization.expectations) > 0 {
+		mmCreateOrganization.mock.t.Fatalf("Some expectations are already set for the PersonRepository.CreateOrganization method")
 	}
 
-	mmDeletePersonByID.mock.funcDeletePersonByID = f
-	mmDeletePersonByID.mock.funcDeletePersonByIDOrigin = minimock.CallerInfo(1)
-	return mmDeletePersonByID.mock
+	mmCreateOrganization.mock.funcCreateOrganization = f
+	mmCreateOrganization.mock.funcCreateOrganizationOrigin = minimock.CallerInfo(1)
+	return mmCreateOrganization.mock
 }
 
-// When sets expectation for the personRepository.DeletePersonByID which will trigger the result defined by the following
+// When sets expectation for the PersonRepository.CreateOrganization which will trigger the result defined by the following
 // Then helper
-func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) When(id int32) *PersonRepositoryMockDeletePersonByIDExpectation {
-	if mmDeletePersonByID.mock.funcDeletePersonByID != nil {
-		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by Set")
+func (mmCreateOrganization *mPersonRepositoryMockCreateOrganization) When(organization models.Organization) *PersonRepositoryMockCreateOrganizationExpectation {
+	if mmCreateOrganization.mock.funcCreateOrganization != nil {
+		mmCreateOrganization.mock.t.Fatalf("PersonRepositoryMock.CreateOrganization mock is already set by Set")
 	}
 
-	expectation := &PersonRepositoryMockDeletePersonByIDExpectation{
-		mock:               mmDeletePersonByID.mock,
-		params:             &PersonRepositoryMockDeletePersonByIDParams{id},
-		expectationOrigins: PersonRepositoryMockDeletePersonByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &PersonRepositoryMockCreateOrganizationExpectation{
+		mock:               mmCreateOrganization.mock,
+		params:             &PersonRepositoryMockCreateOrganizationParams{organization},
+		expectationOrigins: PersonRepositoryMockCreateOrganizationExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeletePersonByID.expectations = append(mmDeletePersonByID.expectations, expectation)
+	mmCreateOrganization.expectations = append(mmCreateOrganization.expectations, expectation)
 	return expectation
 }
 
-// Then sets up personRepository.DeletePersonByID return parameters for the expectation previously defined by the When method
-func (e *PersonRepositoryMockDeletePersonByIDExpectation) Then(err error) *PersonRepositoryMock {
-	e.results = &PersonRepositoryMockDeletePersonByIDResults{err}
+// Then sets up PersonRepository.CreateOrganization return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockCreateOrganizationExpectation) Then(o1 models.Organization, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockCreateOrganizationResults{o1, err}
 	return e.mock
 }
 
-// Times sets number of times personRepository.DeletePersonByID should be invoked
-func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Times(n uint64) *mPersonRepositoryMockDeletePersonByID {
+// Times sets number of times PersonRepository.CreateOrganization should be invoked
+func (mmCreateOrganization *mPersonRepositoryMockCreateOrganization) Times(n uint64) *mPersonRepositoryMockCreateOrganization {
 	if n == 0 {
-		mmDeletePersonByID.mock.t.Fatalf("Times of PersonRepositoryMock.DeletePersonByID mock can not be zero")
+		mmCreateOrganization.mock.t.Fatalf("Times of PersonRepositoryMock.CreateOrganization mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeletePersonByID.expectedInvocations, n)
-	mmDeletePersonByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeletePersonByID
+	mm_atomic.StoreUint64(&mmCreateOrganization.expectedInvocations, n)
+	mmCreateOrganization.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateOrganization
 }
 
-func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) invocationsDone() bool {
-	if len(mmDeletePersonByID.expectations) == 0 && mmDeletePersonByID.defaultExpectation == nil && mmDeletePersonByID.mock.funcDeletePersonByID == nil {
+func (mmCreateOrganization *mPersonRepositoryMockCreateOrganization) invocationsDone() bool {
+	if len(mmCreateOrganization.expectations) == 0 && mmCreateOrganization.defaultExpectation == nil && mmCreateOrganization.mock.funcCreateOrganization == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeletePersonByID.mock.afterDeletePersonByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePersonByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOrganization.mock.afterCreateOrganizationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOrganization.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeletePersonByID implements personRepository
-func (mmDeletePersonByID *PersonRepositoryMock) DeletePersonByID(id int32) (err error) {
-	mm_atomic.AddUint64(&mmDeletePersonByID.beforeDeletePersonByIDCounter, 1)
+// CreateOrganization implements PersonRepository
+func (mmCreateOrganization *PersonRepositoryMock) CreateOrganization(organization models.Organization) (o1 models.Organization, err error) {
+	mm_atomic.AddUint64(&mmCreateOrganization.beforeCreateOrganizationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOrganization.afterCreateOrganizationCounter, 1)
+
+	mmCreateOrganization.t.Helper()
+
+	if mmCreateOrganization.inspectFuncCreateOrganization != nil {
+		mmCreateOrganization.inspectFuncCreateOrganization(organization)
+	}
+
+	mm_params := PersonRepositoryMockCreateOrganizationParams{organization}
+
+	// Record call args
+	mmCreateOrganization.CreateOrganizationMock.mutex.Lock()
+	mmCreateOrganization.CreateOrganizationMock.callArgs = append(mmCreateOrganization.CreateOrganizationMock.callArgs, &mm_params)
+	mmCreateOrganization.CreateOrganizationMock.mutex.Unlock()
+
+	for _, e := range mmCreateOrganization.CreateOrganizationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.o1, e.results.err
+		}
+	}
+
+	if mmCreateOrganization.CreateOrganizationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOrganization.CreateOrganizationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOrganization.CreateOrganizationMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOrganization.CreateOrganizationMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockCreateOrganizationParams{organization}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.organization != nil && !minimock.Equal(*mm_want_ptrs.organization, mm_got.organization) {
+				mmCreateOrganization.t.Errorf("PersonRepositoryMock.CreateOrganization got unexpected parameter organization, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrganization.CreateOrganizationMock.defaultExpectation.expectationOrigins.originOrganization, *mm_want_ptrs.organization, mm_got.organization, minimock.Diff(*mm_want_ptrs.organization, mm_got.organization))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOrganization.t.Errorf("PersonRepositoryMock.CreateOrganization got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateOrganization.CreateOrganizationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOrganization.CreateOrganizationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOrganization.t.Fatal("No results are set for the PersonRepositoryMock.CreateOrganization")
+		}
+		return (*mm_results).o1, (*mm_results).err
+	}
+	if mmCreateOrganization.funcCreateOrganization != nil {
+		return mmCreateOrganization.funcCreateOrganization(organization)
+	}
+	mmCreateOrganization.t.Fatalf("Unexpected call to PersonRepositoryMock.CreateOrganization. %v", organization)
+	return
+}
+
+// CreateOrganizationAfterCounter returns a count of finished PersonRepositoryMock.CreateOrganization invocations
+func (mmCreateOrganization *PersonRepositoryMock) CreateOrganizationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrganization.afterCreateOrganizationCounter)
+}
+
+// CreateOrganizationBeforeCounter returns a count of PersonRepositoryMock.CreateOrganization invocations
+func (mmCreateOrganization *PersonRepositoryMock) CreateOrganizationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrganization.beforeCreateOrganizationCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.CreateOrganization.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOrganization *mPersonRepositoryMockCreateOrganization) Calls() []*PersonRepositoryMockCreateOrganizationParams {
+	mmCreateOrganization.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockCreateOrganizationParams, len(mmCreateOrganization.callArgs))
+	copy(argCopy, mmCreateOrganization.callArgs)
+
+	mmCreateOrganization.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOrganizationDone returns true if the count of the CreateOrganization invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockCreateOrganizationDone() bool {
+	if m.CreateOrganizationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateOrganizationMock.invocationsDone()
+}
+
+// MinimockCreateOrganizationInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockCreateOrganizationInspect() {
+	for _, e := range m.CreateOrganizationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreateOrganization at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateOrganizationCounter := mm_atomic.LoadUint64(&m.afterCreateOrganizationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOrganizationMock.defaultExpectation != nil && afterCreateOrganizationCounter < 1 {
+		if m.CreateOrganizationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreateOrganization at\n%s", m.CreateOrganizationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreateOrganization at\n%s with params: %#v", m.CreateOrganizationMock.defaultExpectation.expectationOrigins.origin, *m.CreateOrganizationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOrganization != nil && afterCreateOrganizationCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.CreateOrganization at\n%s", m.funcCreateOrganizationOrigin)
+	}
+
+	if !m.CreateOrganizationMock.invocationsDone() && afterCreateOrganizationCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.CreateOrganization at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOrganizationMock.expectedInvocations), m.CreateOrganizationMock.expectedInvocationsOrigin, afterCreateOrganizationCounter)
+	}
+}
+
+type mPersonRepositoryMockCreatePerson struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockCreatePersonExpectation
+	expectations       []*PersonRepositoryMockCreatePersonExpectation
+
+	callArgs []*PersonRepositoryMockCreatePersonParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockCreatePersonExpectation specifies expectation struct of the PersonRepository.CreatePerson
+type PersonRepositoryMockCreatePersonExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockCreatePersonParams
+	paramPtrs          *PersonRepositoryMockCreatePersonParamPtrs
+	expectationOrigins PersonRepositoryMockCreatePersonExpectationOrigins
+	results            *PersonRepositoryMockCreatePersonResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockCreatePersonParams contains parameters of the PersonRepository.CreatePerson
+type PersonRepositoryMockCreatePersonParams struct {
+	person models.Person
+}
+
+// PersonRepositoryMockCreatePersonParamPtrs contains pointers to parameters of the PersonRepository.CreatePerson
+type PersonRepositoryMockCreatePersonParamPtrs struct {
+	person *models.Person
+}
+
+// PersonRepositoryMockCreatePersonResults contains results of the PersonRepository.CreatePerson
+type PersonRepositoryMockCreatePersonResults struct {
+	p1  models.Person
+	err error
+}
+
+// PersonRepositoryMockCreatePersonOrigins contains origins of expectations of the PersonRepository.CreatePerson
+type PersonRepositoryMockCreatePersonExpectationOrigins struct {
+	origin       string
+	originPerson string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Optional() *mPersonRepositoryMockCreatePerson {
+	mmCreatePerson.optional = true
+	return mmCreatePerson
+}
+
+// Expect sets up expected params for PersonRepository.CreatePerson
+func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Expect(person models.Person) *mPersonRepositoryMockCreatePerson {
+	if mmCreatePerson.mock.funcCreatePerson != nil {
+		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by Set")
+	}
+
+	if mmCreatePerson.defaultExpectation == nil {
+		mmCreatePerson.defaultExpectation = &PersonRepositoryMockCreatePersonExpectation{}
+	}
+
+	if mmCreatePerson.defaultExpectation.paramPtrs != nil {
+		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePerson.defaultExpectation.params = &PersonRepositoryMockCreatePersonParams{person}
+	mmCreatePerson.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePerson.expectations {
+		if minimock.Equal(e.params, mmCreatePerson.defaultExpectation.params) {
+			mmCreatePerson.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePerson.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePerson
+}
+
+// ExpectPersonParam1 sets up expected param person for PersonRepository.CreatePerson
+func (mmCreatePerson *mPersonRepositoryMockCreatePerson) ExpectPersonParam1(person models.Person) *mPersonRepositoryMockCreatePerson {
+	if mmCreatePerson.mock.funcCreatePerson != nil {
+		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by Set")
+	}
+
+	if mmCreatePerson.defaultExpectation == nil {
+		mmCreatePerson.defaultExpectation = &PersonRepositoryMockCreatePersonExpectation{}
+	}
+
+	if mmCreatePerson.defaultExpectation.params != nil {
+		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by Expect")
+	}
+
+	if mmCreatePerson.defaultExpectation.paramPtrs == nil {
+		mmCreatePerson.defaultExpectation.paramPtrs = &PersonRepositoryMockCreatePersonParamPtrs{}
+	}
+	mmCreatePerson.defaultExpectation.paramPtrs.person = &person
+	mmCreatePerson.defaultExpectation.expectationOrigins.originPerson = minimock.CallerInfo(1)
+
+	return mmCreatePerson
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.CreatePerson
+func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Inspect(f func(person models.Person)) *mPersonRepositoryMockCreatePerson {
+	if mmCreatePerson.mock.inspectFuncCreatePerson != nil {
+		mmCreatePerson.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.CreatePerson")
+	}
+
+	mmCreatePerson.mock.inspectFuncCreatePerson = f
+
+	return mmCreatePerson
+}
+
+// Return sets up results that will be returned by PersonRepository.CreatePerson
+func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Return(p1 models.Person, err error) *PersonRepositoryMock {
+	if mmCreatePerson.mock.funcCreatePerson != nil {
+		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by Set")
+	}
+
+	if mmCreatePerson.defaultExpectation == nil {
+		mmCreatePerson.defaultExpectation = &PersonRepositoryMockCreatePersonExpectation{mock: mmCreatePerson.mock}
+	}
+	mmCreatePerson.defaultExpectation.results = &PersonRepositoryMockCreatePersonResults{p1, err}
+	mmCreatePerson.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePerson.mock
+}
+
+// Set uses given function f to mock the PersonRepository.CreatePerson method
+func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Set(f func(person models.Person) (p1 models.Person, err error)) *PersonRepositoryMock {
+	if mmCreatePerson.defaultExpectation != nil {
+		mmCreatePerson.mock.t.Fatalf("Default expectation is already set for the PersonRepository.CreatePerson method")
+	}
+
+	if len(mmCreatePerson.expectations) > 0 {
+		mmCreatePerson.mock.t.Fatalf("Some expectations are already set for the PersonRepository.CreatePerson method")
+	}
+
+	mmCreatePerson.mock.funcCreatePerson = f
+	mmCreatePerson.mock.funcCreatePersonOrigin = minimock.CallerInfo(1)
+	return mmCreatePerson.mock
+}
+
+// When sets expectation for the PersonRepository.CreatePerson which will trigger the result defined by the following
+// Then helper
+func (mmCreatePerson *mPersonRepositoryMockCreatePerson) When(person models.Person) *PersonRepositoryMockCreatePersonExpectation {
+	if mmCreatePerson.mock.funcCreatePerson != nil {
+		mmCreatePerson.mock.t.Fatalf("PersonRepositoryMock.CreatePerson mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockCreatePersonExpectation{
+		mock:               mmCreatePerson.mock,
+		params:             &PersonRepositoryMockCreatePersonParams{person},
+		expectationOrigins: PersonRepositoryMockCreatePersonExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePerson.expectations = append(mmCreatePerson.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.CreatePerson return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockCreatePersonExpectation) Then(p1 models.Person, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockCreatePersonResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.CreatePerson should be invoked
+func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Times(n uint64) *mPersonRepositoryMockCreatePerson {
+	if n == 0 {
+		mmCreatePerson.mock.t.Fatalf("Times of PersonRepositoryMock.CreatePerson mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePerson.expectedInvocations, n)
+	mmCreatePerson.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePerson
+}
+
+func (mmCreatePerson *mPersonRepositoryMockCreatePerson) invocationsDone() bool {
+	if len(mmCreatePerson.expectations) == 0 && mmCreatePerson.defaultExpectation == nil && mmCreatePerson.mock.funcCreatePerson == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePerson.mock.afterCreatePersonCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePerson.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePerson implements PersonRepository
+func (mmCreatePerson *PersonRepositoryMock) CreatePerson(person models.Person) (p1 models.Person, err error) {
+	mm_atomic.AddUint64(&mmCreatePerson.beforeCreatePersonCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePerson.afterCreatePersonCounter, 1)
+
+	mmCreatePerson.t.Helper()
+
+	if mmCreatePerson.inspectFuncCreatePerson != nil {
+		mmCreatePerson.inspectFuncCreatePerson(person)
+	}
+
+	mm_params := PersonRepositoryMockCreatePersonParams{person}
+
+	// Record call args
+	mmCreatePerson.CreatePersonMock.mutex.Lock()
+	mmCreatePerson.CreatePersonMock.callArgs = append(mmCreatePerson.CreatePersonMock.callArgs, &mm_params)
+	mmCreatePerson.CreatePersonMock.mutex.Unlock()
+
+	for _, e := range mmCreatePerson.CreatePersonMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmCreatePerson.CreatePersonMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePerson.CreatePersonMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePerson.CreatePersonMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePerson.CreatePersonMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockCreatePersonParams{person}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.person != nil && !minimock.Equal(*mm_want_ptrs.person, mm_got.person) {
+				mmCreatePerson.t.Errorf("PersonRepositoryMock.CreatePerson got unexpected parameter person, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePerson.CreatePersonMock.defaultExpectation.expectationOrigins.originPerson, *mm_want_ptrs.person, mm_got.person, minimock.Diff(*mm_want_ptrs.person, mm_got.person))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePerson.t.Errorf("PersonRepositoryMock.CreatePerson got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePerson.CreatePersonMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePerson.CreatePersonMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePerson.t.Fatal("No results are set for the PersonRepositoryMock.CreatePerson")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmCreatePerson.funcCreatePerson != nil {
+		return mmCreatePerson.funcCreatePerson(person)
+	}
+	mmCreatePerson.t.Fatalf("Unexpected call to PersonRepositoryMock.CreatePerson. %v", person)
+	return
+}
+
+// CreatePersonAfterCounter returns a count of finished PersonRepositoryMock.CreatePerson invocations
+func (mmCreatePerson *PersonRepositoryMock) CreatePersonAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePerson.afterCreatePersonCounter)
+}
+
+// CreatePersonBeforeCounter returns a count of PersonRepositoryMock.CreatePerson invocations
+func (mmCreatePerson *PersonRepositoryMock) CreatePersonBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePerson.beforeCreatePersonCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.CreatePerson.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePerson *mPersonRepositoryMockCreatePerson) Calls() []*PersonRepositoryMockCreatePersonParams {
+	mmCreatePerson.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockCreatePersonParams, len(mmCreatePerson.callArgs))
+	copy(argCopy, mmCreatePerson.callArgs)
+
+	mmCreatePerson.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePersonDone returns true if the count of the CreatePerson invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockCreatePersonDone() bool {
+	if m.CreatePersonMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePersonMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePersonMock.invocationsDone()
+}
+
+// MinimockCreatePersonInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockCreatePersonInspect() {
+	for _, e := range m.CreatePersonMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreatePerson at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePersonCounter := mm_atomic.LoadUint64(&m.afterCreatePersonCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePersonMock.defaultExpectation != nil && afterCreatePersonCounter < 1 {
+		if m.CreatePersonMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreatePerson at\n%s", m.CreatePersonMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreatePerson at\n%s with params: %#v", m.CreatePersonMock.defaultExpectation.expectationOrigins.origin, *m.CreatePersonMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePerson != nil && afterCreatePersonCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.CreatePerson at\n%s", m.funcCreatePersonOrigin)
+	}
+
+	if !m.CreatePersonMock.invocationsDone() && afterCreatePersonCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.CreatePerson at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePersonMock.expectedInvocations), m.CreatePersonMock.expectedInvocationsOrigin, afterCreatePersonCounter)
+	}
+}
+
+type mPersonRepositoryMockCreatePersons struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockCreatePersonsExpectation
+	expectations       []*PersonRepositoryMockCreatePersonsExpectation
+
+	callArgs []*PersonRepositoryMockCreatePersonsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockCreatePersonsExpectation specifies expectation struct of the PersonRepository.CreatePersons
+type PersonRepositoryMockCreatePersonsExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockCreatePersonsParams
+	paramPtrs          *PersonRepositoryMockCreatePersonsParamPtrs
+	expectationOrigins PersonRepositoryMockCreatePersonsExpectationOrigins
+	results            *PersonRepositoryMockCreatePersonsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockCreatePersonsParams contains parameters of the PersonRepository.CreatePersons
+type PersonRepositoryMockCreatePersonsParams struct {
+	persons []models.Person
+}
+
+// PersonRepositoryMockCreatePersonsParamPtrs contains pointers to parameters of the PersonRepository.CreatePersons
+type PersonRepositoryMockCreatePersonsParamPtrs struct {
+	persons *[]models.Person
+}
+
+// PersonRepositoryMockCreatePersonsResults contains results of the PersonRepository.CreatePersons
+type PersonRepositoryMockCreatePersonsResults struct {
+	pa1 []models.Person
+	err error
+}
+
+// PersonRepositoryMockCreatePersonsOrigins contains origins of expectations of the PersonRepository.CreatePersons
+type PersonRepositoryMockCreatePersonsExpectationOrigins struct {
+	origin        string
+	originPersons string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePersons *mPersonRepositoryMockCreatePersons) Optional() *mPersonRepositoryMockCreatePersons {
+	mmCreatePersons.optional = true
+	return mmCreatePersons
+}
+
+// Expect sets up expected params for PersonRepository.CreatePersons
+func (mmCreatePersons *mPersonRepositoryMockCreatePersons) Expect(persons []models.Person) *mPersonRepositoryMockCreatePersons {
+	if mmCreatePersons.mock.funcCreatePersons != nil {
+		mmCreatePersons.mock.t.Fatalf("PersonRepositoryMock.CreatePersons mock is already set by Set")
+	}
+
+	if mmCreatePersons.defaultExpectation == nil {
+		mmCreatePersons.defaultExpectation = &PersonRepositoryMockCreatePersonsExpectation{}
+	}
+
+	if mmCreatePersons.defaultExpectation.paramPtrs != nil {
+		mmCreatePersons.mock.t.Fatalf("PersonRepositoryMock.CreatePersons mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePersons.defaultExpectation.params = &PersonRepositoryMockCreatePersonsParams{persons}
+	mmCreatePersons.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePersons.expectations {
+		if minimock.Equal(e.params, mmCreatePersons.defaultExpectation.params) {
+			mmCreatePersons.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePersons.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePersons
+}
+
+// ExpectPersonsParam1 sets up expected param persons for PersonRepository.CreatePersons
+func (mmCreatePersons *mPersonRepositoryMockCreatePersons) ExpectPersonsParam1(persons []models.Person) *mPersonRepositoryMockCreatePersons {
+	if mmCreatePersons.mock.funcCreatePersons != nil {
+		mmCreatePersons.mock.t.Fatalf("PersonRepositoryMock.CreatePersons mock is already set by Set")
+	}
+
+	if mmCreatePersons.defaultExpectation == nil {
+		mmCreatePersons.defaultExpectation = &PersonRepositoryMockCreatePersonsExpectation{}
+	}
+
+	if mmCreatePersons.defaultExpectation.params != nil {
+		mmCreatePersons.mock.t.Fatalf("PersonRepositoryMock.CreatePersons mock is already set by Expect")
+	}
+
+	if mmCreatePersons.defaultExpectation.paramPtrs == nil {
+		mmCreatePersons.defaultExpectation.paramPtrs = &PersonRepositoryMockCreatePersonsParamPtrs{}
+	}
+	mmCreatePersons.defaultExpectation.paramPtrs.persons = &persons
+	mmCreatePersons.defaultExpectation.expectationOrigins.originPersons = minimock.CallerInfo(1)
+
+	return mmCreatePersons
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.CreatePersons
+func (mmCreatePersons *mPersonRepositoryMockCreatePersons) Inspect(f func(persons []models.Person)) *mPersonRepositoryMockCreatePersons {
+	if mmCreatePersons.mock.inspectFuncCreatePersons != nil {
+		mmCreatePersons.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.CreatePersons")
+	}
+
+	mmCreatePersons.mock.inspectFuncCreatePersons = f
+
+	return mmCreatePersons
+}
+
+// Return sets up results that will be returned by PersonRepository.CreatePersons
+func (mmCreatePersons *mPersonRepositoryMockCreatePersons) Return(pa1 []models.Person, err error) *PersonRepositoryMock {
+	if mmCreatePersons.mock.funcCreatePersons != nil {
+		mmCreatePersons.mock.t.Fatalf("PersonRepositoryMock.CreatePersons mock is already set by Set")
+	}
+
+	if mmCreatePersons.defaultExpectation == nil {
+		mmCreatePersons.defaultExpectation = &PersonRepositoryMockCreatePersonsExpectation{mock: mmCreatePersons.mock}
+	}
+	mmCreatePersons.defaultExpectation.results = &PersonRepositoryMockCreatePersonsResults{pa1, err}
+	mmCreatePersons.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePersons.mock
+}
+
+// Set uses given function f to mock the PersonRepository.CreatePersons method
+func (mmCreatePersons *mPersonRepositoryMockCreatePersons) Set(f func(persons []models.Person) (pa1 []models.Person, err error)) *PersonRepositoryMock {
+	if mmCreatePersons.defaultExpectation != nil {
+		mmCreatePersons.mock.t.Fatalf("Default expectation is already set for the PersonRepository.CreatePersons method")
+	}
+
+	if len(mmCreatePersons.expectations) > 0 {
+		mmCreatePersons.mock.t.Fatalf("Some expectations are already set for the PersonRepository.CreatePersons method")
+	}
+
+	mmCreatePersons.mock.funcCreatePersons = f
+	mmCreatePersons.mock.funcCreatePersonsOrigin = minimock.CallerInfo(1)
+	return mmCreatePersons.mock
+}
+
+// When sets expectation for the PersonRepository.CreatePersons which will trigger the result defined by the following
+// Then helper
+func (mmCreatePersons *mPersonRepositoryMockCreatePersons) When(persons []models.Person) *PersonRepositoryMockCreatePersonsExpectation {
+	if mmCreatePersons.mock.funcCreatePersons != nil {
+		mmCreatePersons.mock.t.Fatalf("PersonRepositoryMock.CreatePersons mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockCreatePersonsExpectation{
+		mock:               mmCreatePersons.mock,
+		params:             &PersonRepositoryMockCreatePersonsParams{persons},
+		expectationOrigins: PersonRepositoryMockCreatePersonsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePersons.expectations = append(mmCreatePersons.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.CreatePersons return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockCreatePersonsExpectation) Then(pa1 []models.Person, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockCreatePersonsResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.CreatePersons should be invoked
+func (mmCreatePersons *mPersonRepositoryMockCreatePersons) Times(n uint64) *mPersonRepositoryMockCreatePersons {
+	if n == 0 {
+		mmCreatePersons.mock.t.Fatalf("Times of PersonRepositoryMock.CreatePersons mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePersons.expectedInvocations, n)
+	mmCreatePersons.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePersons
+}
+
+func (mmCreatePersons *mPersonRepositoryMockCreatePersons) invocationsDone() bool {
+	if len(mmCreatePersons.expectations) == 0 && mmCreatePersons.defaultExpectation == nil && mmCreatePersons.mock.funcCreatePersons == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePersons.mock.afterCreatePersonsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePersons.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePersons implements PersonRepository
+func (mmCreatePersons *PersonRepositoryMock) CreatePersons(persons []models.Person) (pa1 []models.Person, err error) {
+	mm_atomic.AddUint64(&mmCreatePersons.beforeCreatePersonsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePersons.afterCreatePersonsCounter, 1)
+
+	mmCreatePersons.t.Helper()
+
+	if mmCreatePersons.inspectFuncCreatePersons != nil {
+		mmCreatePersons.inspectFuncCreatePersons(persons)
+	}
+
+	mm_params := PersonRepositoryMockCreatePersonsParams{persons}
+
+	// Record call args
+	mmCreatePersons.CreatePersonsMock.mutex.Lock()
+	mmCreatePersons.CreatePersonsMock.callArgs = append(mmCreatePersons.CreatePersonsMock.callArgs, &mm_params)
+	mmCreatePersons.CreatePersonsMock.mutex.Unlock()
+
+	for _, e := range mmCreatePersons.CreatePersonsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmCreatePersons.CreatePersonsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePersons.CreatePersonsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePersons.CreatePersonsMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePersons.CreatePersonsMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockCreatePersonsParams{persons}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.persons != nil && !minimock.Equal(*mm_want_ptrs.persons, mm_got.persons) {
+				mmCreatePersons.t.Errorf("PersonRepositoryMock.CreatePersons got unexpected parameter persons, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePersons.CreatePersonsMock.defaultExpectation.expectationOrigins.originPersons, *mm_want_ptrs.persons, mm_got.persons, minimock.Diff(*mm_want_ptrs.persons, mm_got.persons))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePersons.t.Errorf("PersonRepositoryMock.CreatePersons got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePersons.CreatePersonsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePersons.CreatePersonsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePersons.t.Fatal("No results are set for the PersonRepositoryMock.CreatePersons")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmCreatePersons.funcCreatePersons != nil {
+		return mmCreatePersons.funcCreatePersons(persons)
+	}
+	mmCreatePersons.t.Fatalf("Unexpected call to PersonRepositoryMock.CreatePersons. %v", persons)
+	return
+}
+
+// CreatePersonsAfterCounter returns a count of finished PersonRepositoryMock.CreatePersons invocations
+func (mmCreatePersons *PersonRepositoryMock) CreatePersonsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePersons.afterCreatePersonsCounter)
+}
+
+// CreatePersonsBeforeCounter returns a count of PersonRepositoryMock.CreatePersons invocations
+func (mmCreatePersons *PersonRepositoryMock) CreatePersonsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePersons.beforeCreatePersonsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.CreatePersons.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePersons *mPersonRepositoryMockCreatePersons) Calls() []*PersonRepositoryMockCreatePersonsParams {
+	mmCreatePersons.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockCreatePersonsParams, len(mmCreatePersons.callArgs))
+	copy(argCopy, mmCreatePersons.callArgs)
+
+	mmCreatePersons.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePersonsDone returns true if the count of the CreatePersons invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockCreatePersonsDone() bool {
+	if m.CreatePersonsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePersonsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePersonsMock.invocationsDone()
+}
+
+// MinimockCreatePersonsInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockCreatePersonsInspect() {
+	for _, e := range m.CreatePersonsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreatePersons at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePersonsCounter := mm_atomic.LoadUint64(&m.afterCreatePersonsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePersonsMock.defaultExpectation != nil && afterCreatePersonsCounter < 1 {
+		if m.CreatePersonsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreatePersons at\n%s", m.CreatePersonsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreatePersons at\n%s with params: %#v", m.CreatePersonsMock.defaultExpectation.expectationOrigins.origin, *m.CreatePersonsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePersons != nil && afterCreatePersonsCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.CreatePersons at\n%s", m.funcCreatePersonsOrigin)
+	}
+
+	if !m.CreatePersonsMock.invocationsDone() && afterCreatePersonsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.CreatePersons at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePersonsMock.expectedInvocations), m.CreatePersonsMock.expectedInvocationsOrigin, afterCreatePersonsCounter)
+	}
+}
+
+type mPersonRepositoryMockCreateRelation struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockCreateRelationExpectation
+	expectations       []*PersonRepositoryMockCreateRelationExpectation
+
+	callArgs []*PersonRepositoryMockCreateRelationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockCreateRelationExpectation specifies expectation struct of the PersonRepository.CreateRelation
+type PersonRepositoryMockCreateRelationExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockCreateRelationParams
+	paramPtrs          *PersonRepositoryMockCreateRelationParamPtrs
+	expectationOrigins PersonRepositoryMockCreateRelationExpectationOrigins
+	results            *PersonRepositoryMockCreateRelationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockCreateRelationParams contains parameters of the PersonRepository.CreateRelation
+type PersonRepositoryMockCreateRelationParams struct {
+	relation models.PersonRelation
+}
+
+// PersonRepositoryMockCreateRelationParamPtrs contains pointers to parameters of the PersonRepository.CreateRelation
+type PersonRepositoryMockCreateRelationParamPtrs struct {
+	relation *models.PersonRelation
+}
+
+// PersonRepositoryMockCreateRelationResults contains results of the PersonRepository.CreateRelation
+type PersonRepositoryMockCreateRelationResults struct {
+	p1  models.PersonRelation
+	err error
+}
+
+// PersonRepositoryMockCreateRelationOrigins contains origins of expectations of the PersonRepository.CreateRelation
+type PersonRepositoryMockCreateRelationExpectationOrigins struct {
+	origin         string
+	originRelation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRelation *mPersonRepositoryMockCreateRelation) Optional() *mPersonRepositoryMockCreateRelation {
+	mmCreateRelation.optional = true
+	return mmCreateRelation
+}
+
+// Expect sets up expected params for PersonRepository.CreateRelation
+func (mmCreateRelation *mPersonRepositoryMockCreateRelation) Expect(relation models.PersonRelation) *mPersonRepositoryMockCreateRelation {
+	if mmCreateRelation.mock.funcCreateRelation != nil {
+		mmCreateRelation.mock.t.Fatalf("PersonRepositoryMock.CreateRelation mock is already set by Set")
+	}
+
+	if mmCreateRelation.defaultExpectation == nil {
+		mmCreateRelation.defaultExpectation = &PersonRepositoryMockCreateRelationExpectation{}
+	}
+
+	if mmCreateRelation.defaultExpectation.paramPtrs != nil {
+		mmCreateRelation.mock.t.Fatalf("PersonRepositoryMock.CreateRelation mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRelation.defaultExpectation.params = &PersonRepositoryMockCreateRelationParams{relation}
+	mmCreateRelation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRelation.expectations {
+		if minimock.Equal(e.params, mmCreateRelation.defaultExpectation.params) {
+			mmCreateRelation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRelation.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRelation
+}
+
+// ExpectRelationParam1 sets up expected param relation for PersonRepository.CreateRelation
+func (mmCreateRelation *mPersonRepositoryMockCreateRelation) ExpectRelationParam1(relation models.PersonRelation) *mPersonRepositoryMockCreateRelation {
+	if mmCreateRelation.mock.funcCreateRelation != nil {
+		mmCreateRelation.mock.t.Fatalf("PersonRepositoryMock.CreateRelation mock is already set by Set")
+	}
+
+	if mmCreateRelation.defaultExpectation == nil {
+		mmCreateRelation.defaultExpectation = &PersonRepositoryMockCreateRelationExpectation{}
+	}
+
+	if mmCreateRelation.defaultExpectation.params != nil {
+		mmCreateRelation.mock.t.Fatalf("PersonRepositoryMock.CreateRelation mock is already set by Expect")
+	}
+
+	if mmCreateRelation.defaultExpectation.paramPtrs == nil {
+		mmCreateRelation.defaultExpectation.paramPtrs = &PersonRepositoryMockCreateRelationParamPtrs{}
+	}
+	mmCreateRelation.defaultExpectation.paramPtrs.relation = &relation
+	mmCreateRelation.defaultExpectation.expectationOrigins.originRelation = minimock.CallerInfo(1)
+
+	return mmCreateRelation
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.CreateRelation
+func (mmCreateRelation *mPersonRepositoryMockCreateRelation) Inspect(f func(relation models.PersonRelation)) *mPersonRepositoryMockCreateRelation {
+	if mmCreateRelation.mock.inspectFuncCreateRelation != nil {
+		mmCreateRelation.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.CreateRelation")
+	}
+
+	mmCreateRelation.mock.inspectFuncCreateRelation = f
+
+	return mmCreateRelation
+}
+
+// Return sets up results that will be returned by PersonRepository.CreateRelation
+func (mmCreateRelation *mPersonRepositoryMockCreateRelation) Return(p1 models.PersonRelation, err error) *PersonRepositoryMock {
+	if mmCreateRelation.mock.funcCreateRelation != nil {
+		mmCreateRelation.mock.t.Fatalf("PersonRepositoryMock.CreateRelation mock is already set by Set")
+	}
+
+	if mmCreateRelation.defaultExpectation == nil {
+		mmCreateRelation.defaultExpectation = &PersonRepositoryMockCreateRelationExpectation{mock: mmCreateRelation.mock}
+	}
+	mmCreateRelation.defaultExpectation.results = &PersonRepositoryMockCreateRelationResults{p1, err}
+	mmCreateRelation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRelation.mock
+}
+
+// Set uses given function f to mock the PersonRepository.CreateRelation method
+func (mmCreateRelation *mPersonRepositoryMockCreateRelation) Set(f func(relation models.PersonRelation) (p1 models.PersonRelation, err error)) *PersonRepositoryMock {
+	if mmCreateRelation.defaultExpectation != nil {
+		mmCreateRelation.mock.t.Fatalf("Default expectation is already set for the PersonRepository.CreateRelation method")
+	}
+
+	if len(mmCreateRelation.expectations) > 0 {
+		mmCreateRelation.mock.t.Fatalf("Some expectations are already set for the PersonRepository.CreateRelation method")
+	}
+
+	mmCreateRelation.mock.funcCreateRelation = f
+	mmCreateRelation.mock.funcCreateRelationOrigin = minimock.CallerInfo(1)
+	return mmCreateRelation.mock
+}
+
+// When sets expectation for the PersonRepository.CreateRelation which will trigger the result defined by the following
+// Then helper
+func (mmCreateRelation *mPersonRepositoryMockCreateRelation) When(relation models.PersonRelation) *PersonRepositoryMockCreateRelationExpectation {
+	if mmCreateRelation.mock.funcCreateRelation != nil {
+		mmCreateRelation.mock.t.Fatalf("PersonRepositoryMock.CreateRelation mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockCreateRelationExpectation{
+		mock:               mmCreateRelation.mock,
+		params:             &PersonRepositoryMockCreateRelationParams{relation},
+		expectationOrigins: PersonRepositoryMockCreateRelationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRelation.expectations = append(mmCreateRelation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.CreateRelation return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockCreateRelationExpectation) Then(p1 models.PersonRelation, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockCreateRelationResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.CreateRelation should be invoked
+func (mmCreateRelation *mPersonRepositoryMockCreateRelation) Times(n uint64) *mPersonRepositoryMockCreateRelation {
+	if n == 0 {
+		mmCreateRelation.mock.t.Fatalf("Times of PersonRepositoryMock.CreateRelation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRelation.expectedInvocations, n)
+	mmCreateRelation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRelation
+}
+
+func (mmCreateRelation *mPersonRepositoryMockCreateRelation) invocationsDone() bool {
+	if len(mmCreateRelation.expectations) == 0 && mmCreateRelation.defaultExpectation == nil && mmCreateRelation.mock.funcCreateRelation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRelation.mock.afterCreateRelationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRelation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRelation implements PersonRepository
+func (mmCreateRelation *PersonRepositoryMock) CreateRelation(relation models.PersonRelation) (p1 models.PersonRelation, err error) {
+	mm_atomic.AddUint64(&mmCreateRelation.beforeCreateRelationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRelation.afterCreateRelationCounter, 1)
+
+	mmCreateRelation.t.Helper()
+
+	if mmCreateRelation.inspectFuncCreateRelation != nil {
+		mmCreateRelation.inspectFuncCreateRelation(relation)
+	}
+
+	mm_params := PersonRepositoryMockCreateRelationParams{relation}
+
+	// Record call args
+	mmCreateRelation.CreateRelationMock.mutex.Lock()
+	mmCreateRelation.CreateRelationMock.callArgs = append(mmCreateRelation.CreateRelationMock.callArgs, &mm_params)
+	mmCreateRelation.CreateRelationMock.mutex.Unlock()
+
+	for _, e := range mmCreateRelation.CreateRelationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmCreateRelation.CreateRelationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRelation.CreateRelationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRelation.CreateRelationMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRelation.CreateRelationMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockCreateRelationParams{relation}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.relation != nil && !minimock.Equal(*mm_want_ptrs.relation, mm_got.relation) {
+				mmCreateRelation.t.Errorf("PersonRepositoryMock.CreateRelation got unexpected parameter relation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRelation.CreateRelationMock.defaultExpectation.expectationOrigins.originRelation, *mm_want_ptrs.relation, mm_got.relation, minimock.Diff(*mm_want_ptrs.relation, mm_got.relation))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRelation.t.Errorf("PersonRepositoryMock.CreateRelation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRelation.CreateRelationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRelation.CreateRelationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRelation.t.Fatal("No results are set for the PersonRepositoryMock.CreateRelation")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmCreateRelation.funcCreateRelation != nil {
+		return mmCreateRelation.funcCreateRelation(relation)
+	}
+	mmCreateRelation.t.Fatalf("Unexpected call to PersonRepositoryMock.CreateRelation. %v", relation)
+	return
+}
+
+// CreateRelationAfterCounter returns a count of finished PersonRepositoryMock.CreateRelation invocations
+func (mmCreateRelation *PersonRepositoryMock) CreateRelationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRelation.afterCreateRelationCounter)
+}
+
+// CreateRelationBeforeCounter returns a count of PersonRepositoryMock.CreateRelation invocations
+func (mmCreateRelation *PersonRepositoryMock) CreateRelationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRelation.beforeCreateRelationCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.CreateRelation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRelation *mPersonRepositoryMockCreateRelation) Calls() []*PersonRepositoryMockCreateRelationParams {
+	mmCreateRelation.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockCreateRelationParams, len(mmCreateRelation.callArgs))
+	copy(argCopy, mmCreateRelation.callArgs)
+
+	mmCreateRelation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRelationDone returns true if the count of the CreateRelation invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockCreateRelationDone() bool {
+	if m.CreateRelationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRelationMock.invocationsDone()
+}
+
+// MinimockCreateRelationInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockCreateRelationInspect() {
+	for _, e := range m.CreateRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreateRelation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRelationCounter := mm_atomic.LoadUint64(&m.afterCreateRelationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRelationMock.defaultExpectation != nil && afterCreateRelationCounter < 1 {
+		if m.CreateRelationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreateRelation at\n%s", m.CreateRelationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.CreateRelation at\n%s with params: %#v", m.CreateRelationMock.defaultExpectation.expectationOrigins.origin, *m.CreateRelationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRelation != nil && afterCreateRelationCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.CreateRelation at\n%s", m.funcCreateRelationOrigin)
+	}
+
+	if !m.CreateRelationMock.invocationsDone() && afterCreateRelationCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.CreateRelation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRelationMock.expectedInvocations), m.CreateRelationMock.expectedInvocationsOrigin, afterCreateRelationCounter)
+	}
+}
+
+type mPersonRepositoryMockDeleteEmployment struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockDeleteEmploymentExpectation
+	expectations       []*PersonRepositoryMockDeleteEmploymentExpectation
+
+	callArgs []*PersonRepositoryMockDeleteEmploymentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockDeleteEmploymentExpectation specifies expectation struct of the PersonRepository.DeleteEmployment
+type PersonRepositoryMockDeleteEmploymentExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockDeleteEmploymentParams
+	paramPtrs          *PersonRepositoryMockDeleteEmploymentParamPtrs
+	expectationOrigins PersonRepositoryMockDeleteEmploymentExpectationOrigins
+	results            *PersonRepositoryMockDeleteEmploymentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockDeleteEmploymentParams contains parameters of the PersonRepository.DeleteEmployment
+type PersonRepositoryMockDeleteEmploymentParams struct {
+	personID     int32
+	employmentID int32
+}
+
+// PersonRepositoryMockDeleteEmploymentParamPtrs contains pointers to parameters of the PersonRepository.DeleteEmployment
+type PersonRepositoryMockDeleteEmploymentParamPtrs struct {
+	personID     *int32
+	employmentID *int32
+}
+
+// PersonRepositoryMockDeleteEmploymentResults contains results of the PersonRepository.DeleteEmployment
+type PersonRepositoryMockDeleteEmploymentResults struct {
+	err error
+}
+
+// PersonRepositoryMockDeleteEmploymentOrigins contains origins of expectations of the PersonRepository.DeleteEmployment
+type PersonRepositoryMockDeleteEmploymentExpectationOrigins struct {
+	origin             string
+	originPersonID     string
+	originEmploymentID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) Optional() *mPersonRepositoryMockDeleteEmployment {
+	mmDeleteEmployment.optional = true
+	return mmDeleteEmployment
+}
+
+// Expect sets up expected params for PersonRepository.DeleteEmployment
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) Expect(personID int32, employmentID int32) *mPersonRepositoryMockDeleteEmployment {
+	if mmDeleteEmployment.mock.funcDeleteEmployment != nil {
+		mmDeleteEmployment.mock.t.Fatalf("PersonRepositoryMock.DeleteEmployment mock is already set by Set")
+	}
+
+	if mmDeleteEmployment.defaultExpectation == nil {
+		mmDeleteEmployment.defaultExpectation = &PersonRepositoryMockDeleteEmploymentExpectation{}
+	}
+
+	if mmDeleteEmployment.defaultExpectation.paramPtrs != nil {
+		mmDeleteEmployment.mock.t.Fatalf("PersonRepositoryMock.DeleteEmployment mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteEmployment.defaultExpectation.params = &PersonRepositoryMockDeleteEmploymentParams{personID, employmentID}
+	mmDeleteEmployment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteEmployment.expectations {
+		if minimock.Equal(e.params, mmDeleteEmployment.defaultExpectation.params) {
+			mmDeleteEmployment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteEmployment.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteEmployment
+}
+
+// ExpectPersonIDParam1 sets up expected param personID for PersonRepository.DeleteEmployment
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) ExpectPersonIDParam1(personID int32) *mPersonRepositoryMockDeleteEmployment {
+	if mmDeleteEmployment.mock.funcDeleteEmployment != nil {
+		mmDeleteEmployment.mock.t.Fatalf("PersonRepositoryMock.DeleteEmployment mock is already set by Set")
+	}
+
+	if mmDeleteEmployment.defaultExpectation == nil {
+		mmDeleteEmployment.defaultExpectation = &PersonRepositoryMockDeleteEmploymentExpectation{}
+	}
+
+	if mmDeleteEmployment.defaultExpectation.params != nil {
+		mmDeleteEmployment.mock.t.Fatalf("PersonRepositoryMock.DeleteEmployment mock is already set by Expect")
+	}
+
+	if mmDeleteEmployment.defaultExpectation.paramPtrs == nil {
+		mmDeleteEmployment.defaultExpectation.paramPtrs = &PersonRepositoryMockDeleteEmploymentParamPtrs{}
+	}
+	mmDeleteEmployment.defaultExpectation.paramPtrs.personID = &personID
+	mmDeleteEmployment.defaultExpectation.expectationOrigins.originPersonID = minimock.CallerInfo(1)
+
+	return mmDeleteEmployment
+}
+
+// ExpectEmploymentIDParam2 sets up expected param employmentID for PersonRepository.DeleteEmployment
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) ExpectEmploymentIDParam2(employmentID int32) *mPersonRepositoryMockDeleteEmployment {
+	if mmDeleteEmployment.mock.funcDeleteEmployment != nil {
+		mmDeleteEmployment.mock.t.Fatalf("PersonRepositoryMock.DeleteEmployment mock is already set by Set")
+	}
+
+	if mmDeleteEmployment.defaultExpectation == nil {
+		mmDeleteEmployment.defaultExpectation = &PersonRepositoryMockDeleteEmploymentExpectation{}
+	}
+
+	if mmDeleteEmployment.defaultExpectation.params != nil {
+		mmDeleteEmployment.mock.t.Fatalf("PersonRepositoryMock.DeleteEmployment mock is already set by Expect")
+	}
+
+	if mmDeleteEmployment.defaultExpectation.paramPtrs == nil {
+		mmDeleteEmployment.defaultExpectation.paramPtrs = &PersonRepositoryMockDeleteEmploymentParamPtrs{}
+	}
+	mmDeleteEmployment.defaultExpectation.paramPtrs.employmentID = &employmentID
+	mmDeleteEmployment.defaultExpectation.expectationOrigins.originEmploymentID = minimock.CallerInfo(1)
+
+	return mmDeleteEmployment
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.DeleteEmployment
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) Inspect(f func(personID int32, employmentID int32)) *mPersonRepositoryMockDeleteEmployment {
+	if mmDeleteEmployment.mock.inspectFuncDeleteEmployment != nil {
+		mmDeleteEmployment.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.DeleteEmployment")
+	}
+
+	mmDeleteEmployment.mock.inspectFuncDeleteEmployment = f
+
+	return mmDeleteEmployment
+}
+
+// Return sets up results that will be returned by PersonRepository.DeleteEmployment
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) Return(err error) *PersonRepositoryMock {
+	if mmDeleteEmployment.mock.funcDeleteEmployment != nil {
+		mmDeleteEmployment.mock.t.Fatalf("PersonRepositoryMock.DeleteEmployment mock is already set by Set")
+	}
+
+	if mmDeleteEmployment.defaultExpectation == nil {
+		mmDeleteEmployment.defaultExpectation = &PersonRepositoryMockDeleteEmploymentExpectation{mock: mmDeleteEmployment.mock}
+	}
+	mmDeleteEmployment.defaultExpectation.results = &PersonRepositoryMockDeleteEmploymentResults{err}
+	mmDeleteEmployment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteEmployment.mock
+}
+
+// Set uses given function f to mock the PersonRepository.DeleteEmployment method
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) Set(f func(personID int32, employmentID int32) (err error)) *PersonRepositoryMock {
+	if mmDeleteEmployment.defaultExpectation != nil {
+		mmDeleteEmployment.mock.t.Fatalf("Default expectation is already set for the PersonRepository.DeleteEmployment method")
+	}
+
+	if len(mmDeleteEmployment.expectations) > 0 {
+		mmDeleteEmployment.mock.t.Fatalf("Some expectations are already set for the PersonRepository.DeleteEmployment method")
+	}
+
+	mmDeleteEmployment.mock.funcDeleteEmployment = f
+	mmDeleteEmployment.mock.funcDeleteEmploymentOrigin = minimock.CallerInfo(1)
+	return mmDeleteEmployment.mock
+}
+
+// When sets expectation for the PersonRepository.DeleteEmployment which will trigger the result defined by the following
+// Then helper
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) When(personID int32, employmentID int32) *PersonRepositoryMockDeleteEmploymentExpectation {
+	if mmDeleteEmployment.mock.funcDeleteEmployment != nil {
+		mmDeleteEmployment.mock.t.Fatalf("PersonRepositoryMock.DeleteEmployment mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockDeleteEmploymentExpectation{
+		mock:               mmDeleteEmployment.mock,
+		params:             &PersonRepositoryMockDeleteEmploymentParams{personID, employmentID},
+		expectationOrigins: PersonRepositoryMockDeleteEmploymentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteEmployment.expectations = append(mmDeleteEmployment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.DeleteEmployment return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockDeleteEmploymentExpectation) Then(err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockDeleteEmploymentResults{err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.DeleteEmployment should be invoked
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) Times(n uint64) *mPersonRepositoryMockDeleteEmployment {
+	if n == 0 {
+		mmDeleteEmployment.mock.t.Fatalf("Times of PersonRepositoryMock.DeleteEmployment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteEmployment.expectedInvocations, n)
+	mmDeleteEmployment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteEmployment
+}
+
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) invocationsDone() bool {
+	if len(mmDeleteEmployment.expectations) == 0 && mmDeleteEmployment.defaultExpectation == nil && mmDeleteEmployment.mock.funcDeleteEmployment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteEmployment.mock.afterDeleteEmploymentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteEmployment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteEmployment implements PersonRepository
+func (mmDeleteEmployment *PersonRepositoryMock) DeleteEmployment(personID int32, employmentID int32) (err error) {
+	mm_atomic.AddUint64(&mmDeleteEmployment.beforeDeleteEmploymentCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteEmployment.afterDeleteEmploymentCounter, 1)
+
+	mmDeleteEmployment.t.Helper()
+
+	if mmDeleteEmployment.inspectFuncDeleteEmployment != nil {
+		mmDeleteEmployment.inspectFuncDeleteEmployment(personID, employmentID)
+	}
+
+	mm_params := PersonRepositoryMockDeleteEmploymentParams{personID, employmentID}
+
+	// Record call args
+	mmDeleteEmployment.DeleteEmploymentMock.mutex.Lock()
+	mmDeleteEmployment.DeleteEmploymentMock.callArgs = append(mmDeleteEmployment.DeleteEmploymentMock.callArgs, &mm_params)
+	mmDeleteEmployment.DeleteEmploymentMock.mutex.Unlock()
+
+	for _, e := range mmDeleteEmployment.DeleteEmploymentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteEmployment.DeleteEmploymentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteEmployment.DeleteEmploymentMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteEmployment.DeleteEmploymentMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteEmployment.DeleteEmploymentMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockDeleteEmploymentParams{personID, employmentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.personID != nil && !minimock.Equal(*mm_want_ptrs.personID, mm_got.personID) {
+				mmDeleteEmployment.t.Errorf("PersonRepositoryMock.DeleteEmployment got unexpected parameter personID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEmployment.DeleteEmploymentMock.defaultExpectation.expectationOrigins.originPersonID, *mm_want_ptrs.personID, mm_got.personID, minimock.Diff(*mm_want_ptrs.personID, mm_got.personID))
+			}
+
+			if mm_want_ptrs.employmentID != nil && !minimock.Equal(*mm_want_ptrs.employmentID, mm_got.employmentID) {
+				mmDeleteEmployment.t.Errorf("PersonRepositoryMock.DeleteEmployment got unexpected parameter employmentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEmployment.DeleteEmploymentMock.defaultExpectation.expectationOrigins.originEmploymentID, *mm_want_ptrs.employmentID, mm_got.employmentID, minimock.Diff(*mm_want_ptrs.employmentID, mm_got.employmentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteEmployment.t.Errorf("PersonRepositoryMock.DeleteEmployment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteEmployment.DeleteEmploymentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteEmployment.DeleteEmploymentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteEmployment.t.Fatal("No results are set for the PersonRepositoryMock.DeleteEmployment")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteEmployment.funcDeleteEmployment != nil {
+		return mmDeleteEmployment.funcDeleteEmployment(personID, employmentID)
+	}
+	mmDeleteEmployment.t.Fatalf("Unexpected call to PersonRepositoryMock.DeleteEmployment. %v %v", personID, employmentID)
+	return
+}
+
+// DeleteEmploymentAfterCounter returns a count of finished PersonRepositoryMock.DeleteEmployment invocations
+func (mmDeleteEmployment *PersonRepositoryMock) DeleteEmploymentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteEmployment.afterDeleteEmploymentCounter)
+}
+
+// DeleteEmploymentBeforeCounter returns a count of PersonRepositoryMock.DeleteEmployment invocations
+func (mmDeleteEmployment *PersonRepositoryMock) DeleteEmploymentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteEmployment.beforeDeleteEmploymentCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.DeleteEmployment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteEmployment *mPersonRepositoryMockDeleteEmployment) Calls() []*PersonRepositoryMockDeleteEmploymentParams {
+	mmDeleteEmployment.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockDeleteEmploymentParams, len(mmDeleteEmployment.callArgs))
+	copy(argCopy, mmDeleteEmployment.callArgs)
+
+	mmDeleteEmployment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteEmploymentDone returns true if the count of the DeleteEmployment invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockDeleteEmploymentDone() bool {
+	if m.DeleteEmploymentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteEmploymentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteEmploymentMock.invocationsDone()
+}
+
+// MinimockDeleteEmploymentInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockDeleteEmploymentInspect() {
+	for _, e := range m.DeleteEmploymentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeleteEmployment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteEmploymentCounter := mm_atomic.LoadUint64(&m.afterDeleteEmploymentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteEmploymentMock.defaultExpectation != nil && afterDeleteEmploymentCounter < 1 {
+		if m.DeleteEmploymentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeleteEmployment at\n%s", m.DeleteEmploymentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeleteEmployment at\n%s with params: %#v", m.DeleteEmploymentMock.defaultExpectation.expectationOrigins.origin, *m.DeleteEmploymentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteEmployment != nil && afterDeleteEmploymentCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.DeleteEmployment at\n%s", m.funcDeleteEmploymentOrigin)
+	}
+
+	if !m.DeleteEmploymentMock.invocationsDone() && afterDeleteEmploymentCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.DeleteEmployment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteEmploymentMock.expectedInvocations), m.DeleteEmploymentMock.expectedInvocationsOrigin, afterDeleteEmploymentCounter)
+	}
+}
+
+type mPersonRepositoryMockDeleteOrganizationByID struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockDeleteOrganizationByIDExpectation
+	expectations       []*PersonRepositoryMockDeleteOrganizationByIDExpectation
+
+	callArgs []*PersonRepositoryMockDeleteOrganizationByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockDeleteOrganizationByIDExpectation specifies expectation struct of the PersonRepository.DeleteOrganizationByID
+type PersonRepositoryMockDeleteOrganizationByIDExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockDeleteOrganizationByIDParams
+	paramPtrs          *PersonRepositoryMockDeleteOrganizationByIDParamPtrs
+	expectationOrigins PersonRepositoryMockDeleteOrganizationByIDExpectationOrigins
+	results            *PersonRepositoryMockDeleteOrganizationByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockDeleteOrganizationByIDParams contains parameters of the PersonRepository.DeleteOrganizationByID
+type PersonRepositoryMockDeleteOrganizationByIDParams struct {
+	id int32
+}
+
+// PersonRepositoryMockDeleteOrganizationByIDParamPtrs contains pointers to parameters of the PersonRepository.DeleteOrganizationByID
+type PersonRepositoryMockDeleteOrganizationByIDParamPtrs struct {
+	id *int32
+}
+
+// PersonRepositoryMockDeleteOrganizationByIDResults contains results of the PersonRepository.DeleteOrganizationByID
+type PersonRepositoryMockDeleteOrganizationByIDResults struct {
+	err error
+}
+
+// PersonRepositoryMockDeleteOrganizationByIDOrigins contains origins of expectations of the PersonRepository.DeleteOrganizationByID
+type PersonRepositoryMockDeleteOrganizationByIDExpectationOrigins struct {
+	origin   string
+	originId string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteOrganizationByID *mPersonRepositoryMockDeleteOrganizationByID) Optional() *mPersonRepositoryMockDeleteOrganizationByID {
+	mmDeleteOrganizationByID.optional = true
+	return mmDeleteOrganizationByID
+}
+
+// Expect sets up expected params for PersonRepository.DeleteOrganizationByID
+func (mmDeleteOrganizationByID *mPersonRepositoryMockDeleteOrganizationByID) Expect(id int32) *mPersonRepositoryMockDeleteOrganizationByID {
+	if mmDeleteOrganizationByID.mock.funcDeleteOrganizationByID != nil {
+		mmDeleteOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.DeleteOrganizationByID mock is already set by Set")
+	}
+
+	if mmDeleteOrganizationByID.defaultExpectation == nil {
+		mmDeleteOrganizationByID.defaultExpectation = &PersonRepositoryMockDeleteOrganizationByIDExpectation{}
+	}
+
+	if mmDeleteOrganizationByID.defaultExpectation.paramPtrs != nil {
+		mmDeleteOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.DeleteOrganizationByID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteOrganizationByID.defaultExpectation.params = &PersonRepositoryMockDeleteOrganizationByIDParams{id}
+	mmDeleteOrganizationByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteOrganizationByID.expectations {
+		if minimock.Equal(e.params, mmDeleteOrganizationByID.defaultExpectation.params) {
+			mmDeleteOrganizationByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteOrganizationByID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteOrganizationByID
+}
+
+// ExpectIdParam1 sets up expected param id for PersonRepository.DeleteOrganizationByID
+func (mmDeleteOrganizationByID *mPersonRepositoryMockDeleteOrganizationByID) ExpectIdParam1(id int32) *mPersonRepositoryMockDeleteOrganizationByID {
+	if mmDeleteOrganizationByID.mock.funcDeleteOrganizationByID != nil {
+		mmDeleteOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.DeleteOrganizationByID mock is already set by Set")
+	}
+
+	if mmDeleteOrganizationByID.defaultExpectation == nil {
+		mmDeleteOrganizationByID.defaultExpectation = &PersonRepositoryMockDeleteOrganizationByIDExpectation{}
+	}
+
+	if mmDeleteOrganizationByID.defaultExpectation.params != nil {
+		mmDeleteOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.DeleteOrganizationByID mock is already set by Expect")
+	}
+
+	if mmDeleteOrganizationByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrganizationByID.defaultExpectation.paramPtrs = &PersonRepositoryMockDeleteOrganizationByIDParamPtrs{}
+	}
+	mmDeleteOrganizationByID.defaultExpectation.paramPtrs.id = &id
+	mmDeleteOrganizationByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteOrganizationByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.DeleteOrganizationByID
+func (mmDeleteOrganizationByID *mPersonRepositoryMockDeleteOrganizationByID) Inspect(f func(id int32)) *mPersonRepositoryMockDeleteOrganizationByID {
+	if mmDeleteOrganizationByID.mock.inspectFuncDeleteOrganizationByID != nil {
+		mmDeleteOrganizationByID.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.DeleteOrganizationByID")
+	}
+
+	mmDeleteOrganizationByID.mock.inspectFuncDeleteOrganizationByID = f
+
+	return mmDeleteOrganizationByID
+}
+
+// Return sets up results that will be returned by PersonRepository.DeleteOrganizationByID
+func (mmDeleteOrganizationByID *mPersonRepositoryMockDeleteOrganizationByID) Return(err error) *PersonRepositoryMock {
+	if mmDeleteOrganizationByID.mock.funcDeleteOrganizationByID != nil {
+		mmDeleteOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.DeleteOrganizationByID mock is already set by Set")
+	}
+
+	if mmDeleteOrganizationByID.defaultExpectation == nil {
+		mmDeleteOrganizationByID.defaultExpectation = &PersonRepositoryMockDeleteOrganizationByIDExpectation{mock: mmDeleteOrganizationByID.mock}
+	}
+	mmDeleteOrganizationByID.defaultExpectation.results = &PersonRepositoryMockDeleteOrganizationByIDResults{err}
+	mmDeleteOrganizationByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrganizationByID.mock
+}
+
+// Set uses given function f to mock the PersonRepository.DeleteOrganizationByID method
+func (mmDeleteOrganizationByID *mPersonRepositoryMockDeleteOrganizationByID) Set(f func(id int32) (err error)) *PersonRepositoryMock {
+	if mmDeleteOrganizationByID.defaultExpectation != nil {
+		mmDeleteOrganizationByID.mock.t.Fatalf("Default expectation is already set for the PersonRepository.DeleteOrganizationByID method")
+	}
+
+	if len(mmDeleteOrganizationByID.expectations) > 0 {
+		mmDeleteOrganizationByID.mock.t.Fatalf("Some expectations are already set for the PersonRepository.DeleteOrganizationByID method")
+	}
+
+	mmDeleteOrganizationByID.mock.funcDeleteOrganizationByID = f
+	mmDeleteOrganizationByID.mock.funcDeleteOrganizationByIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrganizationByID.mock
+}
+
+// When sets expectation for the PersonRepository.DeleteOrganizationByID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteOrganizationByID *mPersonRepositoryMockDeleteOrganizationByID) When(id int32) *PersonRepositoryMockDeleteOrganizationByIDExpectation {
+	if mmDeleteOrganizationByID.mock.funcDeleteOrganizationByID != nil {
+		mmDeleteOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.DeleteOrganizationByID mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockDeleteOrganizationByIDExpectation{
+		mock:               mmDeleteOrganizationByID.mock,
+		params:             &PersonRepositoryMockDeleteOrganizationByIDParams{id},
+		expectationOrigins: PersonRepositoryMockDeleteOrganizationByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteOrganizationByID.expectations = append(mmDeleteOrganizationByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.DeleteOrganizationByID return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockDeleteOrganizationByIDExpectation) Then(err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockDeleteOrganizationByIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.DeleteOrganizationByID should be invoked
+func (mmDeleteOrganizationByID *mPersonRepositoryMockDeleteOrganizationByID) Times(n uint64) *mPersonRepositoryMockDeleteOrganizationByID {
+	if n == 0 {
+		mmDeleteOrganizationByID.mock.t.Fatalf("Times of PersonRepositoryMock.DeleteOrganizationByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteOrganizationByID.expectedInvocations, n)
+	mmDeleteOrganizationByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrganizationByID
+}
+
+func (mmDeleteOrganizationByID *mPersonRepositoryMockDeleteOrganizationByID) invocationsDone() bool {
+	if len(mmDeleteOrganizationByID.expectations) == 0 && mmDeleteOrganizationByID.defaultExpectation == nil && mmDeleteOrganizationByID.mock.funcDeleteOrganizationByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteOrganizationByID.mock.afterDeleteOrganizationByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteOrganizationByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteOrganizationByID implements PersonRepository
+func (mmDeleteOrganizationByID *PersonRepositoryMock) DeleteOrganizationByID(id int32) (err error) {
+	mm_atomic.AddUint64(&mmDeleteOrganizationByID.beforeDeleteOrganizationByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteOrganizationByID.afterDeleteOrganizationByIDCounter, 1)
+
+	mmDeleteOrganizationByID.t.Helper()
+
+	if mmDeleteOrganizationByID.inspectFuncDeleteOrganizationByID != nil {
+		mmDeleteOrganizationByID.inspectFuncDeleteOrganizationByID(id)
+	}
+
+	mm_params := PersonRepositoryMockDeleteOrganizationByIDParams{id}
+
+	// Record call args
+	mmDeleteOrganizationByID.DeleteOrganizationByIDMock.mutex.Lock()
+	mmDeleteOrganizationByID.DeleteOrganizationByIDMock.callArgs = append(mmDeleteOrganizationByID.DeleteOrganizationByIDMock.callArgs, &mm_params)
+	mmDeleteOrganizationByID.DeleteOrganizationByIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteOrganizationByID.DeleteOrganizationByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteOrganizationByID.DeleteOrganizationByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteOrganizationByID.DeleteOrganizationByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteOrganizationByID.DeleteOrganizationByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteOrganizationByID.DeleteOrganizationByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockDeleteOrganizationByIDParams{id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteOrganizationByID.t.Errorf("PersonRepositoryMock.DeleteOrganizationByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrganizationByID.DeleteOrganizationByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteOrganizationByID.t.Errorf("PersonRepositoryMock.DeleteOrganizationByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteOrganizationByID.DeleteOrganizationByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteOrganizationByID.DeleteOrganizationByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteOrganizationByID.t.Fatal("No results are set for the PersonRepositoryMock.DeleteOrganizationByID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteOrganizationByID.funcDeleteOrganizationByID != nil {
+		return mmDeleteOrganizationByID.funcDeleteOrganizationByID(id)
+	}
+	mmDeleteOrganizationByID.t.Fatalf("Unexpected call to PersonRepositoryMock.DeleteOrganizationByID. %v", id)
+	return
+}
+
+// DeleteOrganizationByIDAfterCounter returns a count of finished PersonRepositoryMock.DeleteOrganizationByID invocations
+func (mmDeleteOrganizationByID *PersonRepositoryMock) DeleteOrganizationByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOrganizationByID.afterDeleteOrganizationByIDCounter)
+}
+
+// DeleteOrganizationByIDBeforeCounter returns a count of PersonRepositoryMock.DeleteOrganizationByID invocations
+func (mmDeleteOrganizationByID *PersonRepositoryMock) DeleteOrganizationByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOrganizationByID.beforeDeleteOrganizationByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.DeleteOrganizationByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteOrganizationByID *mPersonRepositoryMockDeleteOrganizationByID) Calls() []*PersonRepositoryMockDeleteOrganizationByIDParams {
+	mmDeleteOrganizationByID.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockDeleteOrganizationByIDParams, len(mmDeleteOrganizationByID.callArgs))
+	copy(argCopy, mmDeleteOrganizationByID.callArgs)
+
+	mmDeleteOrganizationByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteOrganizationByIDDone returns true if the count of the DeleteOrganizationByID invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockDeleteOrganizationByIDDone() bool {
+	if m.DeleteOrganizationByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteOrganizationByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteOrganizationByIDMock.invocationsDone()
+}
+
+// MinimockDeleteOrganizationByIDInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockDeleteOrganizationByIDInspect() {
+	for _, e := range m.DeleteOrganizationByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeleteOrganizationByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteOrganizationByIDCounter := mm_atomic.LoadUint64(&m.afterDeleteOrganizationByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteOrganizationByIDMock.defaultExpectation != nil && afterDeleteOrganizationByIDCounter < 1 {
+		if m.DeleteOrganizationByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeleteOrganizationByID at\n%s", m.DeleteOrganizationByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeleteOrganizationByID at\n%s with params: %#v", m.DeleteOrganizationByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteOrganizationByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteOrganizationByID != nil && afterDeleteOrganizationByIDCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.DeleteOrganizationByID at\n%s", m.funcDeleteOrganizationByIDOrigin)
+	}
+
+	if !m.DeleteOrganizationByIDMock.invocationsDone() && afterDeleteOrganizationByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.DeleteOrganizationByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteOrganizationByIDMock.expectedInvocations), m.DeleteOrganizationByIDMock.expectedInvocationsOrigin, afterDeleteOrganizationByIDCounter)
+	}
+}
+
+type mPersonRepositoryMockDeletePersonByID struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockDeletePersonByIDExpectation
+	expectations       []*PersonRepositoryMockDeletePersonByIDExpectation
+
+	callArgs []*PersonRepositoryMockDeletePersonByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockDeletePersonByIDExpectation specifies expectation struct of the PersonRepository.DeletePersonByID
+type PersonRepositoryMockDeletePersonByIDExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockDeletePersonByIDParams
+	paramPtrs          *PersonRepositoryMockDeletePersonByIDParamPtrs
+	expectationOrigins PersonRepositoryMockDeletePersonByIDExpectationOrigins
+	results            *PersonRepositoryMockDeletePersonByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockDeletePersonByIDParams contains parameters of the PersonRepository.DeletePersonByID
+type PersonRepositoryMockDeletePersonByIDParams struct {
+	id int32
+}
+
+// PersonRepositoryMockDeletePersonByIDParamPtrs contains pointers to parameters of the PersonRepository.DeletePersonByID
+type PersonRepositoryMockDeletePersonByIDParamPtrs struct {
+	id *int32
+}
+
+// PersonRepositoryMockDeletePersonByIDResults contains results of the PersonRepository.DeletePersonByID
+type PersonRepositoryMockDeletePersonByIDResults struct {
+	err error
+}
+
+// PersonRepositoryMockDeletePersonByIDOrigins contains origins of expectations of the PersonRepository.DeletePersonByID
+type PersonRepositoryMockDeletePersonByIDExpectationOrigins struct {
+	origin   string
+	originId string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Optional() *mPersonRepositoryMockDeletePersonByID {
+	mmDeletePersonByID.optional = true
+	return mmDeletePersonByID
+}
+
+// Expect sets up expected params for PersonRepository.DeletePersonByID
+func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Expect(id int32) *mPersonRepositoryMockDeletePersonByID {
+	if mmDeletePersonByID.mock.funcDeletePersonByID != nil {
+		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by Set")
+	}
+
+	if mmDeletePersonByID.defaultExpectation == nil {
+		mmDeletePersonByID.defaultExpectation = &PersonRepositoryMockDeletePersonByIDExpectation{}
+	}
+
+	if mmDeletePersonByID.defaultExpectation.paramPtrs != nil {
+		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePersonByID.defaultExpectation.params = &PersonRepositoryMockDeletePersonByIDParams{id}
+	mmDeletePersonByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePersonByID.expectations {
+		if minimock.Equal(e.params, mmDeletePersonByID.defaultExpectation.params) {
+			mmDeletePersonByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePersonByID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePersonByID
+}
+
+// ExpectIdParam1 sets up expected param id for PersonRepository.DeletePersonByID
+func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) ExpectIdParam1(id int32) *mPersonRepositoryMockDeletePersonByID {
+	if mmDeletePersonByID.mock.funcDeletePersonByID != nil {
+		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by Set")
+	}
+
+	if mmDeletePersonByID.defaultExpectation == nil {
+		mmDeletePersonByID.defaultExpectation = &PersonRepositoryMockDeletePersonByIDExpectation{}
+	}
+
+	if mmDeletePersonByID.defaultExpectation.params != nil {
+		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by Expect")
+	}
+
+	if mmDeletePersonByID.defaultExpectation.paramPtrs == nil {
+		mmDeletePersonByID.defaultExpectation.paramPtrs = &PersonRepositoryMockDeletePersonByIDParamPtrs{}
+	}
+	mmDeletePersonByID.defaultExpectation.paramPtrs.id = &id
+	mmDeletePersonByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeletePersonByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.DeletePersonByID
+func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Inspect(f func(id int32)) *mPersonRepositoryMockDeletePersonByID {
+	if mmDeletePersonByID.mock.inspectFuncDeletePersonByID != nil {
+		mmDeletePersonByID.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.DeletePersonByID")
+	}
+
+	mmDeletePersonByID.mock.inspectFuncDeletePersonByID = f
+
+	return mmDeletePersonByID
+}
+
+// Return sets up results that will be returned by PersonRepository.DeletePersonByID
+func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Return(err error) *PersonRepositoryMock {
+	if mmDeletePersonByID.mock.funcDeletePersonByID != nil {
+		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by Set")
+	}
+
+	if mmDeletePersonByID.defaultExpectation == nil {
+		mmDeletePersonByID.defaultExpectation = &PersonRepositoryMockDeletePersonByIDExpectation{mock: mmDeletePersonByID.mock}
+	}
+	mmDeletePersonByID.defaultExpectation.results = &PersonRepositoryMockDeletePersonByIDResults{err}
+	mmDeletePersonByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePersonByID.mock
+}
+
+// Set uses given function f to mock the PersonRepository.DeletePersonByID method
+func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Set(f func(id int32) (err error)) *PersonRepositoryMock {
+	if mmDeletePersonByID.defaultExpectation != nil {
+		mmDeletePersonByID.mock.t.Fatalf("Default expectation is already set for the PersonRepository.DeletePersonByID method")
+	}
+
+	if len(mmDeletePersonByID.expectations) > 0 {
+		mmDeletePersonByID.mock.t.Fatalf("Some expectations are already set for the PersonRepository.DeletePersonByID method")
+	}
+
+	mmDeletePersonByID.mock.funcDeletePersonByID = f
+	mmDeletePersonByID.mock.funcDeletePersonByIDOrigin = minimock.CallerInfo(1)
+	return mmDeletePersonByID.mock
+}
+
+// When sets expectation for the PersonRepository.DeletePersonByID which will trigger the result defined by the following
+// Then helper
+func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) When(id int32) *PersonRepositoryMockDeletePersonByIDExpectation {
+	if mmDeletePersonByID.mock.funcDeletePersonByID != nil {
+		mmDeletePersonByID.mock.t.Fatalf("PersonRepositoryMock.DeletePersonByID mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockDeletePersonByIDExpectation{
+		mock:               mmDeletePersonByID.mock,
+		params:             &PersonRepositoryMockDeletePersonByIDParams{id},
+		expectationOrigins: PersonRepositoryMockDeletePersonByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeletePersonByID.expectations = append(mmDeletePersonByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.DeletePersonByID return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockDeletePersonByIDExpectation) Then(err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockDeletePersonByIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.DeletePersonByID should be invoked
+func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Times(n uint64) *mPersonRepositoryMockDeletePersonByID {
+	if n == 0 {
+		mmDeletePersonByID.mock.t.Fatalf("Times of PersonRepositoryMock.DeletePersonByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePersonByID.expectedInvocations, n)
+	mmDeletePersonByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePersonByID
+}
+
+func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) invocationsDone() bool {
+	if len(mmDeletePersonByID.expectations) == 0 && mmDeletePersonByID.defaultExpectation == nil && mmDeletePersonByID.mock.funcDeletePersonByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePersonByID.mock.afterDeletePersonByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePersonByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePersonByID implements PersonRepository
+func (mmDeletePersonByID *PersonRepositoryMock) DeletePersonByID(id int32) (err error) {
+	mm_atomic.AddUint64(&mmDeletePersonByID.beforeDeletePersonByIDCounter, 1)
 	defer mm_atomic.AddUint64(&mmDeletePersonByID.afterDeletePersonByIDCounter, 1)
 
-	mmDeletePersonByID.t.Helper()
+	mmDeletePersonByID.t.Helper()
+
+	if mmDeletePersonByID.inspectFuncDeletePersonByID != nil {
+		mmDeletePersonByID.inspectFuncDeletePersonByID(id)
+	}
+
+	mm_params := PersonRepositoryMockDeletePersonByIDParams{id}
+
+	// Record call args
+	mmDeletePersonByID.DeletePersonByIDMock.mutex.Lock()
+	mmDeletePersonByID.DeletePersonByIDMock.callArgs = append(mmDeletePersonByID.DeletePersonByIDMock.callArgs, &mm_params)
+	mmDeletePersonByID.DeletePersonByIDMock.mutex.Unlock()
+
+	for _, e := range mmDeletePersonByID.DeletePersonByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockDeletePersonByIDParams{id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeletePersonByID.t.Errorf("PersonRepositoryMock.DeletePersonByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePersonByID.t.Errorf("PersonRepositoryMock.DeletePersonByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePersonByID.t.Fatal("No results are set for the PersonRepositoryMock.DeletePersonByID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePersonByID.funcDeletePersonByID != nil {
+		return mmDeletePersonByID.funcDeletePersonByID(id)
+	}
+	mmDeletePersonByID.t.Fatalf("Unexpected call to PersonRepositoryMock.DeletePersonByID. %v", id)
+	return
+}
+
+// DeletePersonByIDAfterCounter returns a count of finished PersonRepositoryMock.DeletePersonByID invocations
+func (mmDeletePersonByID *PersonRepositoryMock) DeletePersonByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePersonByID.afterDeletePersonByIDCounter)
+}
+
+// DeletePersonByIDBeforeCounter returns a count of PersonRepositoryMock.DeletePersonByID invocations
+func (mmDeletePersonByID *PersonRepositoryMock) DeletePersonByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePersonByID.beforeDeletePersonByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.DeletePersonByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Calls() []*PersonRepositoryMockDeletePersonByIDParams {
+	mmDeletePersonByID.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockDeletePersonByIDParams, len(mmDeletePersonByID.callArgs))
+	copy(argCopy, mmDeletePersonByID.callArgs)
+
+	mmDeletePersonByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePersonByIDDone returns true if the count of the DeletePersonByID invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockDeletePersonByIDDone() bool {
+	if m.DeletePersonByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePersonByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePersonByIDMock.invocationsDone()
+}
+
+// MinimockDeletePersonByIDInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockDeletePersonByIDInspect() {
+	for _, e := range m.DeletePersonByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeletePersonByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeletePersonByIDCounter := mm_atomic.LoadUint64(&m.afterDeletePersonByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePersonByIDMock.defaultExpectation != nil && afterDeletePersonByIDCounter < 1 {
+		if m.DeletePersonByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeletePersonByID at\n%s", m.DeletePersonByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeletePersonByID at\n%s with params: %#v", m.DeletePersonByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeletePersonByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePersonByID != nil && afterDeletePersonByIDCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.DeletePersonByID at\n%s", m.funcDeletePersonByIDOrigin)
+	}
+
+	if !m.DeletePersonByIDMock.invocationsDone() && afterDeletePersonByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.DeletePersonByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePersonByIDMock.expectedInvocations), m.DeletePersonByIDMock.expectedInvocationsOrigin, afterDeletePersonByIDCounter)
+	}
+}
+
+type mPersonRepositoryMockDeleteRelation struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockDeleteRelationExpectation
+	expectations       []*PersonRepositoryMockDeleteRelationExpectation
+
+	callArgs []*PersonRepositoryMockDeleteRelationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockDeleteRelationExpectation specifies expectation struct of the PersonRepository.DeleteRelation
+type PersonRepositoryMockDeleteRelationExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockDeleteRelationParams
+	paramPtrs          *PersonRepositoryMockDeleteRelationParamPtrs
+	expectationOrigins PersonRepositoryMockDeleteRelationExpectationOrigins
+	results            *PersonRepositoryMockDeleteRelationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockDeleteRelationParams contains parameters of the PersonRepository.DeleteRelation
+type PersonRepositoryMockDeleteRelationParams struct {
+	personID   int32
+	relationID int32
+}
+
+// PersonRepositoryMockDeleteRelationParamPtrs contains pointers to parameters of the PersonRepository.DeleteRelation
+type PersonRepositoryMockDeleteRelationParamPtrs struct {
+	personID   *int32
+	relationID *int32
+}
+
+// PersonRepositoryMockDeleteRelationResults contains results of the PersonRepository.DeleteRelation
+type PersonRepositoryMockDeleteRelationResults struct {
+	err error
+}
+
+// PersonRepositoryMockDeleteRelationOrigins contains origins of expectations of the PersonRepository.DeleteRelation
+type PersonRepositoryMockDeleteRelationExpectationOrigins struct {
+	origin           string
+	originPersonID   string
+	originRelationID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) Optional() *mPersonRepositoryMockDeleteRelation {
+	mmDeleteRelation.optional = true
+	return mmDeleteRelation
+}
+
+// Expect sets up expected params for PersonRepository.DeleteRelation
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) Expect(personID int32, relationID int32) *mPersonRepositoryMockDeleteRelation {
+	if mmDeleteRelation.mock.funcDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("PersonRepositoryMock.DeleteRelation mock is already set by Set")
+	}
+
+	if mmDeleteRelation.defaultExpectation == nil {
+		mmDeleteRelation.defaultExpectation = &PersonRepositoryMockDeleteRelationExpectation{}
+	}
+
+	if mmDeleteRelation.defaultExpectation.paramPtrs != nil {
+		mmDeleteRelation.mock.t.Fatalf("PersonRepositoryMock.DeleteRelation mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRelation.defaultExpectation.params = &PersonRepositoryMockDeleteRelationParams{personID, relationID}
+	mmDeleteRelation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRelation.expectations {
+		if minimock.Equal(e.params, mmDeleteRelation.defaultExpectation.params) {
+			mmDeleteRelation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRelation.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRelation
+}
+
+// ExpectPersonIDParam1 sets up expected param personID for PersonRepository.DeleteRelation
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) ExpectPersonIDParam1(personID int32) *mPersonRepositoryMockDeleteRelation {
+	if mmDeleteRelation.mock.funcDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("PersonRepositoryMock.DeleteRelation mock is already set by Set")
+	}
+
+	if mmDeleteRelation.defaultExpectation == nil {
+		mmDeleteRelation.defaultExpectation = &PersonRepositoryMockDeleteRelationExpectation{}
+	}
+
+	if mmDeleteRelation.defaultExpectation.params != nil {
+		mmDeleteRelation.mock.t.Fatalf("PersonRepositoryMock.DeleteRelation mock is already set by Expect")
+	}
+
+	if mmDeleteRelation.defaultExpectation.paramPtrs == nil {
+		mmDeleteRelation.defaultExpectation.paramPtrs = &PersonRepositoryMockDeleteRelationParamPtrs{}
+	}
+	mmDeleteRelation.defaultExpectation.paramPtrs.personID = &personID
+	mmDeleteRelation.defaultExpectation.expectationOrigins.originPersonID = minimock.CallerInfo(1)
+
+	return mmDeleteRelation
+}
+
+// ExpectRelationIDParam2 sets up expected param relationID for PersonRepository.DeleteRelation
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) ExpectRelationIDParam2(relationID int32) *mPersonRepositoryMockDeleteRelation {
+	if mmDeleteRelation.mock.funcDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("PersonRepositoryMock.DeleteRelation mock is already set by Set")
+	}
+
+	if mmDeleteRelation.defaultExpectation == nil {
+		mmDeleteRelation.defaultExpectation = &PersonRepositoryMockDeleteRelationExpectation{}
+	}
+
+	if mmDeleteRelation.defaultExpectation.params != nil {
+		mmDeleteRelation.mock.t.Fatalf("PersonRepositoryMock.DeleteRelation mock is already set by Expect")
+	}
+
+	if mmDeleteRelation.defaultExpectation.paramPtrs == nil {
+		mmDeleteRelation.defaultExpectation.paramPtrs = &PersonRepositoryMockDeleteRelationParamPtrs{}
+	}
+	mmDeleteRelation.defaultExpectation.paramPtrs.relationID = &relationID
+	mmDeleteRelation.defaultExpectation.expectationOrigins.originRelationID = minimock.CallerInfo(1)
+
+	return mmDeleteRelation
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.DeleteRelation
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) Inspect(f func(personID int32, relationID int32)) *mPersonRepositoryMockDeleteRelation {
+	if mmDeleteRelation.mock.inspectFuncDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.DeleteRelation")
+	}
+
+	mmDeleteRelation.mock.inspectFuncDeleteRelation = f
+
+	return mmDeleteRelation
+}
+
+// Return sets up results that will be returned by PersonRepository.DeleteRelation
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) Return(err error) *PersonRepositoryMock {
+	if mmDeleteRelation.mock.funcDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("PersonRepositoryMock.DeleteRelation mock is already set by Set")
+	}
+
+	if mmDeleteRelation.defaultExpectation == nil {
+		mmDeleteRelation.defaultExpectation = &PersonRepositoryMockDeleteRelationExpectation{mock: mmDeleteRelation.mock}
+	}
+	mmDeleteRelation.defaultExpectation.results = &PersonRepositoryMockDeleteRelationResults{err}
+	mmDeleteRelation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRelation.mock
+}
+
+// Set uses given function f to mock the PersonRepository.DeleteRelation method
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) Set(f func(personID int32, relationID int32) (err error)) *PersonRepositoryMock {
+	if mmDeleteRelation.defaultExpectation != nil {
+		mmDeleteRelation.mock.t.Fatalf("Default expectation is already set for the PersonRepository.DeleteRelation method")
+	}
+
+	if len(mmDeleteRelation.expectations) > 0 {
+		mmDeleteRelation.mock.t.Fatalf("Some expectations are already set for the PersonRepository.DeleteRelation method")
+	}
+
+	mmDeleteRelation.mock.funcDeleteRelation = f
+	mmDeleteRelation.mock.funcDeleteRelationOrigin = minimock.CallerInfo(1)
+	return mmDeleteRelation.mock
+}
+
+// When sets expectation for the PersonRepository.DeleteRelation which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) When(personID int32, relationID int32) *PersonRepositoryMockDeleteRelationExpectation {
+	if mmDeleteRelation.mock.funcDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("PersonRepositoryMock.DeleteRelation mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockDeleteRelationExpectation{
+		mock:               mmDeleteRelation.mock,
+		params:             &PersonRepositoryMockDeleteRelationParams{personID, relationID},
+		expectationOrigins: PersonRepositoryMockDeleteRelationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRelation.expectations = append(mmDeleteRelation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.DeleteRelation return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockDeleteRelationExpectation) Then(err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockDeleteRelationResults{err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.DeleteRelation should be invoked
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) Times(n uint64) *mPersonRepositoryMockDeleteRelation {
+	if n == 0 {
+		mmDeleteRelation.mock.t.Fatalf("Times of PersonRepositoryMock.DeleteRelation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRelation.expectedInvocations, n)
+	mmDeleteRelation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRelation
+}
+
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) invocationsDone() bool {
+	if len(mmDeleteRelation.expectations) == 0 && mmDeleteRelation.defaultExpectation == nil && mmDeleteRelation.mock.funcDeleteRelation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRelation.mock.afterDeleteRelationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRelation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRelation implements PersonRepository
+func (mmDeleteRelation *PersonRepositoryMock) DeleteRelation(personID int32, relationID int32) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRelation.beforeDeleteRelationCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRelation.afterDeleteRelationCounter, 1)
+
+	mmDeleteRelation.t.Helper()
+
+	if mmDeleteRelation.inspectFuncDeleteRelation != nil {
+		mmDeleteRelation.inspectFuncDeleteRelation(personID, relationID)
+	}
+
+	mm_params := PersonRepositoryMockDeleteRelationParams{personID, relationID}
+
+	// Record call args
+	mmDeleteRelation.DeleteRelationMock.mutex.Lock()
+	mmDeleteRelation.DeleteRelationMock.callArgs = append(mmDeleteRelation.DeleteRelationMock.callArgs, &mm_params)
+	mmDeleteRelation.DeleteRelationMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRelation.DeleteRelationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRelation.DeleteRelationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRelation.DeleteRelationMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRelation.DeleteRelationMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRelation.DeleteRelationMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockDeleteRelationParams{personID, relationID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.personID != nil && !minimock.Equal(*mm_want_ptrs.personID, mm_got.personID) {
+				mmDeleteRelation.t.Errorf("PersonRepositoryMock.DeleteRelation got unexpected parameter personID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRelation.DeleteRelationMock.defaultExpectation.expectationOrigins.originPersonID, *mm_want_ptrs.personID, mm_got.personID, minimock.Diff(*mm_want_ptrs.personID, mm_got.personID))
+			}
+
+			if mm_want_ptrs.relationID != nil && !minimock.Equal(*mm_want_ptrs.relationID, mm_got.relationID) {
+				mmDeleteRelation.t.Errorf("PersonRepositoryMock.DeleteRelation got unexpected parameter relationID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRelation.DeleteRelationMock.defaultExpectation.expectationOrigins.originRelationID, *mm_want_ptrs.relationID, mm_got.relationID, minimock.Diff(*mm_want_ptrs.relationID, mm_got.relationID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRelation.t.Errorf("PersonRepositoryMock.DeleteRelation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRelation.DeleteRelationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRelation.DeleteRelationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRelation.t.Fatal("No results are set for the PersonRepositoryMock.DeleteRelation")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRelation.funcDeleteRelation != nil {
+		return mmDeleteRelation.funcDeleteRelation(personID, relationID)
+	}
+	mmDeleteRelation.t.Fatalf("Unexpected call to PersonRepositoryMock.DeleteRelation. %v %v", personID, relationID)
+	return
+}
+
+// DeleteRelationAfterCounter returns a count of finished PersonRepositoryMock.DeleteRelation invocations
+func (mmDeleteRelation *PersonRepositoryMock) DeleteRelationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRelation.afterDeleteRelationCounter)
+}
+
+// DeleteRelationBeforeCounter returns a count of PersonRepositoryMock.DeleteRelation invocations
+func (mmDeleteRelation *PersonRepositoryMock) DeleteRelationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRelation.beforeDeleteRelationCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.DeleteRelation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRelation *mPersonRepositoryMockDeleteRelation) Calls() []*PersonRepositoryMockDeleteRelationParams {
+	mmDeleteRelation.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockDeleteRelationParams, len(mmDeleteRelation.callArgs))
+	copy(argCopy, mmDeleteRelation.callArgs)
+
+	mmDeleteRelation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRelationDone returns true if the count of the DeleteRelation invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockDeleteRelationDone() bool {
+	if m.DeleteRelationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRelationMock.invocationsDone()
+}
+
+// MinimockDeleteRelationInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockDeleteRelationInspect() {
+	for _, e := range m.DeleteRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeleteRelation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRelationCounter := mm_atomic.LoadUint64(&m.afterDeleteRelationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRelationMock.defaultExpectation != nil && afterDeleteRelationCounter < 1 {
+		if m.DeleteRelationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeleteRelation at\n%s", m.DeleteRelationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.DeleteRelation at\n%s with params: %#v", m.DeleteRelationMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRelationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRelation != nil && afterDeleteRelationCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.DeleteRelation at\n%s", m.funcDeleteRelationOrigin)
+	}
+
+	if !m.DeleteRelationMock.invocationsDone() && afterDeleteRelationCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.DeleteRelation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRelationMock.expectedInvocations), m.DeleteRelationMock.expectedInvocationsOrigin, afterDeleteRelationCounter)
+	}
+}
+
+type mPersonRepositoryMockGetAllPerson struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockGetAllPersonExpectation
+	expectations       []*PersonRepositoryMockGetAllPersonExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockGetAllPersonExpectation specifies expectation struct of the PersonRepository.GetAllPerson
+type PersonRepositoryMockGetAllPersonExpectation struct {
+	mock *PersonRepositoryMock
+
+	results      *PersonRepositoryMockGetAllPersonResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// PersonRepositoryMockGetAllPersonResults contains results of the PersonRepository.GetAllPerson
+type PersonRepositoryMockGetAllPersonResults struct {
+	pa1 []models.Person
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Optional() *mPersonRepositoryMockGetAllPerson {
+	mmGetAllPerson.optional = true
+	return mmGetAllPerson
+}
+
+// Expect sets up expected params for PersonRepository.GetAllPerson
+func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Expect() *mPersonRepositoryMockGetAllPerson {
+	if mmGetAllPerson.mock.funcGetAllPerson != nil {
+		mmGetAllPerson.mock.t.Fatalf("PersonRepositoryMock.GetAllPerson mock is already set by Set")
+	}
+
+	if mmGetAllPerson.defaultExpectation == nil {
+		mmGetAllPerson.defaultExpectation = &PersonRepositoryMockGetAllPersonExpectation{}
+	}
+
+	return mmGetAllPerson
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.GetAllPerson
+func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Inspect(f func()) *mPersonRepositoryMockGetAllPerson {
+	if mmGetAllPerson.mock.inspectFuncGetAllPerson != nil {
+		mmGetAllPerson.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetAllPerson")
+	}
+
+	mmGetAllPerson.mock.inspectFuncGetAllPerson = f
+
+	return mmGetAllPerson
+}
+
+// Return sets up results that will be returned by PersonRepository.GetAllPerson
+func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Return(pa1 []models.Person, err error) *PersonRepositoryMock {
+	if mmGetAllPerson.mock.funcGetAllPerson != nil {
+		mmGetAllPerson.mock.t.Fatalf("PersonRepositoryMock.GetAllPerson mock is already set by Set")
+	}
+
+	if mmGetAllPerson.defaultExpectation == nil {
+		mmGetAllPerson.defaultExpectation = &PersonRepositoryMockGetAllPersonExpectation{mock: mmGetAllPerson.mock}
+	}
+	mmGetAllPerson.defaultExpectation.results = &PersonRepositoryMockGetAllPersonResults{pa1, err}
+	mmGetAllPerson.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAllPerson.mock
+}
+
+// Set uses given function f to mock the PersonRepository.GetAllPerson method
+func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Set(f func() (pa1 []models.Person, err error)) *PersonRepositoryMock {
+	if mmGetAllPerson.defaultExpectation != nil {
+		mmGetAllPerson.mock.t.Fatalf("Default expectation is already set for the PersonRepository.GetAllPerson method")
+	}
+
+	if len(mmGetAllPerson.expectations) > 0 {
+		mmGetAllPerson.mock.t.Fatalf("Some expectations are already set for the PersonRepository.GetAllPerson method")
+	}
+
+	mmGetAllPerson.mock.funcGetAllPerson = f
+	mmGetAllPerson.mock.funcGetAllPersonOrigin = minimock.CallerInfo(1)
+	return mmGetAllPerson.mock
+}
+
+// Times sets number of times PersonRepository.GetAllPerson should be invoked
+func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Times(n uint64) *mPersonRepositoryMockGetAllPerson {
+	if n == 0 {
+		mmGetAllPerson.mock.t.Fatalf("Times of PersonRepositoryMock.GetAllPerson mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAllPerson.expectedInvocations, n)
+	mmGetAllPerson.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAllPerson
+}
+
+func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) invocationsDone() bool {
+	if len(mmGetAllPerson.expectations) == 0 && mmGetAllPerson.defaultExpectation == nil && mmGetAllPerson.mock.funcGetAllPerson == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAllPerson.mock.afterGetAllPersonCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAllPerson.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAllPerson implements PersonRepository
+func (mmGetAllPerson *PersonRepositoryMock) GetAllPerson() (pa1 []models.Person, err error) {
+	mm_atomic.AddUint64(&mmGetAllPerson.beforeGetAllPersonCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAllPerson.afterGetAllPersonCounter, 1)
+
+	mmGetAllPerson.t.Helper()
+
+	if mmGetAllPerson.inspectFuncGetAllPerson != nil {
+		mmGetAllPerson.inspectFuncGetAllPerson()
+	}
+
+	if mmGetAllPerson.GetAllPersonMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAllPerson.GetAllPersonMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGetAllPerson.GetAllPersonMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAllPerson.t.Fatal("No results are set for the PersonRepositoryMock.GetAllPerson")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetAllPerson.funcGetAllPerson != nil {
+		return mmGetAllPerson.funcGetAllPerson()
+	}
+	mmGetAllPerson.t.Fatalf("Unexpected call to PersonRepositoryMock.GetAllPerson.")
+	return
+}
+
+// GetAllPersonAfterCounter returns a count of finished PersonRepositoryMock.GetAllPerson invocations
+func (mmGetAllPerson *PersonRepositoryMock) GetAllPersonAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAllPerson.afterGetAllPersonCounter)
+}
+
+// GetAllPersonBeforeCounter returns a count of PersonRepositoryMock.GetAllPerson invocations
+func (mmGetAllPerson *PersonRepositoryMock) GetAllPersonBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAllPerson.beforeGetAllPersonCounter)
+}
+
+// MinimockGetAllPersonDone returns true if the count of the GetAllPerson invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockGetAllPersonDone() bool {
+	if m.GetAllPersonMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAllPersonMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAllPersonMock.invocationsDone()
+}
+
+// MinimockGetAllPersonInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockGetAllPersonInspect() {
+	for _, e := range m.GetAllPersonMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to PersonRepositoryMock.GetAllPerson")
+		}
+	}
+
+	afterGetAllPersonCounter := mm_atomic.LoadUint64(&m.afterGetAllPersonCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAllPersonMock.defaultExpectation != nil && afterGetAllPersonCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetAllPerson at\n%s", m.GetAllPersonMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAllPerson != nil && afterGetAllPersonCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetAllPerson at\n%s", m.funcGetAllPersonOrigin)
+	}
+
+	if !m.GetAllPersonMock.invocationsDone() && afterGetAllPersonCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetAllPerson at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAllPersonMock.expectedInvocations), m.GetAllPersonMock.expectedInvocationsOrigin, afterGetAllPersonCounter)
+	}
+}
+
+type mPersonRepositoryMockGetDuplicateCandidates struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockGetDuplicateCandidatesExpectation
+	expectations       []*PersonRepositoryMockGetDuplicateCandidatesExpectation
+
+	callArgs []*PersonRepositoryMockGetDuplicateCandidatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockGetDuplicateCandidatesExpectation specifies expectation struct of the PersonRepository.GetDuplicateCandidates
+type PersonRepositoryMockGetDuplicateCandidatesExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockGetDuplicateCandidatesParams
+	paramPtrs          *PersonRepositoryMockGetDuplicateCandidatesParamPtrs
+	expectationOrigins PersonRepositoryMockGetDuplicateCandidatesExpectationOrigins
+	results            *PersonRepositoryMockGetDuplicateCandidatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockGetDuplicateCandidatesParams contains parameters of the PersonRepository.GetDuplicateCandidates
+type PersonRepositoryMockGetDuplicateCandidatesParams struct {
+	person models.Person
+	limit  int
+}
+
+// PersonRepositoryMockGetDuplicateCandidatesParamPtrs contains pointers to parameters of the PersonRepository.GetDuplicateCandidates
+type PersonRepositoryMockGetDuplicateCandidatesParamPtrs struct {
+	person *models.Person
+	limit  *int
+}
+
+// PersonRepositoryMockGetDuplicateCandidatesResults contains results of the PersonRepository.GetDuplicateCandidates
+type PersonRepositoryMockGetDuplicateCandidatesResults struct {
+	pa1 []models.Person
+	err error
+}
+
+// PersonRepositoryMockGetDuplicateCandidatesOrigins contains origins of expectations of the PersonRepository.GetDuplicateCandidates
+type PersonRepositoryMockGetDuplicateCandidatesExpectationOrigins struct {
+	origin       string
+	originPerson string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) Optional() *mPersonRepositoryMockGetDuplicateCandidates {
+	mmGetDuplicateCandidates.optional = true
+	return mmGetDuplicateCandidates
+}
+
+// Expect sets up expected params for PersonRepository.GetDuplicateCandidates
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) Expect(person models.Person, limit int) *mPersonRepositoryMockGetDuplicateCandidates {
+	if mmGetDuplicateCandidates.mock.funcGetDuplicateCandidates != nil {
+		mmGetDuplicateCandidates.mock.t.Fatalf("PersonRepositoryMock.GetDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmGetDuplicateCandidates.defaultExpectation == nil {
+		mmGetDuplicateCandidates.defaultExpectation = &PersonRepositoryMockGetDuplicateCandidatesExpectation{}
+	}
+
+	if mmGetDuplicateCandidates.defaultExpectation.paramPtrs != nil {
+		mmGetDuplicateCandidates.mock.t.Fatalf("PersonRepositoryMock.GetDuplicateCandidates mock is already set by ExpectParams functions")
+	}
+
+	mmGetDuplicateCandidates.defaultExpectation.params = &PersonRepositoryMockGetDuplicateCandidatesParams{person, limit}
+	mmGetDuplicateCandidates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDuplicateCandidates.expectations {
+		if minimock.Equal(e.params, mmGetDuplicateCandidates.defaultExpectation.params) {
+			mmGetDuplicateCandidates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDuplicateCandidates.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDuplicateCandidates
+}
+
+// ExpectPersonParam1 sets up expected param person for PersonRepository.GetDuplicateCandidates
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) ExpectPersonParam1(person models.Person) *mPersonRepositoryMockGetDuplicateCandidates {
+	if mmGetDuplicateCandidates.mock.funcGetDuplicateCandidates != nil {
+		mmGetDuplicateCandidates.mock.t.Fatalf("PersonRepositoryMock.GetDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmGetDuplicateCandidates.defaultExpectation == nil {
+		mmGetDuplicateCandidates.defaultExpectation = &PersonRepositoryMockGetDuplicateCandidatesExpectation{}
+	}
+
+	if mmGetDuplicateCandidates.defaultExpectation.params != nil {
+		mmGetDuplicateCandidates.mock.t.Fatalf("PersonRepositoryMock.GetDuplicateCandidates mock is already set by Expect")
+	}
+
+	if mmGetDuplicateCandidates.defaultExpectation.paramPtrs == nil {
+		mmGetDuplicateCandidates.defaultExpectation.paramPtrs = &PersonRepositoryMockGetDuplicateCandidatesParamPtrs{}
+	}
+	mmGetDuplicateCandidates.defaultExpectation.paramPtrs.person = &person
+	mmGetDuplicateCandidates.defaultExpectation.expectationOrigins.originPerson = minimock.CallerInfo(1)
+
+	return mmGetDuplicateCandidates
+}
+
+// ExpectLimitParam2 sets up expected param limit for PersonRepository.GetDuplicateCandidates
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) ExpectLimitParam2(limit int) *mPersonRepositoryMockGetDuplicateCandidates {
+	if mmGetDuplicateCandidates.mock.funcGetDuplicateCandidates != nil {
+		mmGetDuplicateCandidates.mock.t.Fatalf("PersonRepositoryMock.GetDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmGetDuplicateCandidates.defaultExpectation == nil {
+		mmGetDuplicateCandidates.defaultExpectation = &PersonRepositoryMockGetDuplicateCandidatesExpectation{}
+	}
+
+	if mmGetDuplicateCandidates.defaultExpectation.params != nil {
+		mmGetDuplicateCandidates.mock.t.Fatalf("PersonRepositoryMock.GetDuplicateCandidates mock is already set by Expect")
+	}
+
+	if mmGetDuplicateCandidates.defaultExpectation.paramPtrs == nil {
+		mmGetDuplicateCandidates.defaultExpectation.paramPtrs = &PersonRepositoryMockGetDuplicateCandidatesParamPtrs{}
+	}
+	mmGetDuplicateCandidates.defaultExpectation.paramPtrs.limit = &limit
+	mmGetDuplicateCandidates.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetDuplicateCandidates
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.GetDuplicateCandidates
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) Inspect(f func(person models.Person, limit int)) *mPersonRepositoryMockGetDuplicateCandidates {
+	if mmGetDuplicateCandidates.mock.inspectFuncGetDuplicateCandidates != nil {
+		mmGetDuplicateCandidates.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetDuplicateCandidates")
+	}
+
+	mmGetDuplicateCandidates.mock.inspectFuncGetDuplicateCandidates = f
+
+	return mmGetDuplicateCandidates
+}
+
+// Return sets up results that will be returned by PersonRepository.GetDuplicateCandidates
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) Return(pa1 []models.Person, err error) *PersonRepositoryMock {
+	if mmGetDuplicateCandidates.mock.funcGetDuplicateCandidates != nil {
+		mmGetDuplicateCandidates.mock.t.Fatalf("PersonRepositoryMock.GetDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmGetDuplicateCandidates.defaultExpectation == nil {
+		mmGetDuplicateCandidates.defaultExpectation = &PersonRepositoryMockGetDuplicateCandidatesExpectation{mock: mmGetDuplicateCandidates.mock}
+	}
+	mmGetDuplicateCandidates.defaultExpectation.results = &PersonRepositoryMockGetDuplicateCandidatesResults{pa1, err}
+	mmGetDuplicateCandidates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDuplicateCandidates.mock
+}
+
+// Set uses given function f to mock the PersonRepository.GetDuplicateCandidates method
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) Set(f func(person models.Person, limit int) (pa1 []models.Person, err error)) *PersonRepositoryMock {
+	if mmGetDuplicateCandidates.defaultExpectation != nil {
+		mmGetDuplicateCandidates.mock.t.Fatalf("Default expectation is already set for the PersonRepository.GetDuplicateCandidates method")
+	}
+
+	if len(mmGetDuplicateCandidates.expectations) > 0 {
+		mmGetDuplicateCandidates.mock.t.Fatalf("Some expectations are already set for the PersonRepository.GetDuplicateCandidates method")
+	}
+
+	mmGetDuplicateCandidates.mock.funcGetDuplicateCandidates = f
+	mmGetDuplicateCandidates.mock.funcGetDuplicateCandidatesOrigin = minimock.CallerInfo(1)
+	return mmGetDuplicateCandidates.mock
+}
+
+// When sets expectation for the PersonRepository.GetDuplicateCandidates which will trigger the result defined by the following
+// Then helper
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) When(person models.Person, limit int) *PersonRepositoryMockGetDuplicateCandidatesExpectation {
+	if mmGetDuplicateCandidates.mock.funcGetDuplicateCandidates != nil {
+		mmGetDuplicateCandidates.mock.t.Fatalf("PersonRepositoryMock.GetDuplicateCandidates mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockGetDuplicateCandidatesExpectation{
+		mock:               mmGetDuplicateCandidates.mock,
+		params:             &PersonRepositoryMockGetDuplicateCandidatesParams{person, limit},
+		expectationOrigins: PersonRepositoryMockGetDuplicateCandidatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDuplicateCandidates.expectations = append(mmGetDuplicateCandidates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.GetDuplicateCandidates return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockGetDuplicateCandidatesExpectation) Then(pa1 []models.Person, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockGetDuplicateCandidatesResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.GetDuplicateCandidates should be invoked
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) Times(n uint64) *mPersonRepositoryMockGetDuplicateCandidates {
+	if n == 0 {
+		mmGetDuplicateCandidates.mock.t.Fatalf("Times of PersonRepositoryMock.GetDuplicateCandidates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDuplicateCandidates.expectedInvocations, n)
+	mmGetDuplicateCandidates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDuplicateCandidates
+}
+
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) invocationsDone() bool {
+	if len(mmGetDuplicateCandidates.expectations) == 0 && mmGetDuplicateCandidates.defaultExpectation == nil && mmGetDuplicateCandidates.mock.funcGetDuplicateCandidates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDuplicateCandidates.mock.afterGetDuplicateCandidatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDuplicateCandidates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDuplicateCandidates implements PersonRepository
+func (mmGetDuplicateCandidates *PersonRepositoryMock) GetDuplicateCandidates(person models.Person, limit int) (pa1 []models.Person, err error) {
+	mm_atomic.AddUint64(&mmGetDuplicateCandidates.beforeGetDuplicateCandidatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDuplicateCandidates.afterGetDuplicateCandidatesCounter, 1)
+
+	mmGetDuplicateCandidates.t.Helper()
+
+	if mmGetDuplicateCandidates.inspectFuncGetDuplicateCandidates != nil {
+		mmGetDuplicateCandidates.inspectFuncGetDuplicateCandidates(person, limit)
+	}
+
+	mm_params := PersonRepositoryMockGetDuplicateCandidatesParams{person, limit}
+
+	// Record call args
+	mmGetDuplicateCandidates.GetDuplicateCandidatesMock.mutex.Lock()
+	mmGetDuplicateCandidates.GetDuplicateCandidatesMock.callArgs = append(mmGetDuplicateCandidates.GetDuplicateCandidatesMock.callArgs, &mm_params)
+	mmGetDuplicateCandidates.GetDuplicateCandidatesMock.mutex.Unlock()
+
+	for _, e := range mmGetDuplicateCandidates.GetDuplicateCandidatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetDuplicateCandidates.GetDuplicateCandidatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDuplicateCandidates.GetDuplicateCandidatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDuplicateCandidates.GetDuplicateCandidatesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDuplicateCandidates.GetDuplicateCandidatesMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockGetDuplicateCandidatesParams{person, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.person != nil && !minimock.Equal(*mm_want_ptrs.person, mm_got.person) {
+				mmGetDuplicateCandidates.t.Errorf("PersonRepositoryMock.GetDuplicateCandidates got unexpected parameter person, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDuplicateCandidates.GetDuplicateCandidatesMock.defaultExpectation.expectationOrigins.originPerson, *mm_want_ptrs.person, mm_got.person, minimock.Diff(*mm_want_ptrs.person, mm_got.person))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetDuplicateCandidates.t.Errorf("PersonRepositoryMock.GetDuplicateCandidates got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDuplicateCandidates.GetDuplicateCandidatesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDuplicateCandidates.t.Errorf("PersonRepositoryMock.GetDuplicateCandidates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDuplicateCandidates.GetDuplicateCandidatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDuplicateCandidates.GetDuplicateCandidatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDuplicateCandidates.t.Fatal("No results are set for the PersonRepositoryMock.GetDuplicateCandidates")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetDuplicateCandidates.funcGetDuplicateCandidates != nil {
+		return mmGetDuplicateCandidates.funcGetDuplicateCandidates(person, limit)
+	}
+	mmGetDuplicateCandidates.t.Fatalf("Unexpected call to PersonRepositoryMock.GetDuplicateCandidates. %v %v", person, limit)
+	return
+}
+
+// GetDuplicateCandidatesAfterCounter returns a count of finished PersonRepositoryMock.GetDuplicateCandidates invocations
+func (mmGetDuplicateCandidates *PersonRepositoryMock) GetDuplicateCandidatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDuplicateCandidates.afterGetDuplicateCandidatesCounter)
+}
+
+// GetDuplicateCandidatesBeforeCounter returns a count of PersonRepositoryMock.GetDuplicateCandidates invocations
+func (mmGetDuplicateCandidates *PersonRepositoryMock) GetDuplicateCandidatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDuplicateCandidates.beforeGetDuplicateCandidatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.GetDuplicateCandidates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDuplicateCandidates *mPersonRepositoryMockGetDuplicateCandidates) Calls() []*PersonRepositoryMockGetDuplicateCandidatesParams {
+	mmGetDuplicateCandidates.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockGetDuplicateCandidatesParams, len(mmGetDuplicateCandidates.callArgs))
+	copy(argCopy, mmGetDuplicateCandidates.callArgs)
+
+	mmGetDuplicateCandidates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDuplicateCandidatesDone returns true if the count of the GetDuplicateCandidates invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockGetDuplicateCandidatesDone() bool {
+	if m.GetDuplicateCandidatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDuplicateCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDuplicateCandidatesMock.invocationsDone()
+}
+
+// MinimockGetDuplicateCandidatesInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockGetDuplicateCandidatesInspect() {
+	for _, e := range m.GetDuplicateCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetDuplicateCandidates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDuplicateCandidatesCounter := mm_atomic.LoadUint64(&m.afterGetDuplicateCandidatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDuplicateCandidatesMock.defaultExpectation != nil && afterGetDuplicateCandidatesCounter < 1 {
+		if m.GetDuplicateCandidatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetDuplicateCandidates at\n%s", m.GetDuplicateCandidatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetDuplicateCandidates at\n%s with params: %#v", m.GetDuplicateCandidatesMock.defaultExpectation.expectationOrigins.origin, *m.GetDuplicateCandidatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDuplicateCandidates != nil && afterGetDuplicateCandidatesCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetDuplicateCandidates at\n%s", m.funcGetDuplicateCandidatesOrigin)
+	}
+
+	if !m.GetDuplicateCandidatesMock.invocationsDone() && afterGetDuplicateCandidatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetDuplicateCandidates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDuplicateCandidatesMock.expectedInvocations), m.GetDuplicateCandidatesMock.expectedInvocationsOrigin, afterGetDuplicateCandidatesCounter)
+	}
+}
+
+type mPersonRepositoryMockGetEmployments struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockGetEmploymentsExpectation
+	expectations       []*PersonRepositoryMockGetEmploymentsExpectation
+
+	callArgs []*PersonRepositoryMockGetEmploymentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockGetEmploymentsExpectation specifies expectation struct of the PersonRepository.GetEmployments
+type PersonRepositoryMockGetEmploymentsExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockGetEmploymentsParams
+	paramPtrs          *PersonRepositoryMockGetEmploymentsParamPtrs
+	expectationOrigins PersonRepositoryMockGetEmploymentsExpectationOrigins
+	results            *PersonRepositoryMockGetEmploymentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockGetEmploymentsParams contains parameters of the PersonRepository.GetEmployments
+type PersonRepositoryMockGetEmploymentsParams struct {
+	personID int32
+}
+
+// PersonRepositoryMockGetEmploymentsParamPtrs contains pointers to parameters of the PersonRepository.GetEmployments
+type PersonRepositoryMockGetEmploymentsParamPtrs struct {
+	personID *int32
+}
+
+// PersonRepositoryMockGetEmploymentsResults contains results of the PersonRepository.GetEmployments
+type PersonRepositoryMockGetEmploymentsResults struct {
+	ea1 []models.Employment
+	err error
+}
+
+// PersonRepositoryMockGetEmploymentsOrigins contains origins of expectations of the PersonRepository.GetEmployments
+type PersonRepositoryMockGetEmploymentsExpectationOrigins struct {
+	origin         string
+	originPersonID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetEmployments *mPersonRepositoryMockGetEmployments) Optional() *mPersonRepositoryMockGetEmployments {
+	mmGetEmployments.optional = true
+	return mmGetEmployments
+}
+
+// Expect sets up expected params for PersonRepository.GetEmployments
+func (mmGetEmployments *mPersonRepositoryMockGetEmployments) Expect(personID int32) *mPersonRepositoryMockGetEmployments {
+	if mmGetEmployments.mock.funcGetEmployments != nil {
+		mmGetEmployments.mock.t.Fatalf("PersonRepositoryMock.GetEmployments mock is already set by Set")
+	}
+
+	if mmGetEmployments.defaultExpectation == nil {
+		mmGetEmployments.defaultExpectation = &PersonRepositoryMockGetEmploymentsExpectation{}
+	}
+
+	if mmGetEmployments.defaultExpectation.paramPtrs != nil {
+		mmGetEmployments.mock.t.Fatalf("PersonRepositoryMock.GetEmployments mock is already set by ExpectParams functions")
+	}
+
+	mmGetEmployments.defaultExpectation.params = &PersonRepositoryMockGetEmploymentsParams{personID}
+	mmGetEmployments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetEmployments.expectations {
+		if minimock.Equal(e.params, mmGetEmployments.defaultExpectation.params) {
+			mmGetEmployments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetEmployments.defaultExpectation.params)
+		}
+	}
+
+	return mmGetEmployments
+}
+
+// ExpectPersonIDParam1 sets up expected param personID for PersonRepository.GetEmployments
+func (mmGetEmployments *mPersonRepositoryMockGetEmployments) ExpectPersonIDParam1(personID int32) *mPersonRepositoryMockGetEmployments {
+	if mmGetEmployments.mock.funcGetEmployments != nil {
+		mmGetEmployments.mock.t.Fatalf("PersonRepositoryMock.GetEmployments mock is already set by Set")
+	}
+
+	if mmGetEmployments.defaultExpectation == nil {
+		mmGetEmployments.defaultExpectation = &PersonRepositoryMockGetEmploymentsExpectation{}
+	}
+
+	if mmGetEmployments.defaultExpectation.params != nil {
+		mmGetEmployments.mock.t.Fatalf("PersonRepositoryMock.GetEmployments mock is already set by Expect")
+	}
+
+	if mmGetEmployments.defaultExpectation.paramPtrs == nil {
+		mmGetEmployments.defaultExpectation.paramPtrs = &PersonRepositoryMockGetEmploymentsParamPtrs{}
+	}
+	mmGetEmployments.defaultExpectation.paramPtrs.personID = &personID
+	mmGetEmployments.defaultExpectation.expectationOrigins.originPersonID = minimock.CallerInfo(1)
+
+	return mmGetEmployments
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.GetEmployments
+func (mmGetEmployments *mPersonRepositoryMockGetEmployments) Inspect(f func(personID int32)) *mPersonRepositoryMockGetEmployments {
+	if mmGetEmployments.mock.inspectFuncGetEmployments != nil {
+		mmGetEmployments.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetEmployments")
+	}
+
+	mmGetEmployments.mock.inspectFuncGetEmployments = f
+
+	return mmGetEmployments
+}
+
+// Return sets up results that will be returned by PersonRepository.GetEmployments
+func (mmGetEmployments *mPersonRepositoryMockGetEmployments) Return(ea1 []models.Employment, err error) *PersonRepositoryMock {
+	if mmGetEmployments.mock.funcGetEmployments != nil {
+		mmGetEmployments.mock.t.Fatalf("PersonRepositoryMock.GetEmployments mock is already set by Set")
+	}
+
+	if mmGetEmployments.defaultExpectation == nil {
+		mmGetEmployments.defaultExpectation = &PersonRepositoryMockGetEmploymentsExpectation{mock: mmGetEmployments.mock}
+	}
+	mmGetEmployments.defaultExpectation.results = &PersonRepositoryMockGetEmploymentsResults{ea1, err}
+	mmGetEmployments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetEmployments.mock
+}
+
+// Set uses given function f to mock the PersonRepository.GetEmployments method
+func (mmGetEmployments *mPersonRepositoryMockGetEmployments) Set(f func(personID int32) (ea1 []models.Employment, err error)) *PersonRepositoryMock {
+	if mmGetEmployments.defaultExpectation != nil {
+		mmGetEmployments.mock.t.Fatalf("Default expectation is already set for the PersonRepository.GetEmployments method")
+	}
+
+	if len(mmGetEmployments.expectations) > 0 {
+		mmGetEmployments.mock.t.Fatalf("Some expectations are already set for the PersonRepository.GetEmployments method")
+	}
+
+	mmGetEmployments.mock.funcGetEmployments = f
+	mmGetEmployments.mock.funcGetEmploymentsOrigin = minimock.CallerInfo(1)
+	return mmGetEmployments.mock
+}
+
+// When sets expectation for the PersonRepository.GetEmployments which will trigger the result defined by the following
+// Then helper
+func (mmGetEmployments *mPersonRepositoryMockGetEmployments) When(personID int32) *PersonRepositoryMockGetEmploymentsExpectation {
+	if mmGetEmployments.mock.funcGetEmployments != nil {
+		mmGetEmployments.mock.t.Fatalf("PersonRepositoryMock.GetEmployments mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockGetEmploymentsExpectation{
+		mock:               mmGetEmployments.mock,
+		params:             &PersonRepositoryMockGetEmploymentsParams{personID},
+		expectationOrigins: PersonRepositoryMockGetEmploymentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetEmployments.expectations = append(mmGetEmployments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.GetEmployments return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockGetEmploymentsExpectation) Then(ea1 []models.Employment, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockGetEmploymentsResults{ea1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.GetEmployments should be invoked
+func (mmGetEmployments *mPersonRepositoryMockGetEmployments) Times(n uint64) *mPersonRepositoryMockGetEmployments {
+	if n == 0 {
+		mmGetEmployments.mock.t.Fatalf("Times of PersonRepositoryMock.GetEmployments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetEmployments.expectedInvocations, n)
+	mmGetEmployments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetEmployments
+}
+
+func (mmGetEmployments *mPersonRepositoryMockGetEmployments) invocationsDone() bool {
+	if len(mmGetEmployments.expectations) == 0 && mmGetEmployments.defaultExpectation == nil && mmGetEmployments.mock.funcGetEmployments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetEmployments.mock.afterGetEmploymentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetEmployments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetEmployments implements PersonRepository
+func (mmGetEmployments *PersonRepositoryMock) GetEmployments(personID int32) (ea1 []models.Employment, err error) {
+	mm_atomic.AddUint64(&mmGetEmployments.beforeGetEmploymentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetEmployments.afterGetEmploymentsCounter, 1)
+
+	mmGetEmployments.t.Helper()
+
+	if mmGetEmployments.inspectFuncGetEmployments != nil {
+		mmGetEmployments.inspectFuncGetEmployments(personID)
+	}
+
+	mm_params := PersonRepositoryMockGetEmploymentsParams{personID}
+
+	// Record call args
+	mmGetEmployments.GetEmploymentsMock.mutex.Lock()
+	mmGetEmployments.GetEmploymentsMock.callArgs = append(mmGetEmployments.GetEmploymentsMock.callArgs, &mm_params)
+	mmGetEmployments.GetEmploymentsMock.mutex.Unlock()
+
+	for _, e := range mmGetEmployments.GetEmploymentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ea1, e.results.err
+		}
+	}
+
+	if mmGetEmployments.GetEmploymentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetEmployments.GetEmploymentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetEmployments.GetEmploymentsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetEmployments.GetEmploymentsMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockGetEmploymentsParams{personID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.personID != nil && !minimock.Equal(*mm_want_ptrs.personID, mm_got.personID) {
+				mmGetEmployments.t.Errorf("PersonRepositoryMock.GetEmployments got unexpected parameter personID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetEmployments.GetEmploymentsMock.defaultExpectation.expectationOrigins.originPersonID, *mm_want_ptrs.personID, mm_got.personID, minimock.Diff(*mm_want_ptrs.personID, mm_got.personID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetEmployments.t.Errorf("PersonRepositoryMock.GetEmployments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetEmployments.GetEmploymentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetEmployments.GetEmploymentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetEmployments.t.Fatal("No results are set for the PersonRepositoryMock.GetEmployments")
+		}
+		return (*mm_results).ea1, (*mm_results).err
+	}
+	if mmGetEmployments.funcGetEmployments != nil {
+		return mmGetEmployments.funcGetEmployments(personID)
+	}
+	mmGetEmployments.t.Fatalf("Unexpected call to PersonRepositoryMock.GetEmployments. %v", personID)
+	return
+}
+
+// GetEmploymentsAfterCounter returns a count of finished PersonRepositoryMock.GetEmployments invocations
+func (mmGetEmployments *PersonRepositoryMock) GetEmploymentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEmployments.afterGetEmploymentsCounter)
+}
+
+// GetEmploymentsBeforeCounter returns a count of PersonRepositoryMock.GetEmployments invocations
+func (mmGetEmployments *PersonRepositoryMock) GetEmploymentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEmployments.beforeGetEmploymentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.GetEmployments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetEmployments *mPersonRepositoryMockGetEmployments) Calls() []*PersonRepositoryMockGetEmploymentsParams {
+	mmGetEmployments.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockGetEmploymentsParams, len(mmGetEmployments.callArgs))
+	copy(argCopy, mmGetEmployments.callArgs)
+
+	mmGetEmployments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetEmploymentsDone returns true if the count of the GetEmployments invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockGetEmploymentsDone() bool {
+	if m.GetEmploymentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetEmploymentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetEmploymentsMock.invocationsDone()
+}
+
+// MinimockGetEmploymentsInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockGetEmploymentsInspect() {
+	for _, e := range m.GetEmploymentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetEmployments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetEmploymentsCounter := mm_atomic.LoadUint64(&m.afterGetEmploymentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetEmploymentsMock.defaultExpectation != nil && afterGetEmploymentsCounter < 1 {
+		if m.GetEmploymentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetEmployments at\n%s", m.GetEmploymentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetEmployments at\n%s with params: %#v", m.GetEmploymentsMock.defaultExpectation.expectationOrigins.origin, *m.GetEmploymentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetEmployments != nil && afterGetEmploymentsCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetEmployments at\n%s", m.funcGetEmploymentsOrigin)
+	}
+
+	if !m.GetEmploymentsMock.invocationsDone() && afterGetEmploymentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetEmployments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetEmploymentsMock.expectedInvocations), m.GetEmploymentsMock.expectedInvocationsOrigin, afterGetEmploymentsCounter)
+	}
+}
+
+type mPersonRepositoryMockGetMergedPersonID struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockGetMergedPersonIDExpectation
+	expectations       []*PersonRepositoryMockGetMergedPersonIDExpectation
+
+	callArgs []*PersonRepositoryMockGetMergedPersonIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockGetMergedPersonIDExpectation specifies expectation struct of the PersonRepository.GetMergedPersonID
+type PersonRepositoryMockGetMergedPersonIDExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockGetMergedPersonIDParams
+	paramPtrs          *PersonRepositoryMockGetMergedPersonIDParamPtrs
+	expectationOrigins PersonRepositoryMockGetMergedPersonIDExpectationOrigins
+	results            *PersonRepositoryMockGetMergedPersonIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockGetMergedPersonIDParams contains parameters of the PersonRepository.GetMergedPersonID
+type PersonRepositoryMockGetMergedPersonIDParams struct {
+	id int32
+}
+
+// PersonRepositoryMockGetMergedPersonIDParamPtrs contains pointers to parameters of the PersonRepository.GetMergedPersonID
+type PersonRepositoryMockGetMergedPersonIDParamPtrs struct {
+	id *int32
+}
+
+// PersonRepositoryMockGetMergedPersonIDResults contains results of the PersonRepository.GetMergedPersonID
+type PersonRepositoryMockGetMergedPersonIDResults struct {
+	i1  int32
+	err error
+}
+
+// PersonRepositoryMockGetMergedPersonIDOrigins contains origins of expectations of the PersonRepository.GetMergedPersonID
+type PersonRepositoryMockGetMergedPersonIDExpectationOrigins struct {
+	origin   string
+	originId string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMergedPersonID *mPersonRepositoryMockGetMergedPersonID) Optional() *mPersonRepositoryMockGetMergedPersonID {
+	mmGetMergedPersonID.optional = true
+	return mmGetMergedPersonID
+}
+
+// Expect sets up expected params for PersonRepository.GetMergedPersonID
+func (mmGetMergedPersonID *mPersonRepositoryMockGetMergedPersonID) Expect(id int32) *mPersonRepositoryMockGetMergedPersonID {
+	if mmGetMergedPersonID.mock.funcGetMergedPersonID != nil {
+		mmGetMergedPersonID.mock.t.Fatalf("PersonRepositoryMock.GetMergedPersonID mock is already set by Set")
+	}
+
+	if mmGetMergedPersonID.defaultExpectation == nil {
+		mmGetMergedPersonID.defaultExpectation = &PersonRepositoryMockGetMergedPersonIDExpectation{}
+	}
+
+	if mmGetMergedPersonID.defaultExpectation.paramPtrs != nil {
+		mmGetMergedPersonID.mock.t.Fatalf("PersonRepositoryMock.GetMergedPersonID mock is already set by ExpectParams functions")
+	}
+
+	mmGetMergedPersonID.defaultExpectation.params = &PersonRepositoryMockGetMergedPersonIDParams{id}
+	mmGetMergedPersonID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMergedPersonID.expectations {
+		if minimock.Equal(e.params, mmGetMergedPersonID.defaultExpectation.params) {
+			mmGetMergedPersonID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMergedPersonID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMergedPersonID
+}
+
+// ExpectIdParam1 sets up expected param id for PersonRepository.GetMergedPersonID
+func (mmGetMergedPersonID *mPersonRepositoryMockGetMergedPersonID) ExpectIdParam1(id int32) *mPersonRepositoryMockGetMergedPersonID {
+	if mmGetMergedPersonID.mock.funcGetMergedPersonID != nil {
+		mmGetMergedPersonID.mock.t.Fatalf("PersonRepositoryMock.GetMergedPersonID mock is already set by Set")
+	}
+
+	if mmGetMergedPersonID.defaultExpectation == nil {
+		mmGetMergedPersonID.defaultExpectation = &PersonRepositoryMockGetMergedPersonIDExpectation{}
+	}
+
+	if mmGetMergedPersonID.defaultExpectation.params != nil {
+		mmGetMergedPersonID.mock.t.Fatalf("PersonRepositoryMock.GetMergedPersonID mock is already set by Expect")
+	}
+
+	if mmGetMergedPersonID.defaultExpectation.paramPtrs == nil {
+		mmGetMergedPersonID.defaultExpectation.paramPtrs = &PersonRepositoryMockGetMergedPersonIDParamPtrs{}
+	}
+	mmGetMergedPersonID.defaultExpectation.paramPtrs.id = &id
+	mmGetMergedPersonID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetMergedPersonID
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.GetMergedPersonID
+func (mmGetMergedPersonID *mPersonRepositoryMockGetMergedPersonID) Inspect(f func(id int32)) *mPersonRepositoryMockGetMergedPersonID {
+	if mmGetMergedPersonID.mock.inspectFuncGetMergedPersonID != nil {
+		mmGetMergedPersonID.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetMergedPersonID")
+	}
+
+	mmGetMergedPersonID.mock.inspectFuncGetMergedPersonID = f
+
+	return mmGetMergedPersonID
+}
+
+// Return sets up results that will be returned by PersonRepository.GetMergedPersonID
+func (mmGetMergedPersonID *mPersonRepositoryMockGetMergedPersonID) Return(i1 int32, err error) *PersonRepositoryMock {
+	if mmGetMergedPersonID.mock.funcGetMergedPersonID != nil {
+		mmGetMergedPersonID.mock.t.Fatalf("PersonRepositoryMock.GetMergedPersonID mock is already set by Set")
+	}
+
+	if mmGetMergedPersonID.defaultExpectation == nil {
+		mmGetMergedPersonID.defaultExpectation = &PersonRepositoryMockGetMergedPersonIDExpectation{mock: mmGetMergedPersonID.mock}
+	}
+	mmGetMergedPersonID.defaultExpectation.results = &PersonRepositoryMockGetMergedPersonIDResults{i1, err}
+	mmGetMergedPersonID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMergedPersonID.mock
+}
+
+// Set uses given function f to mock the PersonRepository.GetMergedPersonID method
+func (mmGetMergedPersonID *mPersonRepositoryMockGetMergedPersonID) Set(f func(id int32) (i1 int32, err error)) *PersonRepositoryMock {
+	if mmGetMergedPersonID.defaultExpectation != nil {
+		mmGetMergedPersonID.mock.t.Fatalf("Default expectation is already set for the PersonRepository.GetMergedPersonID method")
+	}
+
+	if len(mmGetMergedPersonID.expectations) > 0 {
+		mmGetMergedPersonID.mock.t.Fatalf("Some expectations are already set for the PersonRepository.GetMergedPersonID method")
+	}
+
+	mmGetMergedPersonID.mock.funcGetMergedPersonID = f
+	mmGetMergedPersonID.mock.funcGetMergedPersonIDOrigin = minimock.CallerInfo(1)
+	return mmGetMergedPersonID.mock
+}
+
+// When sets expectation for the PersonRepository.GetMergedPersonID which will trigger the result defined by the following
+// Then helper
+func (mmGetMergedPersonID *mPersonRepositoryMockGetMergedPersonID) When(id int32) *PersonRepositoryMockGetMergedPersonIDExpectation {
+	if mmGetMergedPersonID.mock.funcGetMergedPersonID != nil {
+		mmGetMergedPersonID.mock.t.Fatalf("PersonRepositoryMock.GetMergedPersonID mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockGetMergedPersonIDExpectation{
+		mock:               mmGetMergedPersonID.mock,
+		params:             &PersonRepositoryMockGetMergedPersonIDParams{id},
+		expectationOrigins: PersonRepositoryMockGetMergedPersonIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMergedPersonID.expectations = append(mmGetMergedPersonID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.GetMergedPersonID return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockGetMergedPersonIDExpectation) Then(i1 int32, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockGetMergedPersonIDResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.GetMergedPersonID should be invoked
+func (mmGetMergedPersonID *mPersonRepositoryMockGetMergedPersonID) Times(n uint64) *mPersonRepositoryMockGetMergedPersonID {
+	if n == 0 {
+		mmGetMergedPersonID.mock.t.Fatalf("Times of PersonRepositoryMock.GetMergedPersonID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMergedPersonID.expectedInvocations, n)
+	mmGetMergedPersonID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMergedPersonID
+}
+
+func (mmGetMergedPersonID *mPersonRepositoryMockGetMergedPersonID) invocationsDone() bool {
+	if len(mmGetMergedPersonID.expectations) == 0 && mmGetMergedPersonID.defaultExpectation == nil && mmGetMergedPersonID.mock.funcGetMergedPersonID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMergedPersonID.mock.afterGetMergedPersonIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMergedPersonID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMergedPersonID implements PersonRepository
+func (mmGetMergedPersonID *PersonRepositoryMock) GetMergedPersonID(id int32) (i1 int32, err error) {
+	mm_atomic.AddUint64(&mmGetMergedPersonID.beforeGetMergedPersonIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMergedPersonID.afterGetMergedPersonIDCounter, 1)
+
+	mmGetMergedPersonID.t.Helper()
+
+	if mmGetMergedPersonID.inspectFuncGetMergedPersonID != nil {
+		mmGetMergedPersonID.inspectFuncGetMergedPersonID(id)
+	}
+
+	mm_params := PersonRepositoryMockGetMergedPersonIDParams{id}
+
+	// Record call args
+	mmGetMergedPersonID.GetMergedPersonIDMock.mutex.Lock()
+	mmGetMergedPersonID.GetMergedPersonIDMock.callArgs = append(mmGetMergedPersonID.GetMergedPersonIDMock.callArgs, &mm_params)
+	mmGetMergedPersonID.GetMergedPersonIDMock.mutex.Unlock()
+
+	for _, e := range mmGetMergedPersonID.GetMergedPersonIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetMergedPersonID.GetMergedPersonIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMergedPersonID.GetMergedPersonIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMergedPersonID.GetMergedPersonIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMergedPersonID.GetMergedPersonIDMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockGetMergedPersonIDParams{id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetMergedPersonID.t.Errorf("PersonRepositoryMock.GetMergedPersonID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMergedPersonID.GetMergedPersonIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMergedPersonID.t.Errorf("PersonRepositoryMock.GetMergedPersonID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMergedPersonID.GetMergedPersonIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMergedPersonID.GetMergedPersonIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMergedPersonID.t.Fatal("No results are set for the PersonRepositoryMock.GetMergedPersonID")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetMergedPersonID.funcGetMergedPersonID != nil {
+		return mmGetMergedPersonID.funcGetMergedPersonID(id)
+	}
+	mmGetMergedPersonID.t.Fatalf("Unexpected call to PersonRepositoryMock.GetMergedPersonID. %v", id)
+	return
+}
+
+// GetMergedPersonIDAfterCounter returns a count of finished PersonRepositoryMock.GetMergedPersonID invocations
+func (mmGetMergedPersonID *PersonRepositoryMock) GetMergedPersonIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMergedPersonID.afterGetMergedPersonIDCounter)
+}
+
+// GetMergedPersonIDBeforeCounter returns a count of PersonRepositoryMock.GetMergedPersonID invocations
+func (mmGetMergedPersonID *PersonRepositoryMock) GetMergedPersonIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMergedPersonID.beforeGetMergedPersonIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.GetMergedPersonID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMergedPersonID *mPersonRepositoryMockGetMergedPersonID) Calls() []*PersonRepositoryMockGetMergedPersonIDParams {
+	mmGetMergedPersonID.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockGetMergedPersonIDParams, len(mmGetMergedPersonID.callArgs))
+	copy(argCopy, mmGetMergedPersonID.callArgs)
+
+	mmGetMergedPersonID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMergedPersonIDDone returns true if the count of the GetMergedPersonID invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockGetMergedPersonIDDone() bool {
+	if m.GetMergedPersonIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMergedPersonIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMergedPersonIDMock.invocationsDone()
+}
+
+// MinimockGetMergedPersonIDInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockGetMergedPersonIDInspect() {
+	for _, e := range m.GetMergedPersonIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetMergedPersonID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMergedPersonIDCounter := mm_atomic.LoadUint64(&m.afterGetMergedPersonIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMergedPersonIDMock.defaultExpectation != nil && afterGetMergedPersonIDCounter < 1 {
+		if m.GetMergedPersonIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetMergedPersonID at\n%s", m.GetMergedPersonIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetMergedPersonID at\n%s with params: %#v", m.GetMergedPersonIDMock.defaultExpectation.expectationOrigins.origin, *m.GetMergedPersonIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMergedPersonID != nil && afterGetMergedPersonIDCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetMergedPersonID at\n%s", m.funcGetMergedPersonIDOrigin)
+	}
+
+	if !m.GetMergedPersonIDMock.invocationsDone() && afterGetMergedPersonIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetMergedPersonID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMergedPersonIDMock.expectedInvocations), m.GetMergedPersonIDMock.expectedInvocationsOrigin, afterGetMergedPersonIDCounter)
+	}
+}
+
+type mPersonRepositoryMockGetOrganizationByID struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockGetOrganizationByIDExpectation
+	expectations       []*PersonRepositoryMockGetOrganizationByIDExpectation
+
+	callArgs []*PersonRepositoryMockGetOrganizationByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockGetOrganizationByIDExpectation specifies expectation struct of the PersonRepository.GetOrganizationByID
+type PersonRepositoryMockGetOrganizationByIDExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockGetOrganizationByIDParams
+	paramPtrs          *PersonRepositoryMockGetOrganizationByIDParamPtrs
+	expectationOrigins PersonRepositoryMockGetOrganizationByIDExpectationOrigins
+	results            *PersonRepositoryMockGetOrganizationByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockGetOrganizationByIDParams contains parameters of the PersonRepository.GetOrganizationByID
+type PersonRepositoryMockGetOrganizationByIDParams struct {
+	id int32
+}
+
+// PersonRepositoryMockGetOrganizationByIDParamPtrs contains pointers to parameters of the PersonRepository.GetOrganizationByID
+type PersonRepositoryMockGetOrganizationByIDParamPtrs struct {
+	id *int32
+}
+
+// PersonRepositoryMockGetOrganizationByIDResults contains results of the PersonRepository.GetOrganizationByID
+type PersonRepositoryMockGetOrganizationByIDResults struct {
+	o1  models.Organization
+	err error
+}
+
+// PersonRepositoryMockGetOrganizationByIDOrigins contains origins of expectations of the PersonRepository.GetOrganizationByID
+type PersonRepositoryMockGetOrganizationByIDExpectationOrigins struct {
+	origin   string
+	originId string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrganizationByID *mPersonRepositoryMockGetOrganizationByID) Optional() *mPersonRepositoryMockGetOrganizationByID {
+	mmGetOrganizationByID.optional = true
+	return mmGetOrganizationByID
+}
+
+// Expect sets up expected params for PersonRepository.GetOrganizationByID
+func (mmGetOrganizationByID *mPersonRepositoryMockGetOrganizationByID) Expect(id int32) *mPersonRepositoryMockGetOrganizationByID {
+	if mmGetOrganizationByID.mock.funcGetOrganizationByID != nil {
+		mmGetOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationByID mock is already set by Set")
+	}
+
+	if mmGetOrganizationByID.defaultExpectation == nil {
+		mmGetOrganizationByID.defaultExpectation = &PersonRepositoryMockGetOrganizationByIDExpectation{}
+	}
+
+	if mmGetOrganizationByID.defaultExpectation.paramPtrs != nil {
+		mmGetOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrganizationByID.defaultExpectation.params = &PersonRepositoryMockGetOrganizationByIDParams{id}
+	mmGetOrganizationByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrganizationByID.expectations {
+		if minimock.Equal(e.params, mmGetOrganizationByID.defaultExpectation.params) {
+			mmGetOrganizationByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrganizationByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrganizationByID
+}
+
+// ExpectIdParam1 sets up expected param id for PersonRepository.GetOrganizationByID
+func (mmGetOrganizationByID *mPersonRepositoryMockGetOrganizationByID) ExpectIdParam1(id int32) *mPersonRepositoryMockGetOrganizationByID {
+	if mmGetOrganizationByID.mock.funcGetOrganizationByID != nil {
+		mmGetOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationByID mock is already set by Set")
+	}
+
+	if mmGetOrganizationByID.defaultExpectation == nil {
+		mmGetOrganizationByID.defaultExpectation = &PersonRepositoryMockGetOrganizationByIDExpectation{}
+	}
+
+	if mmGetOrganizationByID.defaultExpectation.params != nil {
+		mmGetOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationByID mock is already set by Expect")
+	}
+
+	if mmGetOrganizationByID.defaultExpectation.paramPtrs == nil {
+		mmGetOrganizationByID.defaultExpectation.paramPtrs = &PersonRepositoryMockGetOrganizationByIDParamPtrs{}
+	}
+	mmGetOrganizationByID.defaultExpectation.paramPtrs.id = &id
+	mmGetOrganizationByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetOrganizationByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.GetOrganizationByID
+func (mmGetOrganizationByID *mPersonRepositoryMockGetOrganizationByID) Inspect(f func(id int32)) *mPersonRepositoryMockGetOrganizationByID {
+	if mmGetOrganizationByID.mock.inspectFuncGetOrganizationByID != nil {
+		mmGetOrganizationByID.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetOrganizationByID")
+	}
+
+	mmGetOrganizationByID.mock.inspectFuncGetOrganizationByID = f
+
+	return mmGetOrganizationByID
+}
+
+// Return sets up results that will be returned by PersonRepository.GetOrganizationByID
+func (mmGetOrganizationByID *mPersonRepositoryMockGetOrganizationByID) Return(o1 models.Organization, err error) *PersonRepositoryMock {
+	if mmGetOrganizationByID.mock.funcGetOrganizationByID != nil {
+		mmGetOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationByID mock is already set by Set")
+	}
+
+	if mmGetOrganizationByID.defaultExpectation == nil {
+		mmGetOrganizationByID.defaultExpectation = &PersonRepositoryMockGetOrganizationByIDExpectation{mock: mmGetOrganizationByID.mock}
+	}
+	mmGetOrganizationByID.defaultExpectation.results = &PersonRepositoryMockGetOrganizationByIDResults{o1, err}
+	mmGetOrganizationByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrganizationByID.mock
+}
+
+// Set uses given function f to mock the PersonRepository.GetOrganizationByID method
+func (mmGetOrganizationByID *mPersonRepositoryMockGetOrganizationByID) Set(f func(id int32) (o1 models.Organization, err error)) *PersonRepositoryMock {
+	if mmGetOrganizationByID.defaultExpectation != nil {
+		mmGetOrganizationByID.mock.t.Fatalf("Default expectation is already set for the PersonRepository.GetOrganizationByID method")
+	}
+
+	if len(mmGetOrganizationByID.expectations) > 0 {
+		mmGetOrganizationByID.mock.t.Fatalf("Some expectations are already set for the PersonRepository.GetOrganizationByID method")
+	}
+
+	mmGetOrganizationByID.mock.funcGetOrganizationByID = f
+	mmGetOrganizationByID.mock.funcGetOrganizationByIDOrigin = minimock.CallerInfo(1)
+	return mmGetOrganizationByID.mock
+}
+
+// When sets expectation for the PersonRepository.GetOrganizationByID which will trigger the result defined by the following
+// Then helper
+func (mmGetOrganizationByID *mPersonRepositoryMockGetOrganizationByID) When(id int32) *PersonRepositoryMockGetOrganizationByIDExpectation {
+	if mmGetOrganizationByID.mock.funcGetOrganizationByID != nil {
+		mmGetOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationByID mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockGetOrganizationByIDExpectation{
+		mock:               mmGetOrganizationByID.mock,
+		params:             &PersonRepositoryMockGetOrganizationByIDParams{id},
+		expectationOrigins: PersonRepositoryMockGetOrganizationByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrganizationByID.expectations = append(mmGetOrganizationByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.GetOrganizationByID return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockGetOrganizationByIDExpectation) Then(o1 models.Organization, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockGetOrganizationByIDResults{o1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.GetOrganizationByID should be invoked
+func (mmGetOrganizationByID *mPersonRepositoryMockGetOrganizationByID) Times(n uint64) *mPersonRepositoryMockGetOrganizationByID {
+	if n == 0 {
+		mmGetOrganizationByID.mock.t.Fatalf("Times of PersonRepositoryMock.GetOrganizationByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrganizationByID.expectedInvocations, n)
+	mmGetOrganizationByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrganizationByID
+}
+
+func (mmGetOrganizationByID *mPersonRepositoryMockGetOrganizationByID) invocationsDone() bool {
+	if len(mmGetOrganizationByID.expectations) == 0 && mmGetOrganizationByID.defaultExpectation == nil && mmGetOrganizationByID.mock.funcGetOrganizationByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrganizationByID.mock.afterGetOrganizationByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrganizationByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrganizationByID implements PersonRepository
+func (mmGetOrganizationByID *PersonRepositoryMock) GetOrganizationByID(id int32) (o1 models.Organization, err error) {
+	mm_atomic.AddUint64(&mmGetOrganizationByID.beforeGetOrganizationByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrganizationByID.afterGetOrganizationByIDCounter, 1)
+
+	mmGetOrganizationByID.t.Helper()
+
+	if mmGetOrganizationByID.inspectFuncGetOrganizationByID != nil {
+		mmGetOrganizationByID.inspectFuncGetOrganizationByID(id)
+	}
+
+	mm_params := PersonRepositoryMockGetOrganizationByIDParams{id}
+
+	// Record call args
+	mmGetOrganizationByID.GetOrganizationByIDMock.mutex.Lock()
+	mmGetOrganizationByID.GetOrganizationByIDMock.callArgs = append(mmGetOrganizationByID.GetOrganizationByIDMock.callArgs, &mm_params)
+	mmGetOrganizationByID.GetOrganizationByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetOrganizationByID.GetOrganizationByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.o1, e.results.err
+		}
+	}
+
+	if mmGetOrganizationByID.GetOrganizationByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrganizationByID.GetOrganizationByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrganizationByID.GetOrganizationByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrganizationByID.GetOrganizationByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockGetOrganizationByIDParams{id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetOrganizationByID.t.Errorf("PersonRepositoryMock.GetOrganizationByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrganizationByID.GetOrganizationByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrganizationByID.t.Errorf("PersonRepositoryMock.GetOrganizationByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrganizationByID.GetOrganizationByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrganizationByID.GetOrganizationByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrganizationByID.t.Fatal("No results are set for the PersonRepositoryMock.GetOrganizationByID")
+		}
+		return (*mm_results).o1, (*mm_results).err
+	}
+	if mmGetOrganizationByID.funcGetOrganizationByID != nil {
+		return mmGetOrganizationByID.funcGetOrganizationByID(id)
+	}
+	mmGetOrganizationByID.t.Fatalf("Unexpected call to PersonRepositoryMock.GetOrganizationByID. %v", id)
+	return
+}
+
+// GetOrganizationByIDAfterCounter returns a count of finished PersonRepositoryMock.GetOrganizationByID invocations
+func (mmGetOrganizationByID *PersonRepositoryMock) GetOrganizationByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrganizationByID.afterGetOrganizationByIDCounter)
+}
+
+// GetOrganizationByIDBeforeCounter returns a count of PersonRepositoryMock.GetOrganizationByID invocations
+func (mmGetOrganizationByID *PersonRepositoryMock) GetOrganizationByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrganizationByID.beforeGetOrganizationByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.GetOrganizationByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrganizationByID *mPersonRepositoryMockGetOrganizationByID) Calls() []*PersonRepositoryMockGetOrganizationByIDParams {
+	mmGetOrganizationByID.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockGetOrganizationByIDParams, len(mmGetOrganizationByID.callArgs))
+	copy(argCopy, mmGetOrganizationByID.callArgs)
+
+	mmGetOrganizationByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrganizationByIDDone returns true if the count of the GetOrganizationByID invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockGetOrganizationByIDDone() bool {
+	if m.GetOrganizationByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrganizationByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrganizationByIDMock.invocationsDone()
+}
+
+// MinimockGetOrganizationByIDInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockGetOrganizationByIDInspect() {
+	for _, e := range m.GetOrganizationByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetOrganizationByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrganizationByIDCounter := mm_atomic.LoadUint64(&m.afterGetOrganizationByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrganizationByIDMock.defaultExpectation != nil && afterGetOrganizationByIDCounter < 1 {
+		if m.GetOrganizationByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetOrganizationByID at\n%s", m.GetOrganizationByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetOrganizationByID at\n%s with params: %#v", m.GetOrganizationByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetOrganizationByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrganizationByID != nil && afterGetOrganizationByIDCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetOrganizationByID at\n%s", m.funcGetOrganizationByIDOrigin)
+	}
+
+	if !m.GetOrganizationByIDMock.invocationsDone() && afterGetOrganizationByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetOrganizationByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrganizationByIDMock.expectedInvocations), m.GetOrganizationByIDMock.expectedInvocationsOrigin, afterGetOrganizationByIDCounter)
+	}
+}
+
+type mPersonRepositoryMockGetOrganizationEmployments struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockGetOrganizationEmploymentsExpectation
+	expectations       []*PersonRepositoryMockGetOrganizationEmploymentsExpectation
+
+	callArgs []*PersonRepositoryMockGetOrganizationEmploymentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockGetOrganizationEmploymentsExpectation specifies expectation struct of the PersonRepository.GetOrganizationEmployments
+type PersonRepositoryMockGetOrganizationEmploymentsExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockGetOrganizationEmploymentsParams
+	paramPtrs          *PersonRepositoryMockGetOrganizationEmploymentsParamPtrs
+	expectationOrigins PersonRepositoryMockGetOrganizationEmploymentsExpectationOrigins
+	results            *PersonRepositoryMockGetOrganizationEmploymentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockGetOrganizationEmploymentsParams contains parameters of the PersonRepository.GetOrganizationEmployments
+type PersonRepositoryMockGetOrganizationEmploymentsParams struct {
+	organizationID int32
+}
+
+// PersonRepositoryMockGetOrganizationEmploymentsParamPtrs contains pointers to parameters of the PersonRepository.GetOrganizationEmployments
+type PersonRepositoryMockGetOrganizationEmploymentsParamPtrs struct {
+	organizationID *int32
+}
+
+// PersonRepositoryMockGetOrganizationEmploymentsResults contains results of the PersonRepository.GetOrganizationEmployments
+type PersonRepositoryMockGetOrganizationEmploymentsResults struct {
+	ea1 []models.Employment
+	err error
+}
+
+// PersonRepositoryMockGetOrganizationEmploymentsOrigins contains origins of expectations of the PersonRepository.GetOrganizationEmployments
+type PersonRepositoryMockGetOrganizationEmploymentsExpectationOrigins struct {
+	origin               string
+	originOrganizationID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrganizationEmployments *mPersonRepositoryMockGetOrganizationEmployments) Optional() *mPersonRepositoryMockGetOrganizationEmployments {
+	mmGetOrganizationEmployments.optional = true
+	return mmGetOrganizationEmployments
+}
+
+// Expect sets up expected params for PersonRepository.GetOrganizationEmployments
+func (mmGetOrganizationEmployments *mPersonRepositoryMockGetOrganizationEmployments) Expect(organizationID int32) *mPersonRepositoryMockGetOrganizationEmployments {
+	if mmGetOrganizationEmployments.mock.funcGetOrganizationEmployments != nil {
+		mmGetOrganizationEmployments.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationEmployments mock is already set by Set")
+	}
+
+	if mmGetOrganizationEmployments.defaultExpectation == nil {
+		mmGetOrganizationEmployments.defaultExpectation = &PersonRepositoryMockGetOrganizationEmploymentsExpectation{}
+	}
+
+	if mmGetOrganizationEmployments.defaultExpectation.paramPtrs != nil {
+		mmGetOrganizationEmployments.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationEmployments mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrganizationEmployments.defaultExpectation.params = &PersonRepositoryMockGetOrganizationEmploymentsParams{organizationID}
+	mmGetOrganizationEmployments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrganizationEmployments.expectations {
+		if minimock.Equal(e.params, mmGetOrganizationEmployments.defaultExpectation.params) {
+			mmGetOrganizationEmployments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrganizationEmployments.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrganizationEmployments
+}
+
+// ExpectOrganizationIDParam1 sets up expected param organizationID for PersonRepository.GetOrganizationEmployments
+func (mmGetOrganizationEmployments *mPersonRepositoryMockGetOrganizationEmployments) ExpectOrganizationIDParam1(organizationID int32) *mPersonRepositoryMockGetOrganizationEmployments {
+	if mmGetOrganizationEmployments.mock.funcGetOrganizationEmployments != nil {
+		mmGetOrganizationEmployments.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationEmployments mock is already set by Set")
+	}
+
+	if mmGetOrganizationEmployments.defaultExpectation == nil {
+		mmGetOrganizationEmployments.defaultExpectation = &PersonRepositoryMockGetOrganizationEmploymentsExpectation{}
+	}
+
+	if mmGetOrganizationEmployments.defaultExpectation.params != nil {
+		mmGetOrganizationEmployments.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationEmployments mock is already set by Expect")
+	}
+
+	if mmGetOrganizationEmployments.defaultExpectation.paramPtrs == nil {
+		mmGetOrganizationEmployments.defaultExpectation.paramPtrs = &PersonRepositoryMockGetOrganizationEmploymentsParamPtrs{}
+	}
+	mmGetOrganizationEmployments.defaultExpectation.paramPtrs.organizationID = &organizationID
+	mmGetOrganizationEmployments.defaultExpectation.expectationOrigins.originOrganizationID = minimock.CallerInfo(1)
+
+	return mmGetOrganizationEmployments
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.GetOrganizationEmployments
+func (mmGetOrganizationEmployments *mPersonRepositoryMockGetOrganizationEmployments) Inspect(f func(organizationID int32)) *mPersonRepositoryMockGetOrganizationEmployments {
+	if mmGetOrganizationEmployments.mock.inspectFuncGetOrganizationEmployments != nil {
+		mmGetOrganizationEmployments.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetOrganizationEmployments")
+	}
+
+	mmGetOrganizationEmployments.mock.inspectFuncGetOrganizationEmployments = f
+
+	return mmGetOrganizationEmployments
+}
+
+// Return sets up results that will be returned by PersonRepository.GetOrganizationEmployments
+func (mmGetOrganizationEmployments *mPersonRepositoryMockGetOrganizationEmployments) Return(ea1 []models.Employment, err error) *PersonRepositoryMock {
+	if mmGetOrganizationEmployments.mock.funcGetOrganizationEmployments != nil {
+		mmGetOrganizationEmployments.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationEmployments mock is already set by Set")
+	}
+
+	if mmGetOrganizationEmployments.defaultExpectation == nil {
+		mmGetOrganizationEmployments.defaultExpectation = &PersonRepositoryMockGetOrganizationEmploymentsExpectation{mock: mmGetOrganizationEmployments.mock}
+	}
+	mmGetOrganizationEmployments.defaultExpectation.results = &PersonRepositoryMockGetOrganizationEmploymentsResults{ea1, err}
+	mmGetOrganizationEmployments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrganizationEmployments.mock
+}
+
+// Set uses given function f to mock the PersonRepository.GetOrganizationEmployments method
+func (mmGetOrganizationEmployments *mPersonRepositoryMockGetOrganizationEmployments) Set(f func(organizationID int32) (ea1 []models.Employment, err error)) *PersonRepositoryMock {
+	if mmGetOrganizationEmployments.defaultExpectation != nil {
+		mmGetOrganizationEmployments.mock.t.Fatalf("Default expectation is already set for the PersonRepository.GetOrganizationEmployments method")
+	}
+
+	if len(mmGetOrganizationEmployments.expectations) > 0 {
+		mmGetOrganizationEmployments.mock.t.Fatalf("Some expectations are already set for the PersonRepository.GetOrganizationEmployments method")
+	}
+
+	mmGetOrganizationEmployments.mock.funcGetOrganizationEmployments = f
+	mmGetOrganizationEmployments.mock.funcGetOrganizationEmploymentsOrigin = minimock.CallerInfo(1)
+	return mmGetOrganizationEmployments.mock
+}
+
+// When sets expectation for the PersonRepository.GetOrganizationEmployments which will trigger the result defined by the following
+// Then helper
+func (mmGetOrganizationEmployments *mPersonRepositoryMockGetOrganizationEmployments) When(organizationID int32) *PersonRepositoryMockGetOrganizationEmploymentsExpectation {
+	if mmGetOrganizationEmployments.mock.funcGetOrganizationEmployments != nil {
+		mmGetOrganizationEmployments.mock.t.Fatalf("PersonRepositoryMock.GetOrganizationEmployments mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockGetOrganizationEmploymentsExpectation{
+		mock:               mmGetOrganizationEmployments.mock,
+		params:             &PersonRepositoryMockGetOrganizationEmploymentsParams{organizationID},
+		expectationOrigins: PersonRepositoryMockGetOrganizationEmploymentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrganizationEmployments.expectations = append(mmGetOrganizationEmployments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.GetOrganizationEmployments return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockGetOrganizationEmploymentsExpectation) Then(ea1 []models.Employment, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockGetOrganizationEmploymentsResults{ea1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.GetOrganizationEmployments should be invoked
+func (mmGetOrganizationEmployments *mPersonRepositoryMockGetOrganizationEmployments) Times(n uint64) *mPersonRepositoryMockGetOrganizationEmployments {
+	if n == 0 {
+		mmGetOrganizationEmployments.mock.t.Fatalf("Times of PersonRepositoryMock.GetOrganizationEmployments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrganizationEmployments.expectedInvocations, n)
+	mmGetOrganizationEmployments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrganizationEmployments
+}
+
+func (mmGetOrganizationEmployments *mPersonRepositoryMockGetOrganizationEmployments) invocationsDone() bool {
+	if len(mmGetOrganizationEmployments.expectations) == 0 && mmGetOrganizationEmployments.defaultExpectation == nil && mmGetOrganizationEmployments.mock.funcGetOrganizationEmployments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrganizationEmployments.mock.afterGetOrganizationEmploymentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrganizationEmployments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrganizationEmployments implements PersonRepository
+func (mmGetOrganizationEmployments *PersonRepositoryMock) GetOrganizationEmployments(organizationID int32) (ea1 []models.Employment, err error) {
+	mm_atomic.AddUint64(&mmGetOrganizationEmployments.beforeGetOrganizationEmploymentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrganizationEmployments.afterGetOrganizationEmploymentsCounter, 1)
+
+	mmGetOrganizationEmployments.t.Helper()
+
+	if mmGetOrganizationEmployments.inspectFuncGetOrganizationEmployments != nil {
+		mmGetOrganizationEmployments.inspectFuncGetOrganizationEmployments(organizationID)
+	}
+
+	mm_params := PersonRepositoryMockGetOrganizationEmploymentsParams{organizationID}
+
+	// Record call args
+	mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.mutex.Lock()
+	mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.callArgs = append(mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.callArgs, &mm_params)
+	mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.mutex.Unlock()
+
+	for _, e := range mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ea1, e.results.err
+		}
+	}
+
+	if mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockGetOrganizationEmploymentsParams{organizationID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.organizationID != nil && !minimock.Equal(*mm_want_ptrs.organizationID, mm_got.organizationID) {
+				mmGetOrganizationEmployments.t.Errorf("PersonRepositoryMock.GetOrganizationEmployments got unexpected parameter organizationID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.defaultExpectation.expectationOrigins.originOrganizationID, *mm_want_ptrs.organizationID, mm_got.organizationID, minimock.Diff(*mm_want_ptrs.organizationID, mm_got.organizationID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrganizationEmployments.t.Errorf("PersonRepositoryMock.GetOrganizationEmployments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrganizationEmployments.GetOrganizationEmploymentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrganizationEmployments.t.Fatal("No results are set for the PersonRepositoryMock.GetOrganizationEmployments")
+		}
+		return (*mm_results).ea1, (*mm_results).err
+	}
+	if mmGetOrganizationEmployments.funcGetOrganizationEmployments != nil {
+		return mmGetOrganizationEmployments.funcGetOrganizationEmployments(organizationID)
+	}
+	mmGetOrganizationEmployments.t.Fatalf("Unexpected call to PersonRepositoryMock.GetOrganizationEmployments. %v", organizationID)
+	return
+}
+
+// GetOrganizationEmploymentsAfterCounter returns a count of finished PersonRepositoryMock.GetOrganizationEmployments invocations
+func (mmGetOrganizationEmployments *PersonRepositoryMock) GetOrganizationEmploymentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrganizationEmployments.afterGetOrganizationEmploymentsCounter)
+}
+
+// GetOrganizationEmploymentsBeforeCounter returns a count of PersonRepositoryMock.GetOrganizationEmployments invocations
+func (mmGetOrganizationEmployments *PersonRepositoryMock) GetOrganizationEmploymentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrganizationEmployments.beforeGetOrganizationEmploymentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.GetOrganizationEmployments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrganizationEmployments *mPersonRepositoryMockGetOrganizationEmployments) Calls() []*PersonRepositoryMockGetOrganizationEmploymentsParams {
+	mmGetOrganizationEmployments.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockGetOrganizationEmploymentsParams, len(mmGetOrganizationEmployments.callArgs))
+	copy(argCopy, mmGetOrganizationEmployments.callArgs)
+
+	mmGetOrganizationEmployments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrganizationEmploymentsDone returns true if the count of the GetOrganizationEmployments invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockGetOrganizationEmploymentsDone() bool {
+	if m.GetOrganizationEmploymentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrganizationEmploymentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrganizationEmploymentsMock.invocationsDone()
+}
+
+// MinimockGetOrganizationEmploymentsInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockGetOrganizationEmploymentsInspect() {
+	for _, e := range m.GetOrganizationEmploymentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetOrganizationEmployments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrganizationEmploymentsCounter := mm_atomic.LoadUint64(&m.afterGetOrganizationEmploymentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrganizationEmploymentsMock.defaultExpectation != nil && afterGetOrganizationEmploymentsCounter < 1 {
+		if m.GetOrganizationEmploymentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetOrganizationEmployments at\n%s", m.GetOrganizationEmploymentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetOrganizationEmployments at\n%s with params: %#v", m.GetOrganizationEmploymentsMock.defaultExpectation.expectationOrigins.origin, *m.GetOrganizationEmploymentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrganizationEmployments != nil && afterGetOrganizationEmploymentsCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetOrganizationEmployments at\n%s", m.funcGetOrganizationEmploymentsOrigin)
+	}
+
+	if !m.GetOrganizationEmploymentsMock.invocationsDone() && afterGetOrganizationEmploymentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetOrganizationEmployments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrganizationEmploymentsMock.expectedInvocations), m.GetOrganizationEmploymentsMock.expectedInvocationsOrigin, afterGetOrganizationEmploymentsCounter)
+	}
+}
+
+type mPersonRepositoryMockGetPersonByID struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockGetPersonByIDExpectation
+	expectations       []*PersonRepositoryMockGetPersonByIDExpectation
+
+	callArgs []*PersonRepositoryMockGetPersonByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockGetPersonByIDExpectation specifies expectation struct of the PersonRepository.GetPersonByID
+type PersonRepositoryMockGetPersonByIDExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockGetPersonByIDParams
+	paramPtrs          *PersonRepositoryMockGetPersonByIDParamPtrs
+	expectationOrigins PersonRepositoryMockGetPersonByIDExpectationOrigins
+	results            *PersonRepositoryMockGetPersonByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockGetPersonByIDParams contains parameters of the PersonRepository.GetPersonByID
+type PersonRepositoryMockGetPersonByIDParams struct {
+	id int32
+}
+
+// PersonRepositoryMockGetPersonByIDParamPtrs contains pointers to parameters of the PersonRepository.GetPersonByID
+type PersonRepositoryMockGetPersonByIDParamPtrs struct {
+	id *int32
+}
+
+// PersonRepositoryMockGetPersonByIDResults contains results of the PersonRepository.GetPersonByID
+type PersonRepositoryMockGetPersonByIDResults struct {
+	p1  models.Person
+	err error
+}
+
+// PersonRepositoryMockGetPersonByIDOrigins contains origins of expectations of the PersonRepository.GetPersonByID
+type PersonRepositoryMockGetPersonByIDExpectationOrigins struct {
+	origin   string
+	originId string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Optional() *mPersonRepositoryMockGetPersonByID {
+	mmGetPersonByID.optional = true
+	return mmGetPersonByID
+}
+
+// Expect sets up expected params for PersonRepository.GetPersonByID
+func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Expect(id int32) *mPersonRepositoryMockGetPersonByID {
+	if mmGetPersonByID.mock.funcGetPersonByID != nil {
+		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by Set")
+	}
+
+	if mmGetPersonByID.defaultExpectation == nil {
+		mmGetPersonByID.defaultExpectation = &PersonRepositoryMockGetPersonByIDExpectation{}
+	}
+
+	if mmGetPersonByID.defaultExpectation.paramPtrs != nil {
+		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetPersonByID.defaultExpectation.params = &PersonRepositoryMockGetPersonByIDParams{id}
+	mmGetPersonByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPersonByID.expectations {
+		if minimock.Equal(e.params, mmGetPersonByID.defaultExpectation.params) {
+			mmGetPersonByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPersonByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPersonByID
+}
+
+// ExpectIdParam1 sets up expected param id for PersonRepository.GetPersonByID
+func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) ExpectIdParam1(id int32) *mPersonRepositoryMockGetPersonByID {
+	if mmGetPersonByID.mock.funcGetPersonByID != nil {
+		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by Set")
+	}
+
+	if mmGetPersonByID.defaultExpectation == nil {
+		mmGetPersonByID.defaultExpectation = &PersonRepositoryMockGetPersonByIDExpectation{}
+	}
+
+	if mmGetPersonByID.defaultExpectation.params != nil {
+		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by Expect")
+	}
+
+	if mmGetPersonByID.defaultExpectation.paramPtrs == nil {
+		mmGetPersonByID.defaultExpectation.paramPtrs = &PersonRepositoryMockGetPersonByIDParamPtrs{}
+	}
+	mmGetPersonByID.defaultExpectation.paramPtrs.id = &id
+	mmGetPersonByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetPersonByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.GetPersonByID
+func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Inspect(f func(id int32)) *mPersonRepositoryMockGetPersonByID {
+	if mmGetPersonByID.mock.inspectFuncGetPersonByID != nil {
+		mmGetPersonByID.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetPersonByID")
+	}
+
+	mmGetPersonByID.mock.inspectFuncGetPersonByID = f
+
+	return mmGetPersonByID
+}
+
+// Return sets up results that will be returned by PersonRepository.GetPersonByID
+func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Return(p1 models.Person, err error) *PersonRepositoryMock {
+	if mmGetPersonByID.mock.funcGetPersonByID != nil {
+		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by Set")
+	}
+
+	if mmGetPersonByID.defaultExpectation == nil {
+		mmGetPersonByID.defaultExpectation = &PersonRepositoryMockGetPersonByIDExpectation{mock: mmGetPersonByID.mock}
+	}
+	mmGetPersonByID.defaultExpectation.results = &PersonRepositoryMockGetPersonByIDResults{p1, err}
+	mmGetPersonByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPersonByID.mock
+}
+
+// Set uses given function f to mock the PersonRepository.GetPersonByID method
+func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Set(f func(id int32) (p1 models.Person, err error)) *PersonRepositoryMock {
+	if mmGetPersonByID.defaultExpectation != nil {
+		mmGetPersonByID.mock.t.Fatalf("Default expectation is already set for the PersonRepository.GetPersonByID method")
+	}
+
+	if len(mmGetPersonByID.expectations) > 0 {
+		mmGetPersonByID.mock.t.Fatalf("Some expectations are already set for the PersonRepository.GetPersonByID method")
+	}
+
+	mmGetPersonByID.mock.funcGetPersonByID = f
+	mmGetPersonByID.mock.funcGetPersonByIDOrigin = minimock.CallerInfo(1)
+	return mmGetPersonByID.mock
+}
+
+// When sets expectation for the PersonRepository.GetPersonByID which will trigger the result defined by the following
+// Then helper
+func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) When(id int32) *PersonRepositoryMockGetPersonByIDExpectation {
+	if mmGetPersonByID.mock.funcGetPersonByID != nil {
+		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockGetPersonByIDExpectation{
+		mock:               mmGetPersonByID.mock,
+		params:             &PersonRepositoryMockGetPersonByIDParams{id},
+		expectationOrigins: PersonRepositoryMockGetPersonByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPersonByID.expectations = append(mmGetPersonByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.GetPersonByID return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockGetPersonByIDExpectation) Then(p1 models.Person, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockGetPersonByIDResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.GetPersonByID should be invoked
+func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Times(n uint64) *mPersonRepositoryMockGetPersonByID {
+	if n == 0 {
+		mmGetPersonByID.mock.t.Fatalf("Times of PersonRepositoryMock.GetPersonByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPersonByID.expectedInvocations, n)
+	mmGetPersonByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPersonByID
+}
+
+func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) invocationsDone() bool {
+	if len(mmGetPersonByID.expectations) == 0 && mmGetPersonByID.defaultExpectation == nil && mmGetPersonByID.mock.funcGetPersonByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPersonByID.mock.afterGetPersonByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPersonByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPersonByID implements PersonRepository
+func (mmGetPersonByID *PersonRepositoryMock) GetPersonByID(id int32) (p1 models.Person, err error) {
+	mm_atomic.AddUint64(&mmGetPersonByID.beforeGetPersonByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPersonByID.afterGetPersonByIDCounter, 1)
+
+	mmGetPersonByID.t.Helper()
+
+	if mmGetPersonByID.inspectFuncGetPersonByID != nil {
+		mmGetPersonByID.inspectFuncGetPersonByID(id)
+	}
+
+	mm_params := PersonRepositoryMockGetPersonByIDParams{id}
+
+	// Record call args
+	mmGetPersonByID.GetPersonByIDMock.mutex.Lock()
+	mmGetPersonByID.GetPersonByIDMock.callArgs = append(mmGetPersonByID.GetPersonByIDMock.callArgs, &mm_params)
+	mmGetPersonByID.GetPersonByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetPersonByID.GetPersonByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPersonByID.GetPersonByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPersonByID.GetPersonByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPersonByID.GetPersonByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPersonByID.GetPersonByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockGetPersonByIDParams{id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetPersonByID.t.Errorf("PersonRepositoryMock.GetPersonByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPersonByID.GetPersonByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPersonByID.t.Errorf("PersonRepositoryMock.GetPersonByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPersonByID.GetPersonByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPersonByID.GetPersonByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPersonByID.t.Fatal("No results are set for the PersonRepositoryMock.GetPersonByID")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPersonByID.funcGetPersonByID != nil {
+		return mmGetPersonByID.funcGetPersonByID(id)
+	}
+	mmGetPersonByID.t.Fatalf("Unexpected call to PersonRepositoryMock.GetPersonByID. %v", id)
+	return
+}
+
+// GetPersonByIDAfterCounter returns a count of finished PersonRepositoryMock.GetPersonByID invocations
+func (mmGetPersonByID *PersonRepositoryMock) GetPersonByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPersonByID.afterGetPersonByIDCounter)
+}
+
+// GetPersonByIDBeforeCounter returns a count of PersonRepositoryMock.GetPersonByID invocations
+func (mmGetPersonByID *PersonRepositoryMock) GetPersonByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPersonByID.beforeGetPersonByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.GetPersonByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Calls() []*PersonRepositoryMockGetPersonByIDParams {
+	mmGetPersonByID.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockGetPersonByIDParams, len(mmGetPersonByID.callArgs))
+	copy(argCopy, mmGetPersonByID.callArgs)
+
+	mmGetPersonByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPersonByIDDone returns true if the count of the GetPersonByID invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockGetPersonByIDDone() bool {
+	if m.GetPersonByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPersonByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPersonByIDMock.invocationsDone()
+}
+
+// MinimockGetPersonByIDInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockGetPersonByIDInspect() {
+	for _, e := range m.GetPersonByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPersonByIDCounter := mm_atomic.LoadUint64(&m.afterGetPersonByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPersonByIDMock.defaultExpectation != nil && afterGetPersonByIDCounter < 1 {
+		if m.GetPersonByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonByID at\n%s", m.GetPersonByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonByID at\n%s with params: %#v", m.GetPersonByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetPersonByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPersonByID != nil && afterGetPersonByIDCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonByID at\n%s", m.funcGetPersonByIDOrigin)
+	}
+
+	if !m.GetPersonByIDMock.invocationsDone() && afterGetPersonByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetPersonByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPersonByIDMock.expectedInvocations), m.GetPersonByIDMock.expectedInvocationsOrigin, afterGetPersonByIDCounter)
+	}
+}
+
+type mPersonRepositoryMockGetPersonsByIDs struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockGetPersonsByIDsExpectation
+	expectations       []*PersonRepositoryMockGetPersonsByIDsExpectation
+
+	callArgs []*PersonRepositoryMockGetPersonsByIDsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockGetPersonsByIDsExpectation specifies expectation struct of the PersonRepository.GetPersonsByIDs
+type PersonRepositoryMockGetPersonsByIDsExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockGetPersonsByIDsParams
+	paramPtrs          *PersonRepositoryMockGetPersonsByIDsParamPtrs
+	expectationOrigins PersonRepositoryMockGetPersonsByIDsExpectationOrigins
+	results            *PersonRepositoryMockGetPersonsByIDsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockGetPersonsByIDsParams contains parameters of the PersonRepository.GetPersonsByIDs
+type PersonRepositoryMockGetPersonsByIDsParams struct {
+	ids []int32
+}
+
+// PersonRepositoryMockGetPersonsByIDsParamPtrs contains pointers to parameters of the PersonRepository.GetPersonsByIDs
+type PersonRepositoryMockGetPersonsByIDsParamPtrs struct {
+	ids *[]int32
+}
+
+// PersonRepositoryMockGetPersonsByIDsResults contains results of the PersonRepository.GetPersonsByIDs
+type PersonRepositoryMockGetPersonsByIDsResults struct {
+	pa1 []models.Person
+	err error
+}
+
+// PersonRepositoryMockGetPersonsByIDsOrigins contains origins of expectations of the PersonRepository.GetPersonsByIDs
+type PersonRepositoryMockGetPersonsByIDsExpectationOrigins struct {
+	origin    string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPersonsByIDs *mPersonRepositoryMockGetPersonsByIDs) Optional() *mPersonRepositoryMockGetPersonsByIDs {
+	mmGetPersonsByIDs.optional = true
+	return mmGetPersonsByIDs
+}
+
+// Expect sets up expected params for PersonRepository.GetPersonsByIDs
+func (mmGetPersonsByIDs *mPersonRepositoryMockGetPersonsByIDs) Expect(ids []int32) *mPersonRepositoryMockGetPersonsByIDs {
+	if mmGetPersonsByIDs.mock.funcGetPersonsByIDs != nil {
+		mmGetPersonsByIDs.mock.t.Fatalf("PersonRepositoryMock.GetPersonsByIDs mock is already set by Set")
+	}
+
+	if mmGetPersonsByIDs.defaultExpectation == nil {
+		mmGetPersonsByIDs.defaultExpectation = &PersonRepositoryMockGetPersonsByIDsExpectation{}
+	}
+
+	if mmGetPersonsByIDs.defaultExpectation.paramPtrs != nil {
+		mmGetPersonsByIDs.mock.t.Fatalf("PersonRepositoryMock.GetPersonsByIDs mock is already set by ExpectParams functions")
+	}
+
+	mmGetPersonsByIDs.defaultExpectation.params = &PersonRepositoryMockGetPersonsByIDsParams{ids}
+	mmGetPersonsByIDs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPersonsByIDs.expectations {
+		if minimock.Equal(e.params, mmGetPersonsByIDs.defaultExpectation.params) {
+			mmGetPersonsByIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPersonsByIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPersonsByIDs
+}
+
+// ExpectIdsParam1 sets up expected param ids for PersonRepository.GetPersonsByIDs
+func (mmGetPersonsByIDs *mPersonRepositoryMockGetPersonsByIDs) ExpectIdsParam1(ids []int32) *mPersonRepositoryMockGetPersonsByIDs {
+	if mmGetPersonsByIDs.mock.funcGetPersonsByIDs != nil {
+		mmGetPersonsByIDs.mock.t.Fatalf("PersonRepositoryMock.GetPersonsByIDs mock is already set by Set")
+	}
+
+	if mmGetPersonsByIDs.defaultExpectation == nil {
+		mmGetPersonsByIDs.defaultExpectation = &PersonRepositoryMockGetPersonsByIDsExpectation{}
+	}
+
+	if mmGetPersonsByIDs.defaultExpectation.params != nil {
+		mmGetPersonsByIDs.mock.t.Fatalf("PersonRepositoryMock.GetPersonsByIDs mock is already set by Expect")
+	}
+
+	if mmGetPersonsByIDs.defaultExpectation.paramPtrs == nil {
+		mmGetPersonsByIDs.defaultExpectation.paramPtrs = &PersonRepositoryMockGetPersonsByIDsParamPtrs{}
+	}
+	mmGetPersonsByIDs.defaultExpectation.paramPtrs.ids = &ids
+	mmGetPersonsByIDs.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmGetPersonsByIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.GetPersonsByIDs
+func (mmGetPersonsByIDs *mPersonRepositoryMockGetPersonsByIDs) Inspect(f func(ids []int32)) *mPersonRepositoryMockGetPersonsByIDs {
+	if mmGetPersonsByIDs.mock.inspectFuncGetPersonsByIDs != nil {
+		mmGetPersonsByIDs.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetPersonsByIDs")
+	}
+
+	mmGetPersonsByIDs.mock.inspectFuncGetPersonsByIDs = f
+
+	return mmGetPersonsByIDs
+}
+
+// Return sets up results that will be returned by PersonRepository.GetPersonsByIDs
+func (mmGetPersonsByIDs *mPersonRepositoryMockGetPersonsByIDs) Return(pa1 []models.Person, err error) *PersonRepositoryMock {
+	if mmGetPersonsByIDs.mock.funcGetPersonsByIDs != nil {
+		mmGetPersonsByIDs.mock.t.Fatalf("PersonRepositoryMock.GetPersonsByIDs mock is already set by Set")
+	}
+
+	if mmGetPersonsByIDs.defaultExpectation == nil {
+		mmGetPersonsByIDs.defaultExpectation = &PersonRepositoryMockGetPersonsByIDsExpectation{mock: mmGetPersonsByIDs.mock}
+	}
+	mmGetPersonsByIDs.defaultExpectation.results = &PersonRepositoryMockGetPersonsByIDsResults{pa1, err}
+	mmGetPersonsByIDs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPersonsByIDs.mock
+}
+
+// Set uses given function f to mock the PersonRepository.GetPersonsByIDs method
+func (mmGetPersonsByIDs *mPersonRepositoryMockGetPersonsByIDs) Set(f func(ids []int32) (pa1 []models.Person, err error)) *PersonRepositoryMock {
+	if mmGetPersonsByIDs.defaultExpectation != nil {
+		mmGetPersonsByIDs.mock.t.Fatalf("Default expectation is already set for the PersonRepository.GetPersonsByIDs method")
+	}
+
+	if len(mmGetPersonsByIDs.expectations) > 0 {
+		mmGetPersonsByIDs.mock.t.Fatalf("Some expectations are already set for the PersonRepository.GetPersonsByIDs method")
+	}
+
+	mmGetPersonsByIDs.mock.funcGetPersonsByIDs = f
+	mmGetPersonsByIDs.mock.funcGetPersonsByIDsOrigin = minimock.CallerInfo(1)
+	return mmGetPersonsByIDs.mock
+}
+
+// When sets expectation for the PersonRepository.GetPersonsByIDs which will trigger the result defined by the following
+// Then helper
+func (mmGetPersonsByIDs *mPersonRepositoryMockGetPersonsByIDs) When(ids []int32) *PersonRepositoryMockGetPersonsByIDsExpectation {
+	if mmGetPersonsByIDs.mock.funcGetPersonsByIDs != nil {
+		mmGetPersonsByIDs.mock.t.Fatalf("PersonRepositoryMock.GetPersonsByIDs mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockGetPersonsByIDsExpectation{
+		mock:               mmGetPersonsByIDs.mock,
+		params:             &PersonRepositoryMockGetPersonsByIDsParams{ids},
+		expectationOrigins: PersonRepositoryMockGetPersonsByIDsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPersonsByIDs.expectations = append(mmGetPersonsByIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.GetPersonsByIDs return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockGetPersonsByIDsExpectation) Then(pa1 []models.Person, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockGetPersonsByIDsResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.GetPersonsByIDs should be invoked
+func (mmGetPersonsByIDs *mPersonRepositoryMockGetPersonsByIDs) Times(n uint64) *mPersonRepositoryMockGetPersonsByIDs {
+	if n == 0 {
+		mmGetPersonsByIDs.mock.t.Fatalf("Times of PersonRepositoryMock.GetPersonsByIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPersonsByIDs.expectedInvocations, n)
+	mmGetPersonsByIDs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPersonsByIDs
+}
+
+func (mmGetPersonsByIDs *mPersonRepositoryMockGetPersonsByIDs) invocationsDone() bool {
+	if len(mmGetPersonsByIDs.expectations) == 0 && mmGetPersonsByIDs.defaultExpectation == nil && mmGetPersonsByIDs.mock.funcGetPersonsByIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPersonsByIDs.mock.afterGetPersonsByIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPersonsByIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPersonsByIDs implements PersonRepository
+func (mmGetPersonsByIDs *PersonRepositoryMock) GetPersonsByIDs(ids []int32) (pa1 []models.Person, err error) {
+	mm_atomic.AddUint64(&mmGetPersonsByIDs.beforeGetPersonsByIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPersonsByIDs.afterGetPersonsByIDsCounter, 1)
+
+	mmGetPersonsByIDs.t.Helper()
+
+	if mmGetPersonsByIDs.inspectFuncGetPersonsByIDs != nil {
+		mmGetPersonsByIDs.inspectFuncGetPersonsByIDs(ids)
+	}
+
+	mm_params := PersonRepositoryMockGetPersonsByIDsParams{ids}
+
+	// Record call args
+	mmGetPersonsByIDs.GetPersonsByIDsMock.mutex.Lock()
+	mmGetPersonsByIDs.GetPersonsByIDsMock.callArgs = append(mmGetPersonsByIDs.GetPersonsByIDsMock.callArgs, &mm_params)
+	mmGetPersonsByIDs.GetPersonsByIDsMock.mutex.Unlock()
+
+	for _, e := range mmGetPersonsByIDs.GetPersonsByIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetPersonsByIDs.GetPersonsByIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPersonsByIDs.GetPersonsByIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPersonsByIDs.GetPersonsByIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPersonsByIDs.GetPersonsByIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockGetPersonsByIDsParams{ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmGetPersonsByIDs.t.Errorf("PersonRepositoryMock.GetPersonsByIDs got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPersonsByIDs.GetPersonsByIDsMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPersonsByIDs.t.Errorf("PersonRepositoryMock.GetPersonsByIDs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPersonsByIDs.GetPersonsByIDsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPersonsByIDs.GetPersonsByIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPersonsByIDs.t.Fatal("No results are set for the PersonRepositoryMock.GetPersonsByIDs")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetPersonsByIDs.funcGetPersonsByIDs != nil {
+		return mmGetPersonsByIDs.funcGetPersonsByIDs(ids)
+	}
+	mmGetPersonsByIDs.t.Fatalf("Unexpected call to PersonRepositoryMock.GetPersonsByIDs. %v", ids)
+	return
+}
+
+// GetPersonsByIDsAfterCounter returns a count of finished PersonRepositoryMock.GetPersonsByIDs invocations
+func (mmGetPersonsByIDs *PersonRepositoryMock) GetPersonsByIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPersonsByIDs.afterGetPersonsByIDsCounter)
+}
+
+// GetPersonsByIDsBeforeCounter returns a count of PersonRepositoryMock.GetPersonsByIDs invocations
+func (mmGetPersonsByIDs *PersonRepositoryMock) GetPersonsByIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPersonsByIDs.beforeGetPersonsByIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.GetPersonsByIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPersonsByIDs *mPersonRepositoryMockGetPersonsByIDs) Calls() []*PersonRepositoryMockGetPersonsByIDsParams {
+	mmGetPersonsByIDs.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockGetPersonsByIDsParams, len(mmGetPersonsByIDs.callArgs))
+	copy(argCopy, mmGetPersonsByIDs.callArgs)
+
+	mmGetPersonsByIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPersonsByIDsDone returns true if the count of the GetPersonsByIDs invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockGetPersonsByIDsDone() bool {
+	if m.GetPersonsByIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPersonsByIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPersonsByIDsMock.invocationsDone()
+}
+
+// MinimockGetPersonsByIDsInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockGetPersonsByIDsInspect() {
+	for _, e := range m.GetPersonsByIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonsByIDs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPersonsByIDsCounter := mm_atomic.LoadUint64(&m.afterGetPersonsByIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPersonsByIDsMock.defaultExpectation != nil && afterGetPersonsByIDsCounter < 1 {
+		if m.GetPersonsByIDsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonsByIDs at\n%s", m.GetPersonsByIDsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonsByIDs at\n%s with params: %#v", m.GetPersonsByIDsMock.defaultExpectation.expectationOrigins.origin, *m.GetPersonsByIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPersonsByIDs != nil && afterGetPersonsByIDsCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonsByIDs at\n%s", m.funcGetPersonsByIDsOrigin)
+	}
+
+	if !m.GetPersonsByIDsMock.invocationsDone() && afterGetPersonsByIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetPersonsByIDs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPersonsByIDsMock.expectedInvocations), m.GetPersonsByIDsMock.expectedInvocationsOrigin, afterGetPersonsByIDsCounter)
+	}
+}
+
+type mPersonRepositoryMockGetPersonsUpdatedSince struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockGetPersonsUpdatedSinceExpectation
+	expectations       []*PersonRepositoryMockGetPersonsUpdatedSinceExpectation
+
+	callArgs []*PersonRepositoryMockGetPersonsUpdatedSinceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockGetPersonsUpdatedSinceExpectation specifies expectation struct of the PersonRepository.GetPersonsUpdatedSince
+type PersonRepositoryMockGetPersonsUpdatedSinceExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockGetPersonsUpdatedSinceParams
+	paramPtrs          *PersonRepositoryMockGetPersonsUpdatedSinceParamPtrs
+	expectationOrigins PersonRepositoryMockGetPersonsUpdatedSinceExpectationOrigins
+	results            *PersonRepositoryMockGetPersonsUpdatedSinceResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockGetPersonsUpdatedSinceParams contains parameters of the PersonRepository.GetPersonsUpdatedSince
+type PersonRepositoryMockGetPersonsUpdatedSinceParams struct {
+	since time.Time
+	after *models.PersonCursor
+	limit int
+}
+
+// PersonRepositoryMockGetPersonsUpdatedSinceParamPtrs contains pointers to parameters of the PersonRepository.GetPersonsUpdatedSince
+type PersonRepositoryMockGetPersonsUpdatedSinceParamPtrs struct {
+	since *time.Time
+	after **models.PersonCursor
+	limit *int
+}
+
+// PersonRepositoryMockGetPersonsUpdatedSinceResults contains results of the PersonRepository.GetPersonsUpdatedSince
+type PersonRepositoryMockGetPersonsUpdatedSinceResults struct {
+	pa1 []models.PersonChange
+	err error
+}
+
+// PersonRepositoryMockGetPersonsUpdatedSinceOrigins contains origins of expectations of the PersonRepository.GetPersonsUpdatedSince
+type PersonRepositoryMockGetPersonsUpdatedSinceExpectationOrigins struct {
+	origin      string
+	originSince string
+	originAfter string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) Optional() *mPersonRepositoryMockGetPersonsUpdatedSince {
+	mmGetPersonsUpdatedSince.optional = true
+	return mmGetPersonsUpdatedSince
+}
+
+// Expect sets up expected params for PersonRepository.GetPersonsUpdatedSince
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) Expect(since time.Time, after *models.PersonCursor, limit int) *mPersonRepositoryMockGetPersonsUpdatedSince {
+	if mmGetPersonsUpdatedSince.mock.funcGetPersonsUpdatedSince != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("PersonRepositoryMock.GetPersonsUpdatedSince mock is already set by Set")
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation == nil {
+		mmGetPersonsUpdatedSince.defaultExpectation = &PersonRepositoryMockGetPersonsUpdatedSinceExpectation{}
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation.paramPtrs != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("PersonRepositoryMock.GetPersonsUpdatedSince mock is already set by ExpectParams functions")
+	}
+
+	mmGetPersonsUpdatedSince.defaultExpectation.params = &PersonRepositoryMockGetPersonsUpdatedSinceParams{since, after, limit}
+	mmGetPersonsUpdatedSince.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPersonsUpdatedSince.expectations {
+		if minimock.Equal(e.params, mmGetPersonsUpdatedSince.defaultExpectation.params) {
+			mmGetPersonsUpdatedSince.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPersonsUpdatedSince.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPersonsUpdatedSince
+}
+
+// ExpectSinceParam1 sets up expected param since for PersonRepository.GetPersonsUpdatedSince
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) ExpectSinceParam1(since time.Time) *mPersonRepositoryMockGetPersonsUpdatedSince {
+	if mmGetPersonsUpdatedSince.mock.funcGetPersonsUpdatedSince != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("PersonRepositoryMock.GetPersonsUpdatedSince mock is already set by Set")
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation == nil {
+		mmGetPersonsUpdatedSince.defaultExpectation = &PersonRepositoryMockGetPersonsUpdatedSinceExpectation{}
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation.params != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("PersonRepositoryMock.GetPersonsUpdatedSince mock is already set by Expect")
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation.paramPtrs == nil {
+		mmGetPersonsUpdatedSince.defaultExpectation.paramPtrs = &PersonRepositoryMockGetPersonsUpdatedSinceParamPtrs{}
+	}
+	mmGetPersonsUpdatedSince.defaultExpectation.paramPtrs.since = &since
+	mmGetPersonsUpdatedSince.defaultExpectation.expectationOrigins.originSince = minimock.CallerInfo(1)
+
+	return mmGetPersonsUpdatedSince
+}
+
+// ExpectAfterParam2 sets up expected param after for PersonRepository.GetPersonsUpdatedSince
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) ExpectAfterParam2(after *models.PersonCursor) *mPersonRepositoryMockGetPersonsUpdatedSince {
+	if mmGetPersonsUpdatedSince.mock.funcGetPersonsUpdatedSince != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("PersonRepositoryMock.GetPersonsUpdatedSince mock is already set by Set")
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation == nil {
+		mmGetPersonsUpdatedSince.defaultExpectation = &PersonRepositoryMockGetPersonsUpdatedSinceExpectation{}
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation.params != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("PersonRepositoryMock.GetPersonsUpdatedSince mock is already set by Expect")
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation.paramPtrs == nil {
+		mmGetPersonsUpdatedSince.defaultExpectation.paramPtrs = &PersonRepositoryMockGetPersonsUpdatedSinceParamPtrs{}
+	}
+	mmGetPersonsUpdatedSince.defaultExpectation.paramPtrs.after = &after
+	mmGetPersonsUpdatedSince.defaultExpectation.expectationOrigins.originAfter = minimock.CallerInfo(1)
+
+	return mmGetPersonsUpdatedSince
+}
+
+// ExpectLimitParam3 sets up expected param limit for PersonRepository.GetPersonsUpdatedSince
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) ExpectLimitParam3(limit int) *mPersonRepositoryMockGetPersonsUpdatedSince {
+	if mmGetPersonsUpdatedSince.mock.funcGetPersonsUpdatedSince != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("PersonRepositoryMock.GetPersonsUpdatedSince mock is already set by Set")
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation == nil {
+		mmGetPersonsUpdatedSince.defaultExpectation = &PersonRepositoryMockGetPersonsUpdatedSinceExpectation{}
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation.params != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("PersonRepositoryMock.GetPersonsUpdatedSince mock is already set by Expect")
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation.paramPtrs == nil {
+		mmGetPersonsUpdatedSince.defaultExpectation.paramPtrs = &PersonRepositoryMockGetPersonsUpdatedSinceParamPtrs{}
+	}
+	mmGetPersonsUpdatedSince.defaultExpectation.paramPtrs.limit = &limit
+	mmGetPersonsUpdatedSince.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetPersonsUpdatedSince
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.GetPersonsUpdatedSince
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) Inspect(f func(since time.Time, after *models.PersonCursor, limit int)) *mPersonRepositoryMockGetPersonsUpdatedSince {
+	if mmGetPersonsUpdatedSince.mock.inspectFuncGetPersonsUpdatedSince != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetPersonsUpdatedSince")
+	}
+
+	mmGetPersonsUpdatedSince.mock.inspectFuncGetPersonsUpdatedSince = f
+
+	return mmGetPersonsUpdatedSince
+}
+
+// Return sets up results that will be returned by PersonRepository.GetPersonsUpdatedSince
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) Return(pa1 []models.PersonChange, err error) *PersonRepositoryMock {
+	if mmGetPersonsUpdatedSince.mock.funcGetPersonsUpdatedSince != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("PersonRepositoryMock.GetPersonsUpdatedSince mock is already set by Set")
+	}
+
+	if mmGetPersonsUpdatedSince.defaultExpectation == nil {
+		mmGetPersonsUpdatedSince.defaultExpectation = &PersonRepositoryMockGetPersonsUpdatedSinceExpectation{mock: mmGetPersonsUpdatedSince.mock}
+	}
+	mmGetPersonsUpdatedSince.defaultExpectation.results = &PersonRepositoryMockGetPersonsUpdatedSinceResults{pa1, err}
+	mmGetPersonsUpdatedSince.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPersonsUpdatedSince.mock
+}
+
+// Set uses given function f to mock the PersonRepository.GetPersonsUpdatedSince method
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) Set(f func(since time.Time, after *models.PersonCursor, limit int) (pa1 []models.PersonChange, err error)) *PersonRepositoryMock {
+	if mmGetPersonsUpdatedSince.defaultExpectation != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("Default expectation is already set for the PersonRepository.GetPersonsUpdatedSince method")
+	}
+
+	if len(mmGetPersonsUpdatedSince.expectations) > 0 {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("Some expectations are already set for the PersonRepository.GetPersonsUpdatedSince method")
+	}
+
+	mmGetPersonsUpdatedSince.mock.funcGetPersonsUpdatedSince = f
+	mmGetPersonsUpdatedSince.mock.funcGetPersonsUpdatedSinceOrigin = minimock.CallerInfo(1)
+	return mmGetPersonsUpdatedSince.mock
+}
+
+// When sets expectation for the PersonRepository.GetPersonsUpdatedSince which will trigger the result defined by the following
+// Then helper
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) When(since time.Time, after *models.PersonCursor, limit int) *PersonRepositoryMockGetPersonsUpdatedSinceExpectation {
+	if mmGetPersonsUpdatedSince.mock.funcGetPersonsUpdatedSince != nil {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("PersonRepositoryMock.GetPersonsUpdatedSince mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockGetPersonsUpdatedSinceExpectation{
+		mock:               mmGetPersonsUpdatedSince.mock,
+		params:             &PersonRepositoryMockGetPersonsUpdatedSinceParams{since, after, limit},
+		expectationOrigins: PersonRepositoryMockGetPersonsUpdatedSinceExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPersonsUpdatedSince.expectations = append(mmGetPersonsUpdatedSince.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.GetPersonsUpdatedSince return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockGetPersonsUpdatedSinceExpectation) Then(pa1 []models.PersonChange, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockGetPersonsUpdatedSinceResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.GetPersonsUpdatedSince should be invoked
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) Times(n uint64) *mPersonRepositoryMockGetPersonsUpdatedSince {
+	if n == 0 {
+		mmGetPersonsUpdatedSince.mock.t.Fatalf("Times of PersonRepositoryMock.GetPersonsUpdatedSince mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPersonsUpdatedSince.expectedInvocations, n)
+	mmGetPersonsUpdatedSince.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPersonsUpdatedSince
+}
+
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) invocationsDone() bool {
+	if len(mmGetPersonsUpdatedSince.expectations) == 0 && mmGetPersonsUpdatedSince.defaultExpectation == nil && mmGetPersonsUpdatedSince.mock.funcGetPersonsUpdatedSince == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPersonsUpdatedSince.mock.afterGetPersonsUpdatedSinceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPersonsUpdatedSince.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPersonsUpdatedSince implements PersonRepository
+func (mmGetPersonsUpdatedSince *PersonRepositoryMock) GetPersonsUpdatedSince(since time.Time, after *models.PersonCursor, limit int) (pa1 []models.PersonChange, err error) {
+	mm_atomic.AddUint64(&mmGetPersonsUpdatedSince.beforeGetPersonsUpdatedSinceCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPersonsUpdatedSince.afterGetPersonsUpdatedSinceCounter, 1)
+
+	mmGetPersonsUpdatedSince.t.Helper()
+
+	if mmGetPersonsUpdatedSince.inspectFuncGetPersonsUpdatedSince != nil {
+		mmGetPersonsUpdatedSince.inspectFuncGetPersonsUpdatedSince(since, after, limit)
+	}
+
+	mm_params := PersonRepositoryMockGetPersonsUpdatedSinceParams{since, after, limit}
+
+	// Record call args
+	mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.mutex.Lock()
+	mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.callArgs = append(mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.callArgs, &mm_params)
+	mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.mutex.Unlock()
+
+	for _, e := range mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockGetPersonsUpdatedSinceParams{since, after, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.since != nil && !minimock.Equal(*mm_want_ptrs.since, mm_got.since) {
+				mmGetPersonsUpdatedSince.t.Errorf("PersonRepositoryMock.GetPersonsUpdatedSince got unexpected parameter since, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.defaultExpectation.expectationOrigins.originSince, *mm_want_ptrs.since, mm_got.since, minimock.Diff(*mm_want_ptrs.since, mm_got.since))
+			}
+
+			if mm_want_ptrs.after != nil && !minimock.Equal(*mm_want_ptrs.after, mm_got.after) {
+				mmGetPersonsUpdatedSince.t.Errorf("PersonRepositoryMock.GetPersonsUpdatedSince got unexpected parameter after, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.defaultExpectation.expectationOrigins.originAfter, *mm_want_ptrs.after, mm_got.after, minimock.Diff(*mm_want_ptrs.after, mm_got.after))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetPersonsUpdatedSince.t.Errorf("PersonRepositoryMock.GetPersonsUpdatedSince got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPersonsUpdatedSince.t.Errorf("PersonRepositoryMock.GetPersonsUpdatedSince got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPersonsUpdatedSince.GetPersonsUpdatedSinceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPersonsUpdatedSince.t.Fatal("No results are set for the PersonRepositoryMock.GetPersonsUpdatedSince")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetPersonsUpdatedSince.funcGetPersonsUpdatedSince != nil {
+		return mmGetPersonsUpdatedSince.funcGetPersonsUpdatedSince(since, after, limit)
+	}
+	mmGetPersonsUpdatedSince.t.Fatalf("Unexpected call to PersonRepositoryMock.GetPersonsUpdatedSince. %v %v %v", since, after, limit)
+	return
+}
+
+// GetPersonsUpdatedSinceAfterCounter returns a count of finished PersonRepositoryMock.GetPersonsUpdatedSince invocations
+func (mmGetPersonsUpdatedSince *PersonRepositoryMock) GetPersonsUpdatedSinceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPersonsUpdatedSince.afterGetPersonsUpdatedSinceCounter)
+}
+
+// GetPersonsUpdatedSinceBeforeCounter returns a count of PersonRepositoryMock.GetPersonsUpdatedSince invocations
+func (mmGetPersonsUpdatedSince *PersonRepositoryMock) GetPersonsUpdatedSinceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPersonsUpdatedSince.beforeGetPersonsUpdatedSinceCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.GetPersonsUpdatedSince.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPersonsUpdatedSince *mPersonRepositoryMockGetPersonsUpdatedSince) Calls() []*PersonRepositoryMockGetPersonsUpdatedSinceParams {
+	mmGetPersonsUpdatedSince.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockGetPersonsUpdatedSinceParams, len(mmGetPersonsUpdatedSince.callArgs))
+	copy(argCopy, mmGetPersonsUpdatedSince.callArgs)
+
+	mmGetPersonsUpdatedSince.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPersonsUpdatedSinceDone returns true if the count of the GetPersonsUpdatedSince invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockGetPersonsUpdatedSinceDone() bool {
+	if m.GetPersonsUpdatedSinceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPersonsUpdatedSinceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPersonsUpdatedSinceMock.invocationsDone()
+}
+
+// MinimockGetPersonsUpdatedSinceInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockGetPersonsUpdatedSinceInspect() {
+	for _, e := range m.GetPersonsUpdatedSinceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonsUpdatedSince at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPersonsUpdatedSinceCounter := mm_atomic.LoadUint64(&m.afterGetPersonsUpdatedSinceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPersonsUpdatedSinceMock.defaultExpectation != nil && afterGetPersonsUpdatedSinceCounter < 1 {
+		if m.GetPersonsUpdatedSinceMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonsUpdatedSince at\n%s", m.GetPersonsUpdatedSinceMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonsUpdatedSince at\n%s with params: %#v", m.GetPersonsUpdatedSinceMock.defaultExpectation.expectationOrigins.origin, *m.GetPersonsUpdatedSinceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPersonsUpdatedSince != nil && afterGetPersonsUpdatedSinceCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonsUpdatedSince at\n%s", m.funcGetPersonsUpdatedSinceOrigin)
+	}
+
+	if !m.GetPersonsUpdatedSinceMock.invocationsDone() && afterGetPersonsUpdatedSinceCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetPersonsUpdatedSince at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPersonsUpdatedSinceMock.expectedInvocations), m.GetPersonsUpdatedSinceMock.expectedInvocationsOrigin, afterGetPersonsUpdatedSinceCounter)
+	}
+}
+
+type mPersonRepositoryMockGetRelatedPersons struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockGetRelatedPersonsExpectation
+	expectations       []*PersonRepositoryMockGetRelatedPersonsExpectation
+
+	callArgs []*PersonRepositoryMockGetRelatedPersonsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockGetRelatedPersonsExpectation specifies expectation struct of the PersonRepository.GetRelatedPersons
+type PersonRepositoryMockGetRelatedPersonsExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockGetRelatedPersonsParams
+	paramPtrs          *PersonRepositoryMockGetRelatedPersonsParamPtrs
+	expectationOrigins PersonRepositoryMockGetRelatedPersonsExpectationOrigins
+	results            *PersonRepositoryMockGetRelatedPersonsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockGetRelatedPersonsParams contains parameters of the PersonRepository.GetRelatedPersons
+type PersonRepositoryMockGetRelatedPersonsParams struct {
+	query models.RelationQuery
+}
+
+// PersonRepositoryMockGetRelatedPersonsParamPtrs contains pointers to parameters of the PersonRepository.GetRelatedPersons
+type PersonRepositoryMockGetRelatedPersonsParamPtrs struct {
+	query *models.RelationQuery
+}
+
+// PersonRepositoryMockGetRelatedPersonsResults contains results of the PersonRepository.GetRelatedPersons
+type PersonRepositoryMockGetRelatedPersonsResults struct {
+	ra1 []models.RelatedPerson
+	err error
+}
+
+// PersonRepositoryMockGetRelatedPersonsOrigins contains origins of expectations of the PersonRepository.GetRelatedPersons
+type PersonRepositoryMockGetRelatedPersonsExpectationOrigins struct {
+	origin      string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRelatedPersons *mPersonRepositoryMockGetRelatedPersons) Optional() *mPersonRepositoryMockGetRelatedPersons {
+	mmGetRelatedPersons.optional = true
+	return mmGetRelatedPersons
+}
+
+// Expect sets up expected params for PersonRepository.GetRelatedPersons
+func (mmGetRelatedPersons *mPersonRepositoryMockGetRelatedPersons) Expect(query models.RelationQuery) *mPersonRepositoryMockGetRelatedPersons {
+	if mmGetRelatedPersons.mock.funcGetRelatedPersons != nil {
+		mmGetRelatedPersons.mock.t.Fatalf("PersonRepositoryMock.GetRelatedPersons mock is already set by Set")
+	}
+
+	if mmGetRelatedPersons.defaultExpectation == nil {
+		mmGetRelatedPersons.defaultExpectation = &PersonRepositoryMockGetRelatedPersonsExpectation{}
+	}
+
+	if mmGetRelatedPersons.defaultExpectation.paramPtrs != nil {
+		mmGetRelatedPersons.mock.t.Fatalf("PersonRepositoryMock.GetRelatedPersons mock is already set by ExpectParams functions")
+	}
+
+	mmGetRelatedPersons.defaultExpectation.params = &PersonRepositoryMockGetRelatedPersonsParams{query}
+	mmGetRelatedPersons.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRelatedPersons.expectations {
+		if minimock.Equal(e.params, mmGetRelatedPersons.defaultExpectation.params) {
+			mmGetRelatedPersons.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRelatedPersons.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRelatedPersons
+}
+
+// ExpectQueryParam1 sets up expected param query for PersonRepository.GetRelatedPersons
+func (mmGetRelatedPersons *mPersonRepositoryMockGetRelatedPersons) ExpectQueryParam1(query models.RelationQuery) *mPersonRepositoryMockGetRelatedPersons {
+	if mmGetRelatedPersons.mock.funcGetRelatedPersons != nil {
+		mmGetRelatedPersons.mock.t.Fatalf("PersonRepositoryMock.GetRelatedPersons mock is already set by Set")
+	}
+
+	if mmGetRelatedPersons.defaultExpectation == nil {
+		mmGetRelatedPersons.defaultExpectation = &PersonRepositoryMockGetRelatedPersonsExpectation{}
+	}
+
+	if mmGetRelatedPersons.defaultExpectation.params != nil {
+		mmGetRelatedPersons.mock.t.Fatalf("PersonRepositoryMock.GetRelatedPersons mock is already set by Expect")
+	}
+
+	if mmGetRelatedPersons.defaultExpectation.paramPtrs == nil {
+		mmGetRelatedPersons.defaultExpectation.paramPtrs = &PersonRepositoryMockGetRelatedPersonsParamPtrs{}
+	}
+	mmGetRelatedPersons.defaultExpectation.paramPtrs.query = &query
+	mmGetRelatedPersons.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmGetRelatedPersons
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.GetRelatedPersons
+func (mmGetRelatedPersons *mPersonRepositoryMockGetRelatedPersons) Inspect(f func(query models.RelationQuery)) *mPersonRepositoryMockGetRelatedPersons {
+	if mmGetRelatedPersons.mock.inspectFuncGetRelatedPersons != nil {
+		mmGetRelatedPersons.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetRelatedPersons")
+	}
+
+	mmGetRelatedPersons.mock.inspectFuncGetRelatedPersons = f
+
+	return mmGetRelatedPersons
+}
+
+// Return sets up results that will be returned by PersonRepository.GetRelatedPersons
+func (mmGetRelatedPersons *mPersonRepositoryMockGetRelatedPersons) Return(ra1 []models.RelatedPerson, err error) *PersonRepositoryMock {
+	if mmGetRelatedPersons.mock.funcGetRelatedPersons != nil {
+		mmGetRelatedPersons.mock.t.Fatalf("PersonRepositoryMock.GetRelatedPersons mock is already set by Set")
+	}
+
+	if mmGetRelatedPersons.defaultExpectation == nil {
+		mmGetRelatedPersons.defaultExpectation = &PersonRepositoryMockGetRelatedPersonsExpectation{mock: mmGetRelatedPersons.mock}
+	}
+	mmGetRelatedPersons.defaultExpectation.results = &PersonRepositoryMockGetRelatedPersonsResults{ra1, err}
+	mmGetRelatedPersons.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRelatedPersons.mock
+}
+
+// Set uses given function f to mock the PersonRepository.GetRelatedPersons method
+func (mmGetRelatedPersons *mPersonRepositoryMockGetRelatedPersons) Set(f func(query models.RelationQuery) (ra1 []models.RelatedPerson, err error)) *PersonRepositoryMock {
+	if mmGetRelatedPersons.defaultExpectation != nil {
+		mmGetRelatedPersons.mock.t.Fatalf("Default expectation is already set for the PersonRepository.GetRelatedPersons method")
+	}
+
+	if len(mmGetRelatedPersons.expectations) > 0 {
+		mmGetRelatedPersons.mock.t.Fatalf("Some expectations are already set for the PersonRepository.GetRelatedPersons method")
+	}
+
+	mmGetRelatedPersons.mock.funcGetRelatedPersons = f
+	mmGetRelatedPersons.mock.funcGetRelatedPersonsOrigin = minimock.CallerInfo(1)
+	return mmGetRelatedPersons.mock
+}
+
+// When sets expectation for the PersonRepository.GetRelatedPersons which will trigger the result defined by the following
+// Then helper
+func (mmGetRelatedPersons *mPersonRepositoryMockGetRelatedPersons) When(query models.RelationQuery) *PersonRepositoryMockGetRelatedPersonsExpectation {
+	if mmGetRelatedPersons.mock.funcGetRelatedPersons != nil {
+		mmGetRelatedPersons.mock.t.Fatalf("PersonRepositoryMock.GetRelatedPersons mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockGetRelatedPersonsExpectation{
+		mock:               mmGetRelatedPersons.mock,
+		params:             &PersonRepositoryMockGetRelatedPersonsParams{query},
+		expectationOrigins: PersonRepositoryMockGetRelatedPersonsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRelatedPersons.expectations = append(mmGetRelatedPersons.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.GetRelatedPersons return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockGetRelatedPersonsExpectation) Then(ra1 []models.RelatedPerson, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockGetRelatedPersonsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.GetRelatedPersons should be invoked
+func (mmGetRelatedPersons *mPersonRepositoryMockGetRelatedPersons) Times(n uint64) *mPersonRepositoryMockGetRelatedPersons {
+	if n == 0 {
+		mmGetRelatedPersons.mock.t.Fatalf("Times of PersonRepositoryMock.GetRelatedPersons mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRelatedPersons.expectedInvocations, n)
+	mmGetRelatedPersons.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRelatedPersons
+}
+
+func (mmGetRelatedPersons *mPersonRepositoryMockGetRelatedPersons) invocationsDone() bool {
+	if len(mmGetRelatedPersons.expectations) == 0 && mmGetRelatedPersons.defaultExpectation == nil && mmGetRelatedPersons.mock.funcGetRelatedPersons == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRelatedPersons.mock.afterGetRelatedPersonsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRelatedPersons.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRelatedPersons implements PersonRepository
+func (mmGetRelatedPersons *PersonRepositoryMock) GetRelatedPersons(query models.RelationQuery) (ra1 []models.RelatedPerson, err error) {
+	mm_atomic.AddUint64(&mmGetRelatedPersons.beforeGetRelatedPersonsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRelatedPersons.afterGetRelatedPersonsCounter, 1)
+
+	mmGetRelatedPersons.t.Helper()
+
+	if mmGetRelatedPersons.inspectFuncGetRelatedPersons != nil {
+		mmGetRelatedPersons.inspectFuncGetRelatedPersons(query)
+	}
+
+	mm_params := PersonRepositoryMockGetRelatedPersonsParams{query}
+
+	// Record call args
+	mmGetRelatedPersons.GetRelatedPersonsMock.mutex.Lock()
+	mmGetRelatedPersons.GetRelatedPersonsMock.callArgs = append(mmGetRelatedPersons.GetRelatedPersonsMock.callArgs, &mm_params)
+	mmGetRelatedPersons.GetRelatedPersonsMock.mutex.Unlock()
+
+	for _, e := range mmGetRelatedPersons.GetRelatedPersonsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetRelatedPersons.GetRelatedPersonsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRelatedPersons.GetRelatedPersonsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRelatedPersons.GetRelatedPersonsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRelatedPersons.GetRelatedPersonsMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockGetRelatedPersonsParams{query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmGetRelatedPersons.t.Errorf("PersonRepositoryMock.GetRelatedPersons got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRelatedPersons.GetRelatedPersonsMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRelatedPersons.t.Errorf("PersonRepositoryMock.GetRelatedPersons got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRelatedPersons.GetRelatedPersonsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRelatedPersons.GetRelatedPersonsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRelatedPersons.t.Fatal("No results are set for the PersonRepositoryMock.GetRelatedPersons")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetRelatedPersons.funcGetRelatedPersons != nil {
+		return mmGetRelatedPersons.funcGetRelatedPersons(query)
+	}
+	mmGetRelatedPersons.t.Fatalf("Unexpected call to PersonRepositoryMock.GetRelatedPersons. %v", query)
+	return
+}
+
+// GetRelatedPersonsAfterCounter returns a count of finished PersonRepositoryMock.GetRelatedPersons invocations
+func (mmGetRelatedPersons *PersonRepositoryMock) GetRelatedPersonsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRelatedPersons.afterGetRelatedPersonsCounter)
+}
+
+// GetRelatedPersonsBeforeCounter returns a count of PersonRepositoryMock.GetRelatedPersons invocations
+func (mmGetRelatedPersons *PersonRepositoryMock) GetRelatedPersonsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRelatedPersons.beforeGetRelatedPersonsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.GetRelatedPersons.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRelatedPersons *mPersonRepositoryMockGetRelatedPersons) Calls() []*PersonRepositoryMockGetRelatedPersonsParams {
+	mmGetRelatedPersons.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockGetRelatedPersonsParams, len(mmGetRelatedPersons.callArgs))
+	copy(argCopy, mmGetRelatedPersons.callArgs)
+
+	mmGetRelatedPersons.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRelatedPersonsDone returns true if the count of the GetRelatedPersons invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockGetRelatedPersonsDone() bool {
+	if m.GetRelatedPersonsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRelatedPersonsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRelatedPersonsMock.invocationsDone()
+}
+
+// MinimockGetRelatedPersonsInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockGetRelatedPersonsInspect() {
+	for _, e := range m.GetRelatedPersonsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetRelatedPersons at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRelatedPersonsCounter := mm_atomic.LoadUint64(&m.afterGetRelatedPersonsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRelatedPersonsMock.defaultExpectation != nil && afterGetRelatedPersonsCounter < 1 {
+		if m.GetRelatedPersonsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetRelatedPersons at\n%s", m.GetRelatedPersonsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.GetRelatedPersons at\n%s with params: %#v", m.GetRelatedPersonsMock.defaultExpectation.expectationOrigins.origin, *m.GetRelatedPersonsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRelatedPersons != nil && afterGetRelatedPersonsCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.GetRelatedPersons at\n%s", m.funcGetRelatedPersonsOrigin)
+	}
+
+	if !m.GetRelatedPersonsMock.invocationsDone() && afterGetRelatedPersonsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetRelatedPersons at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRelatedPersonsMock.expectedInvocations), m.GetRelatedPersonsMock.expectedInvocationsOrigin, afterGetRelatedPersonsCounter)
+	}
+}
+
+type mPersonRepositoryMockListOrganizations struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockListOrganizationsExpectation
+	expectations       []*PersonRepositoryMockListOrganizationsExpectation
+
+	callArgs []*PersonRepositoryMockListOrganizationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockListOrganizationsExpectation specifies expectation struct of the PersonRepository.ListOrganizations
+type PersonRepositoryMockListOrganizationsExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockListOrganizationsParams
+	paramPtrs          *PersonRepositoryMockListOrganizationsParamPtrs
+	expectationOrigins PersonRepositoryMockListOrganizationsExpectationOrigins
+	results            *PersonRepositoryMockListOrganizationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockListOrganizationsParams contains parameters of the PersonRepository.ListOrganizations
+type PersonRepositoryMockListOrganizationsParams struct {
+	name string
+}
+
+// PersonRepositoryMockListOrganizationsParamPtrs contains pointers to parameters of the PersonRepository.ListOrganizations
+type PersonRepositoryMockListOrganizationsParamPtrs struct {
+	name *string
+}
+
+// PersonRepositoryMockListOrganizationsResults contains results of the PersonRepository.ListOrganizations
+type PersonRepositoryMockListOrganizationsResults struct {
+	oa1 []models.Organization
+	err error
+}
+
+// PersonRepositoryMockListOrganizationsOrigins contains origins of expectations of the PersonRepository.ListOrganizations
+type PersonRepositoryMockListOrganizationsExpectationOrigins struct {
+	origin     string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListOrganizations *mPersonRepositoryMockListOrganizations) Optional() *mPersonRepositoryMockListOrganizations {
+	mmListOrganizations.optional = true
+	return mmListOrganizations
+}
+
+// Expect sets up expected params for PersonRepository.ListOrganizations
+func (mmListOrganizations *mPersonRepositoryMockListOrganizations) Expect(name string) *mPersonRepositoryMockListOrganizations {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("PersonRepositoryMock.ListOrganizations mock is already set by Set")
+	}
+
+	if mmListOrganizations.defaultExpectation == nil {
+		mmListOrganizations.defaultExpectation = &PersonRepositoryMockListOrganizationsExpectation{}
+	}
+
+	if mmListOrganizations.defaultExpectation.paramPtrs != nil {
+		mmListOrganizations.mock.t.Fatalf("PersonRepositoryMock.ListOrganizations mock is already set by ExpectParams functions")
+	}
+
+	mmListOrganizations.defaultExpectation.params = &PersonRepositoryMockListOrganizationsParams{name}
+	mmListOrganizations.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListOrganizations.expectations {
+		if minimock.Equal(e.params, mmListOrganizations.defaultExpectation.params) {
+			mmListOrganizations.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOrganizations.defaultExpectation.params)
+		}
+	}
+
+	return mmListOrganizations
+}
+
+// ExpectNameParam1 sets up expected param name for PersonRepository.ListOrganizations
+func (mmListOrganizations *mPersonRepositoryMockListOrganizations) ExpectNameParam1(name string) *mPersonRepositoryMockListOrganizations {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("PersonRepositoryMock.ListOrganizations mock is already set by Set")
+	}
+
+	if mmListOrganizations.defaultExpectation == nil {
+		mmListOrganizations.defaultExpectation = &PersonRepositoryMockListOrganizationsExpectation{}
+	}
+
+	if mmListOrganizations.defaultExpectation.params != nil {
+		mmListOrganizations.mock.t.Fatalf("PersonRepositoryMock.ListOrganizations mock is already set by Expect")
+	}
+
+	if mmListOrganizations.defaultExpectation.paramPtrs == nil {
+		mmListOrganizations.defaultExpectation.paramPtrs = &PersonRepositoryMockListOrganizationsParamPtrs{}
+	}
+	mmListOrganizations.defaultExpectation.paramPtrs.name = &name
+	mmListOrganizations.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmListOrganizations
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.ListOrganizations
+func (mmListOrganizations *mPersonRepositoryMockListOrganizations) Inspect(f func(name string)) *mPersonRepositoryMockListOrganizations {
+	if mmListOrganizations.mock.inspectFuncListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.ListOrganizations")
+	}
+
+	mmListOrganizations.mock.inspectFuncListOrganizations = f
+
+	return mmListOrganizations
+}
+
+// Return sets up results that will be returned by PersonRepository.ListOrganizations
+func (mmListOrganizations *mPersonRepositoryMockListOrganizations) Return(oa1 []models.Organization, err error) *PersonRepositoryMock {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("PersonRepositoryMock.ListOrganizations mock is already set by Set")
+	}
+
+	if mmListOrganizations.defaultExpectation == nil {
+		mmListOrganizations.defaultExpectation = &PersonRepositoryMockListOrganizationsExpectation{mock: mmListOrganizations.mock}
+	}
+	mmListOrganizations.defaultExpectation.results = &PersonRepositoryMockListOrganizationsResults{oa1, err}
+	mmListOrganizations.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListOrganizations.mock
+}
+
+// Set uses given function f to mock the PersonRepository.ListOrganizations method
+func (mmListOrganizations *mPersonRepositoryMockListOrganizations) Set(f func(name string) (oa1 []models.Organization, err error)) *PersonRepositoryMock {
+	if mmListOrganizations.defaultExpectation != nil {
+		mmListOrganizations.mock.t.Fatalf("Default expectation is already set for the PersonRepository.ListOrganizations method")
+	}
+
+	if len(mmListOrganizations.expectations) > 0 {
+		mmListOrganizations.mock.t.Fatalf("Some expectations are already set for the PersonRepository.ListOrganizations method")
+	}
+
+	mmListOrganizations.mock.funcListOrganizations = f
+	mmListOrganizations.mock.funcListOrganizationsOrigin = minimock.CallerInfo(1)
+	return mmListOrganizations.mock
+}
+
+// When sets expectation for the PersonRepository.ListOrganizations which will trigger the result defined by the following
+// Then helper
+func (mmListOrganizations *mPersonRepositoryMockListOrganizations) When(name string) *PersonRepositoryMockListOrganizationsExpectation {
+	if mmListOrganizations.mock.funcListOrganizations != nil {
+		mmListOrganizations.mock.t.Fatalf("PersonRepositoryMock.ListOrganizations mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockListOrganizationsExpectation{
+		mock:               mmListOrganizations.mock,
+		params:             &PersonRepositoryMockListOrganizationsParams{name},
+		expectationOrigins: PersonRepositoryMockListOrganizationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListOrganizations.expectations = append(mmListOrganizations.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.ListOrganizations return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockListOrganizationsExpectation) Then(oa1 []models.Organization, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockListOrganizationsResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.ListOrganizations should be invoked
+func (mmListOrganizations *mPersonRepositoryMockListOrganizations) Times(n uint64) *mPersonRepositoryMockListOrganizations {
+	if n == 0 {
+		mmListOrganizations.mock.t.Fatalf("Times of PersonRepositoryMock.ListOrganizations mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListOrganizations.expectedInvocations, n)
+	mmListOrganizations.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListOrganizations
+}
+
+func (mmListOrganizations *mPersonRepositoryMockListOrganizations) invocationsDone() bool {
+	if len(mmListOrganizations.expectations) == 0 && mmListOrganizations.defaultExpectation == nil && mmListOrganizations.mock.funcListOrganizations == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListOrganizations.mock.afterListOrganizationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListOrganizations.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListOrganizations implements PersonRepository
+func (mmListOrganizations *PersonRepositoryMock) ListOrganizations(name string) (oa1 []models.Organization, err error) {
+	mm_atomic.AddUint64(&mmListOrganizations.beforeListOrganizationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListOrganizations.afterListOrganizationsCounter, 1)
+
+	mmListOrganizations.t.Helper()
+
+	if mmListOrganizations.inspectFuncListOrganizations != nil {
+		mmListOrganizations.inspectFuncListOrganizations(name)
+	}
+
+	mm_params := PersonRepositoryMockListOrganizationsParams{name}
+
+	// Record call args
+	mmListOrganizations.ListOrganizationsMock.mutex.Lock()
+	mmListOrganizations.ListOrganizationsMock.callArgs = append(mmListOrganizations.ListOrganizationsMock.callArgs, &mm_params)
+	mmListOrganizations.ListOrganizationsMock.mutex.Unlock()
+
+	for _, e := range mmListOrganizations.ListOrganizationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmListOrganizations.ListOrganizationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListOrganizations.ListOrganizationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListOrganizations.ListOrganizationsMock.defaultExpectation.params
+		mm_want_ptrs := mmListOrganizations.ListOrganizationsMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockListOrganizationsParams{name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmListOrganizations.t.Errorf("PersonRepositoryMock.ListOrganizations got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOrganizations.ListOrganizationsMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListOrganizations.t.Errorf("PersonRepositoryMock.ListOrganizations got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListOrganizations.ListOrganizationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListOrganizations.ListOrganizationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListOrganizations.t.Fatal("No results are set for the PersonRepositoryMock.ListOrganizations")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmListOrganizations.funcListOrganizations != nil {
+		return mmListOrganizations.funcListOrganizations(name)
+	}
+	mmListOrganizations.t.Fatalf("Unexpected call to PersonRepositoryMock.ListOrganizations. %v", name)
+	return
+}
+
+// ListOrganizationsAfterCounter returns a count of finished PersonRepositoryMock.ListOrganizations invocations
+func (mmListOrganizations *PersonRepositoryMock) ListOrganizationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrganizations.afterListOrganizationsCounter)
+}
+
+// ListOrganizationsBeforeCounter returns a count of PersonRepositoryMock.ListOrganizations invocations
+func (mmListOrganizations *PersonRepositoryMock) ListOrganizationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrganizations.beforeListOrganizationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.ListOrganizations.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListOrganizations *mPersonRepositoryMockListOrganizations) Calls() []*PersonRepositoryMockListOrganizationsParams {
+	mmListOrganizations.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockListOrganizationsParams, len(mmListOrganizations.callArgs))
+	copy(argCopy, mmListOrganizations.callArgs)
+
+	mmListOrganizations.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListOrganizationsDone returns true if the count of the ListOrganizations invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockListOrganizationsDone() bool {
+	if m.ListOrganizationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListOrganizationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListOrganizationsMock.invocationsDone()
+}
+
+// MinimockListOrganizationsInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockListOrganizationsInspect() {
+	for _, e := range m.ListOrganizationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.ListOrganizations at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListOrganizationsCounter := mm_atomic.LoadUint64(&m.afterListOrganizationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListOrganizationsMock.defaultExpectation != nil && afterListOrganizationsCounter < 1 {
+		if m.ListOrganizationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.ListOrganizations at\n%s", m.ListOrganizationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.ListOrganizations at\n%s with params: %#v", m.ListOrganizationsMock.defaultExpectation.expectationOrigins.origin, *m.ListOrganizationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListOrganizations != nil && afterListOrganizationsCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.ListOrganizations at\n%s", m.funcListOrganizationsOrigin)
+	}
+
+	if !m.ListOrganizationsMock.invocationsDone() && afterListOrganizationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.ListOrganizations at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListOrganizationsMock.expectedInvocations), m.ListOrganizationsMock.expectedInvocationsOrigin, afterListOrganizationsCounter)
+	}
+}
+
+type mPersonRepositoryMockListPersons struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockListPersonsExpectation
+	expectations       []*PersonRepositoryMockListPersonsExpectation
+
+	callArgs []*PersonRepositoryMockListPersonsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockListPersonsExpectation specifies expectation struct of the PersonRepository.ListPersons
+type PersonRepositoryMockListPersonsExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockListPersonsParams
+	paramPtrs          *PersonRepositoryMockListPersonsParamPtrs
+	expectationOrigins PersonRepositoryMockListPersonsExpectationOrigins
+	results            *PersonRepositoryMockListPersonsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockListPersonsParams contains parameters of the PersonRepository.ListPersons
+type PersonRepositoryMockListPersonsParams struct {
+	query models.PersonQuery
+}
+
+// PersonRepositoryMockListPersonsParamPtrs contains pointers to parameters of the PersonRepository.ListPersons
+type PersonRepositoryMockListPersonsParamPtrs struct {
+	query *models.PersonQuery
+}
+
+// PersonRepositoryMockListPersonsResults contains results of the PersonRepository.ListPersons
+type PersonRepositoryMockListPersonsResults struct {
+	pa1 []models.Person
+	err error
+}
+
+// PersonRepositoryMockListPersonsOrigins contains origins of expectations of the PersonRepository.ListPersons
+type PersonRepositoryMockListPersonsExpectationOrigins struct {
+	origin      string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPersons *mPersonRepositoryMockListPersons) Optional() *mPersonRepositoryMockListPersons {
+	mmListPersons.optional = true
+	return mmListPersons
+}
+
+// Expect sets up expected params for PersonRepository.ListPersons
+func (mmListPersons *mPersonRepositoryMockListPersons) Expect(query models.PersonQuery) *mPersonRepositoryMockListPersons {
+	if mmListPersons.mock.funcListPersons != nil {
+		mmListPersons.mock.t.Fatalf("PersonRepositoryMock.ListPersons mock is already set by Set")
+	}
+
+	if mmListPersons.defaultExpectation == nil {
+		mmListPersons.defaultExpectation = &PersonRepositoryMockListPersonsExpectation{}
+	}
+
+	if mmListPersons.defaultExpectation.paramPtrs != nil {
+		mmListPersons.mock.t.Fatalf("PersonRepositoryMock.ListPersons mock is already set by ExpectParams functions")
+	}
+
+	mmListPersons.defaultExpectation.params = &PersonRepositoryMockListPersonsParams{query}
+	mmListPersons.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPersons.expectations {
+		if minimock.Equal(e.params, mmListPersons.defaultExpectation.params) {
+			mmListPersons.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPersons.defaultExpectation.params)
+		}
+	}
+
+	return mmListPersons
+}
+
+// ExpectQueryParam1 sets up expected param query for PersonRepository.ListPersons
+func (mmListPersons *mPersonRepositoryMockListPersons) ExpectQueryParam1(query models.PersonQuery) *mPersonRepositoryMockListPersons {
+	if mmListPersons.mock.funcListPersons != nil {
+		mmListPersons.mock.t.Fatalf("PersonRepositoryMock.ListPersons mock is already set by Set")
+	}
+
+	if mmListPersons.defaultExpectation == nil {
+		mmListPersons.defaultExpectation = &PersonRepositoryMockListPersonsExpectation{}
+	}
+
+	if mmListPersons.defaultExpectation.params != nil {
+		mmListPersons.mock.t.Fatalf("PersonRepositoryMock.ListPersons mock is already set by Expect")
+	}
+
+	if mmListPersons.defaultExpectation.paramPtrs == nil {
+		mmListPersons.defaultExpectation.paramPtrs = &PersonRepositoryMockListPersonsParamPtrs{}
+	}
+	mmListPersons.defaultExpectation.paramPtrs.query = &query
+	mmListPersons.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListPersons
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.ListPersons
+func (mmListPersons *mPersonRepositoryMockListPersons) Inspect(f func(query models.PersonQuery)) *mPersonRepositoryMockListPersons {
+	if mmListPersons.mock.inspectFuncListPersons != nil {
+		mmListPersons.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.ListPersons")
+	}
+
+	mmListPersons.mock.inspectFuncListPersons = f
+
+	return mmListPersons
+}
+
+// Return sets up results that will be returned by PersonRepository.ListPersons
+func (mmListPersons *mPersonRepositoryMockListPersons) Return(pa1 []models.Person, err error) *PersonRepositoryMock {
+	if mmListPersons.mock.funcListPersons != nil {
+		mmListPersons.mock.t.Fatalf("PersonRepositoryMock.ListPersons mock is already set by Set")
+	}
+
+	if mmListPersons.defaultExpectation == nil {
+		mmListPersons.defaultExpectation = &PersonRepositoryMockListPersonsExpectation{mock: mmListPersons.mock}
+	}
+	mmListPersons.defaultExpectation.results = &PersonRepositoryMockListPersonsResults{pa1, err}
+	mmListPersons.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPersons.mock
+}
+
+// Set uses given function f to mock the PersonRepository.ListPersons method
+func (mmListPersons *mPersonRepositoryMockListPersons) Set(f func(query models.PersonQuery) (pa1 []models.Person, err error)) *PersonRepositoryMock {
+	if mmListPersons.defaultExpectation != nil {
+		mmListPersons.mock.t.Fatalf("Default expectation is already set for the PersonRepository.ListPersons method")
+	}
+
+	if len(mmListPersons.expectations) > 0 {
+		mmListPersons.mock.t.Fatalf("Some expectations are already set for the PersonRepository.ListPersons method")
+	}
+
+	mmListPersons.mock.funcListPersons = f
+	mmListPersons.mock.funcListPersonsOrigin = minimock.CallerInfo(1)
+	return mmListPersons.mock
+}
+
+// When sets expectation for the PersonRepository.ListPersons which will trigger the result defined by the following
+// Then helper
+func (mmListPersons *mPersonRepositoryMockListPersons) When(query models.PersonQuery) *PersonRepositoryMockListPersonsExpectation {
+	if mmListPersons.mock.funcListPersons != nil {
+		mmListPersons.mock.t.Fatalf("PersonRepositoryMock.ListPersons mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockListPersonsExpectation{
+		mock:               mmListPersons.mock,
+		params:             &PersonRepositoryMockListPersonsParams{query},
+		expectationOrigins: PersonRepositoryMockListPersonsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPersons.expectations = append(mmListPersons.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.ListPersons return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockListPersonsExpectation) Then(pa1 []models.Person, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockListPersonsResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.ListPersons should be invoked
+func (mmListPersons *mPersonRepositoryMockListPersons) Times(n uint64) *mPersonRepositoryMockListPersons {
+	if n == 0 {
+		mmListPersons.mock.t.Fatalf("Times of PersonRepositoryMock.ListPersons mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPersons.expectedInvocations, n)
+	mmListPersons.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPersons
+}
+
+func (mmListPersons *mPersonRepositoryMockListPersons) invocationsDone() bool {
+	if len(mmListPersons.expectations) == 0 && mmListPersons.defaultExpectation == nil && mmListPersons.mock.funcListPersons == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPersons.mock.afterListPersonsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPersons.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPersons implements PersonRepository
+func (mmListPersons *PersonRepositoryMock) ListPersons(query models.PersonQuery) (pa1 []models.Person, err error) {
+	mm_atomic.AddUint64(&mmListPersons.beforeListPersonsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPersons.afterListPersonsCounter, 1)
+
+	mmListPersons.t.Helper()
+
+	if mmListPersons.inspectFuncListPersons != nil {
+		mmListPersons.inspectFuncListPersons(query)
+	}
+
+	mm_params := PersonRepositoryMockListPersonsParams{query}
+
+	// Record call args
+	mmListPersons.ListPersonsMock.mutex.Lock()
+	mmListPersons.ListPersonsMock.callArgs = append(mmListPersons.ListPersonsMock.callArgs, &mm_params)
+	mmListPersons.ListPersonsMock.mutex.Unlock()
+
+	for _, e := range mmListPersons.ListPersonsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmListPersons.ListPersonsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPersons.ListPersonsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPersons.ListPersonsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPersons.ListPersonsMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockListPersonsParams{query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListPersons.t.Errorf("PersonRepositoryMock.ListPersons got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPersons.ListPersonsMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPersons.t.Errorf("PersonRepositoryMock.ListPersons got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPersons.ListPersonsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPersons.ListPersonsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPersons.t.Fatal("No results are set for the PersonRepositoryMock.ListPersons")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmListPersons.funcListPersons != nil {
+		return mmListPersons.funcListPersons(query)
+	}
+	mmListPersons.t.Fatalf("Unexpected call to PersonRepositoryMock.ListPersons. %v", query)
+	return
+}
+
+// ListPersonsAfterCounter returns a count of finished PersonRepositoryMock.ListPersons invocations
+func (mmListPersons *PersonRepositoryMock) ListPersonsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPersons.afterListPersonsCounter)
+}
+
+// ListPersonsBeforeCounter returns a count of PersonRepositoryMock.ListPersons invocations
+func (mmListPersons *PersonRepositoryMock) ListPersonsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPersons.beforeListPersonsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.ListPersons.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPersons *mPersonRepositoryMockListPersons) Calls() []*PersonRepositoryMockListPersonsParams {
+	mmListPersons.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockListPersonsParams, len(mmListPersons.callArgs))
+	copy(argCopy, mmListPersons.callArgs)
+
+	mmListPersons.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPersonsDone returns true if the count of the ListPersons invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockListPersonsDone() bool {
+	if m.ListPersonsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPersonsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPersonsMock.invocationsDone()
+}
+
+// MinimockListPersonsInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockListPersonsInspect() {
+	for _, e := range m.ListPersonsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.ListPersons at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPersonsCounter := mm_atomic.LoadUint64(&m.afterListPersonsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPersonsMock.defaultExpectation != nil && afterListPersonsCounter < 1 {
+		if m.ListPersonsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.ListPersons at\n%s", m.ListPersonsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.ListPersons at\n%s with params: %#v", m.ListPersonsMock.defaultExpectation.expectationOrigins.origin, *m.ListPersonsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPersons != nil && afterListPersonsCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.ListPersons at\n%s", m.funcListPersonsOrigin)
+	}
+
+	if !m.ListPersonsMock.invocationsDone() && afterListPersonsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.ListPersons at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPersonsMock.expectedInvocations), m.ListPersonsMock.expectedInvocationsOrigin, afterListPersonsCounter)
+	}
+}
+
+type mPersonRepositoryMockMergePersons struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockMergePersonsExpectation
+	expectations       []*PersonRepositoryMockMergePersonsExpectation
+
+	callArgs []*PersonRepositoryMockMergePersonsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockMergePersonsExpectation specifies expectation struct of the PersonRepository.MergePersons
+type PersonRepositoryMockMergePersonsExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockMergePersonsParams
+	paramPtrs          *PersonRepositoryMockMergePersonsParamPtrs
+	expectationOrigins PersonRepositoryMockMergePersonsExpectationOrigins
+	results            *PersonRepositoryMockMergePersonsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockMergePersonsParams contains parameters of the PersonRepository.MergePersons
+type PersonRepositoryMockMergePersonsParams struct {
+	merge models.PersonMerge
+}
+
+// PersonRepositoryMockMergePersonsParamPtrs contains pointers to parameters of the PersonRepository.MergePersons
+type PersonRepositoryMockMergePersonsParamPtrs struct {
+	merge *models.PersonMerge
+}
+
+// PersonRepositoryMockMergePersonsResults contains results of the PersonRepository.MergePersons
+type PersonRepositoryMockMergePersonsResults struct {
+	p1  models.Person
+	err error
+}
+
+// PersonRepositoryMockMergePersonsOrigins contains origins of expectations of the PersonRepository.MergePersons
+type PersonRepositoryMockMergePersonsExpectationOrigins struct {
+	origin      string
+	originMerge string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMergePersons *mPersonRepositoryMockMergePersons) Optional() *mPersonRepositoryMockMergePersons {
+	mmMergePersons.optional = true
+	return mmMergePersons
+}
+
+// Expect sets up expected params for PersonRepository.MergePersons
+func (mmMergePersons *mPersonRepositoryMockMergePersons) Expect(merge models.PersonMerge) *mPersonRepositoryMockMergePersons {
+	if mmMergePersons.mock.funcMergePersons != nil {
+		mmMergePersons.mock.t.Fatalf("PersonRepositoryMock.MergePersons mock is already set by Set")
+	}
+
+	if mmMergePersons.defaultExpectation == nil {
+		mmMergePersons.defaultExpectation = &PersonRepositoryMockMergePersonsExpectation{}
+	}
+
+	if mmMergePersons.defaultExpectation.paramPtrs != nil {
+		mmMergePersons.mock.t.Fatalf("PersonRepositoryMock.MergePersons mock is already set by ExpectParams functions")
+	}
+
+	mmMergePersons.defaultExpectation.params = &PersonRepositoryMockMergePersonsParams{merge}
+	mmMergePersons.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMergePersons.expectations {
+		if minimock.Equal(e.params, mmMergePersons.defaultExpectation.params) {
+			mmMergePersons.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMergePersons.defaultExpectation.params)
+		}
+	}
+
+	return mmMergePersons
+}
+
+// ExpectMergeParam1 sets up expected param merge for PersonRepository.MergePersons
+func (mmMergePersons *mPersonRepositoryMockMergePersons) ExpectMergeParam1(merge models.PersonMerge) *mPersonRepositoryMockMergePersons {
+	if mmMergePersons.mock.funcMergePersons != nil {
+		mmMergePersons.mock.t.Fatalf("PersonRepositoryMock.MergePersons mock is already set by Set")
+	}
+
+	if mmMergePersons.defaultExpectation == nil {
+		mmMergePersons.defaultExpectation = &PersonRepositoryMockMergePersonsExpectation{}
+	}
+
+	if mmMergePersons.defaultExpectation.params != nil {
+		mmMergePersons.mock.t.Fatalf("PersonRepositoryMock.MergePersons mock is already set by Expect")
+	}
+
+	if mmMergePersons.defaultExpectation.paramPtrs == nil {
+		mmMergePersons.defaultExpectation.paramPtrs = &PersonRepositoryMockMergePersonsParamPtrs{}
+	}
+	mmMergePersons.defaultExpectation.paramPtrs.merge = &merge
+	mmMergePersons.defaultExpectation.expectationOrigins.originMerge = minimock.CallerInfo(1)
+
+	return mmMergePersons
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.MergePersons
+func (mmMergePersons *mPersonRepositoryMockMergePersons) Inspect(f func(merge models.PersonMerge)) *mPersonRepositoryMockMergePersons {
+	if mmMergePersons.mock.inspectFuncMergePersons != nil {
+		mmMergePersons.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.MergePersons")
+	}
+
+	mmMergePersons.mock.inspectFuncMergePersons = f
+
+	return mmMergePersons
+}
+
+// Return sets up results that will be returned by PersonRepository.MergePersons
+func (mmMergePersons *mPersonRepositoryMockMergePersons) Return(p1 models.Person, err error) *PersonRepositoryMock {
+	if mmMergePersons.mock.funcMergePersons != nil {
+		mmMergePersons.mock.t.Fatalf("PersonRepositoryMock.MergePersons mock is already set by Set")
+	}
+
+	if mmMergePersons.defaultExpectation == nil {
+		mmMergePersons.defaultExpectation = &PersonRepositoryMockMergePersonsExpectation{mock: mmMergePersons.mock}
+	}
+	mmMergePersons.defaultExpectation.results = &PersonRepositoryMockMergePersonsResults{p1, err}
+	mmMergePersons.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMergePersons.mock
+}
+
+// Set uses given function f to mock the PersonRepository.MergePersons method
+func (mmMergePersons *mPersonRepositoryMockMergePersons) Set(f func(merge models.PersonMerge) (p1 models.Person, err error)) *PersonRepositoryMock {
+	if mmMergePersons.defaultExpectation != nil {
+		mmMergePersons.mock.t.Fatalf("Default expectation is already set for the PersonRepository.MergePersons method")
+	}
+
+	if len(mmMergePersons.expectations) > 0 {
+		mmMergePersons.mock.t.Fatalf("Some expectations are already set for the PersonRepository.MergePersons method")
+	}
+
+	mmMergePersons.mock.funcMergePersons = f
+	mmMergePersons.mock.funcMergePersonsOrigin = minimock.CallerInfo(1)
+	return mmMergePersons.mock
+}
+
+// When sets expectation for the PersonRepository.MergePersons which will trigger the result defined by the following
+// Then helper
+func (mmMergePersons *mPersonRepositoryMockMergePersons) When(merge models.PersonMerge) *PersonRepositoryMockMergePersonsExpectation {
+	if mmMergePersons.mock.funcMergePersons != nil {
+		mmMergePersons.mock.t.Fatalf("PersonRepositoryMock.MergePersons mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockMergePersonsExpectation{
+		mock:               mmMergePersons.mock,
+		params:             &PersonRepositoryMockMergePersonsParams{merge},
+		expectationOrigins: PersonRepositoryMockMergePersonsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMergePersons.expectations = append(mmMergePersons.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.MergePersons return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockMergePersonsExpectation) Then(p1 models.Person, err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockMergePersonsResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.MergePersons should be invoked
+func (mmMergePersons *mPersonRepositoryMockMergePersons) Times(n uint64) *mPersonRepositoryMockMergePersons {
+	if n == 0 {
+		mmMergePersons.mock.t.Fatalf("Times of PersonRepositoryMock.MergePersons mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMergePersons.expectedInvocations, n)
+	mmMergePersons.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMergePersons
+}
+
+func (mmMergePersons *mPersonRepositoryMockMergePersons) invocationsDone() bool {
+	if len(mmMergePersons.expectations) == 0 && mmMergePersons.defaultExpectation == nil && mmMergePersons.mock.funcMergePersons == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMergePersons.mock.afterMergePersonsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMergePersons.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MergePersons implements PersonRepository
+func (mmMergePersons *PersonRepositoryMock) MergePersons(merge models.PersonMerge) (p1 models.Person, err error) {
+	mm_atomic.AddUint64(&mmMergePersons.beforeMergePersonsCounter, 1)
+	defer mm_atomic.AddUint64(&mmMergePersons.afterMergePersonsCounter, 1)
+
+	mmMergePersons.t.Helper()
+
+	if mmMergePersons.inspectFuncMergePersons != nil {
+		mmMergePersons.inspectFuncMergePersons(merge)
+	}
+
+	mm_params := PersonRepositoryMockMergePersonsParams{merge}
+
+	// Record call args
+	mmMergePersons.MergePersonsMock.mutex.Lock()
+	mmMergePersons.MergePersonsMock.callArgs = append(mmMergePersons.MergePersonsMock.callArgs, &mm_params)
+	mmMergePersons.MergePersonsMock.mutex.Unlock()
+
+	for _, e := range mmMergePersons.MergePersonsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmMergePersons.MergePersonsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMergePersons.MergePersonsMock.defaultExpectation.Counter, 1)
+		mm_want := mmMergePersons.MergePersonsMock.defaultExpectation.params
+		mm_want_ptrs := mmMergePersons.MergePersonsMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockMergePersonsParams{merge}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.merge != nil && !minimock.Equal(*mm_want_ptrs.merge, mm_got.merge) {
+				mmMergePersons.t.Errorf("PersonRepositoryMock.MergePersons got unexpected parameter merge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergePersons.MergePersonsMock.defaultExpectation.expectationOrigins.originMerge, *mm_want_ptrs.merge, mm_got.merge, minimock.Diff(*mm_want_ptrs.merge, mm_got.merge))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMergePersons.t.Errorf("PersonRepositoryMock.MergePersons got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMergePersons.MergePersonsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMergePersons.MergePersonsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMergePersons.t.Fatal("No results are set for the PersonRepositoryMock.MergePersons")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmMergePersons.funcMergePersons != nil {
+		return mmMergePersons.funcMergePersons(merge)
+	}
+	mmMergePersons.t.Fatalf("Unexpected call to PersonRepositoryMock.MergePersons. %v", merge)
+	return
+}
+
+// MergePersonsAfterCounter returns a count of finished PersonRepositoryMock.MergePersons invocations
+func (mmMergePersons *PersonRepositoryMock) MergePersonsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergePersons.afterMergePersonsCounter)
+}
+
+// MergePersonsBeforeCounter returns a count of PersonRepositoryMock.MergePersons invocations
+func (mmMergePersons *PersonRepositoryMock) MergePersonsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergePersons.beforeMergePersonsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.MergePersons.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMergePersons *mPersonRepositoryMockMergePersons) Calls() []*PersonRepositoryMockMergePersonsParams {
+	mmMergePersons.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockMergePersonsParams, len(mmMergePersons.callArgs))
+	copy(argCopy, mmMergePersons.callArgs)
+
+	mmMergePersons.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergePersonsDone returns true if the count of the MergePersons invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockMergePersonsDone() bool {
+	if m.MergePersonsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MergePersonsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MergePersonsMock.invocationsDone()
+}
+
+// MinimockMergePersonsInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockMergePersonsInspect() {
+	for _, e := range m.MergePersonsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonRepositoryMock.MergePersons at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMergePersonsCounter := mm_atomic.LoadUint64(&m.afterMergePersonsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergePersonsMock.defaultExpectation != nil && afterMergePersonsCounter < 1 {
+		if m.MergePersonsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.MergePersons at\n%s", m.MergePersonsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.MergePersons at\n%s with params: %#v", m.MergePersonsMock.defaultExpectation.expectationOrigins.origin, *m.MergePersonsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMergePersons != nil && afterMergePersonsCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.MergePersons at\n%s", m.funcMergePersonsOrigin)
+	}
+
+	if !m.MergePersonsMock.invocationsDone() && afterMergePersonsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.MergePersons at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MergePersonsMock.expectedInvocations), m.MergePersonsMock.expectedInvocationsOrigin, afterMergePersonsCounter)
+	}
+}
+
+type mPersonRepositoryMockRefreshWork struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockRefreshWorkExpectation
+	expectations       []*PersonRepositoryMockRefreshWorkExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockRefreshWorkExpectation specifies expectation struct of the PersonRepository.RefreshWork
+type PersonRepositoryMockRefreshWorkExpectation struct {
+	mock *PersonRepositoryMock
+
+	results      *PersonRepositoryMockRefreshWorkResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// PersonRepositoryMockRefreshWorkResults contains results of the PersonRepository.RefreshWork
+type PersonRepositoryMockRefreshWorkResults struct {
+	ia1 []int32
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRefreshWork *mPersonRepositoryMockRefreshWork) Optional() *mPersonRepositoryMockRefreshWork {
+	mmRefreshWork.optional = true
+	return mmRefreshWork
+}
+
+// Expect sets up expected params for PersonRepository.RefreshWork
+func (mmRefreshWork *mPersonRepositoryMockRefreshWork) Expect() *mPersonRepositoryMockRefreshWork {
+	if mmRefreshWork.mock.funcRefreshWork != nil {
+		mmRefreshWork.mock.t.Fatalf("PersonRepositoryMock.RefreshWork mock is already set by Set")
+	}
+
+	if mmRefreshWork.defaultExpectation == nil {
+		mmRefreshWork.defaultExpectation = &PersonRepositoryMockRefreshWorkExpectation{}
+	}
+
+	return mmRefreshWork
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.RefreshWork
+func (mmRefreshWork *mPersonRepositoryMockRefreshWork) Inspect(f func()) *mPersonRepositoryMockRefreshWork {
+	if mmRefreshWork.mock.inspectFuncRefreshWork != nil {
+		mmRefreshWork.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.RefreshWork")
+	}
+
+	mmRefreshWork.mock.inspectFuncRefreshWork = f
+
+	return mmRefreshWork
+}
+
+// Return sets up results that will be returned by PersonRepository.RefreshWork
+func (mmRefreshWork *mPersonRepositoryMockRefreshWork) Return(ia1 []int32, err error) *PersonRepositoryMock {
+	if mmRefreshWork.mock.funcRefreshWork != nil {
+		mmRefreshWork.mock.t.Fatalf("PersonRepositoryMock.RefreshWork mock is already set by Set")
+	}
+
+	if mmRefreshWork.defaultExpectation == nil {
+		mmRefreshWork.defaultExpectation = &PersonRepositoryMockRefreshWorkExpectation{mock: mmRefreshWork.mock}
+	}
+	mmRefreshWork.defaultExpectation.results = &PersonRepositoryMockRefreshWorkResults{ia1, err}
+	mmRefreshWork.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRefreshWork.mock
+}
+
+// Set uses given function f to mock the PersonRepository.RefreshWork method
+func (mmRefreshWork *mPersonRepositoryMockRefreshWork) Set(f func() (ia1 []int32, err error)) *PersonRepositoryMock {
+	if mmRefreshWork.defaultExpectation != nil {
+		mmRefreshWork.mock.t.Fatalf("Default expectation is already set for the PersonRepository.RefreshWork method")
+	}
+
+	if len(mmRefreshWork.expectations) > 0 {
+		mmRefreshWork.mock.t.Fatalf("Some expectations are already set for the PersonRepository.RefreshWork method")
+	}
+
+	mmRefreshWork.mock.funcRefreshWork = f
+	mmRefreshWork.mock.funcRefreshWorkOrigin = minimock.CallerInfo(1)
+	return mmRefreshWork.mock
+}
+
+// Times sets number of times PersonRepository.RefreshWork should be invoked
+func (mmRefreshWork *mPersonRepositoryMockRefreshWork) Times(n uint64) *mPersonRepositoryMockRefreshWork {
+	if n == 0 {
+		mmRefreshWork.mock.t.Fatalf("Times of PersonRepositoryMock.RefreshWork mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRefreshWork.expectedInvocations, n)
+	mmRefreshWork.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRefreshWork
+}
+
+func (mmRefreshWork *mPersonRepositoryMockRefreshWork) invocationsDone() bool {
+	if len(mmRefreshWork.expectations) == 0 && mmRefreshWork.defaultExpectation == nil && mmRefreshWork.mock.funcRefreshWork == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRefreshWork.mock.afterRefreshWorkCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRefreshWork.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RefreshWork implements PersonRepository
+func (mmRefreshWork *PersonRepositoryMock) RefreshWork() (ia1 []int32, err error) {
+	mm_atomic.AddUint64(&mmRefreshWork.beforeRefreshWorkCounter, 1)
+	defer mm_atomic.AddUint64(&mmRefreshWork.afterRefreshWorkCounter, 1)
+
+	mmRefreshWork.t.Helper()
+
+	if mmRefreshWork.inspectFuncRefreshWork != nil {
+		mmRefreshWork.inspectFuncRefreshWork()
+	}
+
+	if mmRefreshWork.RefreshWorkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRefreshWork.RefreshWorkMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmRefreshWork.RefreshWorkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRefreshWork.t.Fatal("No results are set for the PersonRepositoryMock.RefreshWork")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmRefreshWork.funcRefreshWork != nil {
+		return mmRefreshWork.funcRefreshWork()
+	}
+	mmRefreshWork.t.Fatalf("Unexpected call to PersonRepositoryMock.RefreshWork.")
+	return
+}
+
+// RefreshWorkAfterCounter returns a count of finished PersonRepositoryMock.RefreshWork invocations
+func (mmRefreshWork *PersonRepositoryMock) RefreshWorkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshWork.afterRefreshWorkCounter)
+}
+
+// RefreshWorkBeforeCounter returns a count of PersonRepositoryMock.RefreshWork invocations
+func (mmRefreshWork *PersonRepositoryMock) RefreshWorkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshWork.beforeRefreshWorkCounter)
+}
+
+// MinimockRefreshWorkDone returns true if the count of the RefreshWork invocations corresponds
+// the number of defined expectations
+func (m *PersonRepositoryMock) MinimockRefreshWorkDone() bool {
+	if m.RefreshWorkMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RefreshWorkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
 
-	if mmDeletePersonByID.inspectFuncDeletePersonByID != nil {
-		mmDeletePersonByID.inspectFuncDeletePersonByID(id)
+	return m.RefreshWorkMock.invocationsDone()
+}
+
+// MinimockRefreshWorkInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockRefreshWorkInspect() {
+	for _, e := range m.RefreshWorkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to PersonRepositoryMock.RefreshWork")
+		}
 	}
 
-	mm_params := PersonRepositoryMockDeletePersonByIDParams{id}
+	afterRefreshWorkCounter := mm_atomic.LoadUint64(&m.afterRefreshWorkCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RefreshWorkMock.defaultExpectation != nil && afterRefreshWorkCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.RefreshWork at\n%s", m.RefreshWorkMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRefreshWork != nil && afterRefreshWorkCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.RefreshWork at\n%s", m.funcRefreshWorkOrigin)
+	}
+
+	if !m.RefreshWorkMock.invocationsDone() && afterRefreshWorkCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.RefreshWork at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RefreshWorkMock.expectedInvocations), m.RefreshWorkMock.expectedInvocationsOrigin, afterRefreshWorkCounter)
+	}
+}
+
+type mPersonRepositoryMockSession struct {
+	optional           bool
+	mock               *PersonRepositoryMock
+	defaultExpectation *PersonRepositoryMockSessionExpectation
+	expectations       []*PersonRepositoryMockSessionExpectation
+
+	callArgs []*PersonRepositoryMockSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonRepositoryMockSessionExpectation specifies expectation struct of the PersonRepository.Session
+type PersonRepositoryMockSessionExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockSessionParams
+	paramPtrs          *PersonRepositoryMockSessionParamPtrs
+	expectationOrigins PersonRepositoryMockSessionExpectationOrigins
+	results            *PersonRepositoryMockSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonRepositoryMockSessionParams contains parameters of the PersonRepository.Session
+type PersonRepositoryMockSessionParams struct {
+	lastWrite time.Time
+}
+
+// PersonRepositoryMockSessionParamPtrs contains pointers to parameters of the PersonRepository.Session
+type PersonRepositoryMockSessionParamPtrs struct {
+	lastWrite *time.Time
+}
+
+// PersonRepositoryMockSessionResults contains results of the PersonRepository.Session
+type PersonRepositoryMockSessionResults struct {
+	s1 repositories.Storage
+}
+
+// PersonRepositoryMockSessionOrigins contains origins of expectations of the PersonRepository.Session
+type PersonRepositoryMockSessionExpectationOrigins struct {
+	origin          string
+	originLastWrite string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSession *mPersonRepositoryMockSession) Optional() *mPersonRepositoryMockSession {
+	mmSession.optional = true
+	return mmSession
+}
+
+// Expect sets up expected params for PersonRepository.Session
+func (mmSession *mPersonRepositoryMockSession) Expect(lastWrite time.Time) *mPersonRepositoryMockSession {
+	if mmSession.mock.funcSession != nil {
+		mmSession.mock.t.Fatalf("PersonRepositoryMock.Session mock is already set by Set")
+	}
+
+	if mmSession.defaultExpectation == nil {
+		mmSession.defaultExpectation = &PersonRepositoryMockSessionExpectation{}
+	}
+
+	if mmSession.defaultExpectation.paramPtrs != nil {
+		mmSession.mock.t.Fatalf("PersonRepositoryMock.Session mock is already set by ExpectParams functions")
+	}
+
+	mmSession.defaultExpectation.params = &PersonRepositoryMockSessionParams{lastWrite}
+	mmSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSession.expectations {
+		if minimock.Equal(e.params, mmSession.defaultExpectation.params) {
+			mmSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSession.defaultExpectation.params)
+		}
+	}
+
+	return mmSession
+}
+
+// ExpectLastWriteParam1 sets up expected param lastWrite for PersonRepository.Session
+func (mmSession *mPersonRepositoryMockSession) ExpectLastWriteParam1(lastWrite time.Time) *mPersonRepositoryMockSession {
+	if mmSession.mock.funcSession != nil {
+		mmSession.mock.t.Fatalf("PersonRepositoryMock.Session mock is already set by Set")
+	}
+
+	if mmSession.defaultExpectation == nil {
+		mmSession.defaultExpectation = &PersonRepositoryMockSessionExpectation{}
+	}
+
+	if mmSession.defaultExpectation.params != nil {
+		mmSession.mock.t.Fatalf("PersonRepositoryMock.Session mock is already set by Expect")
+	}
+
+	if mmSession.defaultExpectation.paramPtrs == nil {
+		mmSession.defaultExpectation.paramPtrs = &PersonRepositoryMockSessionParamPtrs{}
+	}
+	mmSession.defaultExpectation.paramPtrs.lastWrite = &lastWrite
+	mmSession.defaultExpectation.expectationOrigins.originLastWrite = minimock.CallerInfo(1)
+
+	return mmSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.Session
+func (mmSession *mPersonRepositoryMockSession) Inspect(f func(lastWrite time.Time)) *mPersonRepositoryMockSession {
+	if mmSession.mock.inspectFuncSession != nil {
+		mmSession.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.Session")
+	}
+
+	mmSession.mock.inspectFuncSession = f
+
+	return mmSession
+}
+
+// Return sets up results that will be returned by PersonRepository.Session
+func (mmSession *mPersonRepositoryMockSession) Return(s1 repositories.Storage) *PersonRepositoryMock {
+	if mmSession.mock.funcSession != nil {
+		mmSession.mock.t.Fatalf("PersonRepositoryMock.Session mock is already set by Set")
+	}
+
+	if mmSession.defaultExpectation == nil {
+		mmSession.defaultExpectation = &PersonRepositoryMockSessionExpectation{mock: mmSession.mock}
+	}
+	mmSession.defaultExpectation.results = &PersonRepositoryMockSessionResults{s1}
+	mmSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSession.mock
+}
+
+// Set uses given function f to mock the PersonRepository.Session method
+func (mmSession *mPersonRepositoryMockSession) Set(f func(lastWrite time.Time) (s1 repositories.Storage)) *PersonRepositoryMock {
+	if mmSession.defaultExpectation != nil {
+		mmSession.mock.t.Fatalf("Default expectation is already set for the PersonRepository.Session method")
+	}
+
+	if len(mmSession.expectations) > 0 {
+		mmSession.mock.t.Fatalf("Some expectations are already set for the PersonRepository.Session method")
+	}
+
+	mmSession.mock.funcSession = f
+	mmSession.mock.funcSessionOrigin = minimock.CallerInfo(1)
+	return mmSession.mock
+}
+
+// When sets expectation for the PersonRepository.Session which will trigger the result defined by the following
+// Then helper
+func (mmSession *mPersonRepositoryMockSession) When(lastWrite time.Time) *PersonRepositoryMockSessionExpectation {
+	if mmSession.mock.funcSession != nil {
+		mmSession.mock.t.Fatalf("PersonRepositoryMock.Session mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockSessionExpectation{
+		mock:               mmSession.mock,
+		params:             &PersonRepositoryMockSessionParams{lastWrite},
+		expectationOrigins: PersonRepositoryMockSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSession.expectations = append(mmSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.Session return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockSessionExpectation) Then(s1 repositories.Storage) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockSessionResults{s1}
+	return e.mock
+}
+
+// Times sets number of times PersonRepository.Session should be invoked
+func (mmSession *mPersonRepositoryMockSession) Times(n uint64) *mPersonRepositoryMockSession {
+	if n == 0 {
+		mmSession.mock.t.Fatalf("Times of PersonRepositoryMock.Session mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSession.expectedInvocations, n)
+	mmSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSession
+}
+
+func (mmSession *mPersonRepositoryMockSession) invocationsDone() bool {
+	if len(mmSession.expectations) == 0 && mmSession.defaultExpectation == nil && mmSession.mock.funcSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSession.mock.afterSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Session implements PersonRepository
+func (mmSession *PersonRepositoryMock) Session(lastWrite time.Time) (s1 repositories.Storage) {
+	mm_atomic.AddUint64(&mmSession.beforeSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmSession.afterSessionCounter, 1)
+
+	mmSession.t.Helper()
+
+	if mmSession.inspectFuncSession != nil {
+		mmSession.inspectFuncSession(lastWrite)
+	}
+
+	mm_params := PersonRepositoryMockSessionParams{lastWrite}
 
 	// Record call args
-	mmDeletePersonByID.DeletePersonByIDMock.mutex.Lock()
-	mmDeletePersonByID.DeletePersonByIDMock.callArgs = append(mmDeletePersonByID.DeletePersonByIDMock.callArgs, &mm_params)
-	mmDeletePersonByID.DeletePersonByIDMock.mutex.Unlock()
+	mmSession.SessionMock.mutex.Lock()
+	mmSession.SessionMock.callArgs = append(mmSession.SessionMock.callArgs, &mm_params)
+	mmSession.SessionMock.mutex.Unlock()
 
-	for _, e := range mmDeletePersonByID.DeletePersonByIDMock.expectations {
+	for _, e := range mmSession.SessionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.s1
 		}
 	}
 
-	if mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.paramPtrs
+	if mmSession.SessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSession.SessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmSession.SessionMock.defaultExpectation.params
+		mm_want_ptrs := mmSession.SessionMock.defaultExpectation.paramPtrs
 
-		mm_got := PersonRepositoryMockDeletePersonByIDParams{id}
+		mm_got := PersonRepositoryMockSessionParams{lastWrite}
 
 		if mm_want_ptrs != nil {
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeletePersonByID.t.Errorf("PersonRepositoryMock.DeletePersonByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.lastWrite != nil && !minimock.Equal(*mm_want_ptrs.lastWrite, mm_got.lastWrite) {
+				mmSession.t.Errorf("PersonRepositoryMock.Session got unexpected parameter lastWrite, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSession.SessionMock.defaultExpectation.expectationOrigins.originLastWrite, *mm_want_ptrs.lastWrite, mm_got.lastWrite, minimock.Diff(*mm_want_ptrs.lastWrite, mm_got.lastWrite))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeletePersonByID.t.Errorf("PersonRepositoryMock.DeletePersonByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSession.t.Errorf("PersonRepositoryMock.Session got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSession.SessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeletePersonByID.DeletePersonByIDMock.defaultExpectation.results
+		mm_results := mmSession.SessionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeletePersonByID.t.Fatal("No results are set for the PersonRepositoryMock.DeletePersonByID")
+			mmSession.t.Fatal("No results are set for the PersonRepositoryMock.Session")
 		}
-		return (*mm_results).err
+		return (*mm_results).s1
 	}
-	if mmDeletePersonByID.funcDeletePersonByID != nil {
-		return mmDeletePersonByID.funcDeletePersonByID(id)
+	if mmSession.funcSession != nil {
+		return mmSession.funcSession(lastWrite)
 	}
-	mmDeletePersonByID.t.Fatalf("Unexpected call to PersonRepositoryMock.DeletePersonByID. %v", id)
+	mmSession.t.Fatalf("Unexpected call to PersonRepositoryMock.Session. %v", lastWrite)
 	return
 }
 
-// DeletePersonByIDAfterCounter returns a count of finished PersonRepositoryMock.DeletePersonByID invocations
-func (mmDeletePersonByID *PersonRepositoryMock) DeletePersonByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeletePersonByID.afterDeletePersonByIDCounter)
+// SessionAfterCounter returns a count of finished PersonRepositoryMock.Session invocations
+func (mmSession *PersonRepositoryMock) SessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSession.afterSessionCounter)
 }
 
-// DeletePersonByIDBeforeCounter returns a count of PersonRepositoryMock.DeletePersonByID invocations
-func (mmDeletePersonByID *PersonRepositoryMock) DeletePersonByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeletePersonByID.beforeDeletePersonByIDCounter)
+// SessionBeforeCounter returns a count of PersonRepositoryMock.Session invocations
+func (mmSession *PersonRepositoryMock) SessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSession.beforeSessionCounter)
 }
 
-// Calls returns a list of arguments used in each call to PersonRepositoryMock.DeletePersonByID.
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.Session.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeletePersonByID *mPersonRepositoryMockDeletePersonByID) Calls() []*PersonRepositoryMockDeletePersonByIDParams {
-	mmDeletePersonByID.mutex.RLock()
+func (mmSession *mPersonRepositoryMockSession) Calls() []*PersonRepositoryMockSessionParams {
+	mmSession.mutex.RLock()
 
-	argCopy := make([]*PersonRepositoryMockDeletePersonByIDParams, len(mmDeletePersonByID.callArgs))
-	copy(argCopy, mmDeletePersonByID.callArgs)
+	argCopy := make([]*PersonRepositoryMockSessionParams, len(mmSession.callArgs))
+	copy(argCopy, mmSession.callArgs)
 
-	mmDeletePersonByID.mutex.RUnlock()
+	mmSession.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeletePersonByIDDone returns true if the count of the DeletePersonByID invocations corresponds
+// MinimockSessionDone returns true if the count of the Session invocations corresponds
 // the number of defined expectations
-func (m *PersonRepositoryMock) MinimockDeletePersonByIDDone() bool {
-	if m.DeletePersonByIDMock.optional {
+func (m *PersonRepositoryMock) MinimockSessionDone() bool {
+	if m.SessionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeletePersonByIDMock.expectations {
+	for _, e := range m.SessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeletePersonByIDMock.invocationsDone()
+	return m.SessionMock.invocationsDone()
 }
 
-// MinimockDeletePersonByIDInspect logs each unmet expectation
-func (m *PersonRepositoryMock) MinimockDeletePersonByIDInspect() {
-	for _, e := range m.DeletePersonByIDMock.expectations {
+// MinimockSessionInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockSessionInspect() {
+	for _, e := range m.SessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to PersonRepositoryMock.DeletePersonByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to PersonRepositoryMock.Session at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeletePersonByIDCounter := mm_atomic.LoadUint64(&m.afterDeletePersonByIDCounter)
+	afterSessionCounter := mm_atomic.LoadUint64(&m.afterSessionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeletePersonByIDMock.defaultExpectation != nil && afterDeletePersonByIDCounter < 1 {
-		if m.DeletePersonByIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to PersonRepositoryMock.DeletePersonByID at\n%s", m.DeletePersonByIDMock.defaultExpectation.returnOrigin)
+	if m.SessionMock.defaultExpectation != nil && afterSessionCounter < 1 {
+		if m.SessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.Session at\n%s", m.SessionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to PersonRepositoryMock.DeletePersonByID at\n%s with params: %#v", m.DeletePersonByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeletePersonByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to PersonRepositoryMock.Session at\n%s with params: %#v", m.SessionMock.defaultExpectation.expectationOrigins.origin, *m.SessionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeletePersonByID != nil && afterDeletePersonByIDCounter < 1 {
-		m.t.Errorf("Expected call to PersonRepositoryMock.DeletePersonByID at\n%s", m.funcDeletePersonByIDOrigin)
+	if m.funcSession != nil && afterSessionCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.Session at\n%s", m.funcSessionOrigin)
 	}
 
-	if !m.DeletePersonByIDMock.invocationsDone() && afterDeletePersonByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to PersonRepositoryMock.DeletePersonByID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeletePersonByIDMock.expectedInvocations), m.DeletePersonByIDMock.expectedInvocationsOrigin, afterDeletePersonByIDCounter)
+	if !m.SessionMock.invocationsDone() && afterSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.Session at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SessionMock.expectedInvocations), m.SessionMock.expectedInvocationsOrigin, afterSessionCounter)
 	}
 }
 
-type mPersonRepositoryMockGetAllPerson struct {
+type mPersonRepositoryMockUpdateEmployment struct {
 	optional           bool
 	mock               *PersonRepositoryMock
-	defaultExpectation *PersonRepositoryMockGetAllPersonExpectation
-	expectations       []*PersonRepositoryMockGetAllPersonExpectation
+	defaultExpectation *PersonRepositoryMockUpdateEmploymentExpectation
+	expectations       []*PersonRepositoryMockUpdateEmploymentExpectation
+
+	callArgs []*PersonRepositoryMockUpdateEmploymentParams
+	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// PersonRepositoryMockGetAllPersonExpectation specifies expectation struct of the personRepository.GetAllPerson
-type PersonRepositoryMockGetAllPersonExpectation struct {
-	mock *PersonRepositoryMock
+// PersonRepositoryMockUpdateEmploymentExpectation specifies expectation struct of the PersonRepository.UpdateEmployment
+type PersonRepositoryMockUpdateEmploymentExpectation struct {
+	mock               *PersonRepositoryMock
+	params             *PersonRepositoryMockUpdateEmploymentParams
+	paramPtrs          *PersonRepositoryMockUpdateEmploymentParamPtrs
+	expectationOrigins PersonRepositoryMockUpdateEmploymentExpectationOrigins
+	results            *PersonRepositoryMockUpdateEmploymentResults
+	returnOrigin       string
+	Counter            uint64
+}
 
-	results      *PersonRepositoryMockGetAllPersonResults
-	returnOrigin string
-	Counter      uint64
+// PersonRepositoryMockUpdateEmploymentParams contains parameters of the PersonRepository.UpdateEmployment
+type PersonRepositoryMockUpdateEmploymentParams struct {
+	personID     int32
+	employmentID int32
+	employment   models.Employment
 }
 
-// PersonRepositoryMockGetAllPersonResults contains results of the personRepository.GetAllPerson
-type PersonRepositoryMockGetAllPersonResults struct {
-	pa1 []models.Person
+// PersonRepositoryMockUpdateEmploymentParamPtrs contains pointers to parameters of the PersonRepository.UpdateEmployment
+type PersonRepositoryMockUpdateEmploymentParamPtrs struct {
+	personID     *int32
+	employmentID *int32
+	employment   *models.Employment
+}
+
+// PersonRepositoryMockUpdateEmploymentResults contains results of the PersonRepository.UpdateEmployment
+type PersonRepositoryMockUpdateEmploymentResults struct {
 	err error
 }
 
+// PersonRepositoryMockUpdateEmploymentOrigins contains origins of expectations of the PersonRepository.UpdateEmployment
+type PersonRepositoryMockUpdateEmploymentExpectationOrigins struct {
+	origin             string
+	originPersonID     string
+	originEmploymentID string
+	originEmployment   string
+}
+
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
 // the test will fail minimock's automatic final call check if the mocked method was not called at least once.
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Optional() *mPersonRepositoryMockGetAllPerson {
-	mmGetAllPerson.optional = true
-	return mmGetAllPerson
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) Optional() *mPersonRepositoryMockUpdateEmployment {
+	mmUpdateEmployment.optional = true
+	return mmUpdateEmployment
 }
 
-// Expect sets up expected params for personRepository.GetAllPerson
-func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Expect() *mPersonRepositoryMockGetAllPerson {
-	if mmGetAllPerson.mock.funcGetAllPerson != nil {
-		mmGetAllPerson.mock.t.Fatalf("PersonRepositoryMock.GetAllPerson mock is already set by Set")
+// Expect sets up expected params for PersonRepository.UpdateEmployment
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) Expect(personID int32, employmentID int32, employment models.Employment) *mPersonRepositoryMockUpdateEmployment {
+	if mmUpdateEmployment.mock.funcUpdateEmployment != nil {
+		mmUpdateEmployment.mock.t.Fatalf("PersonRepositoryMock.UpdateEmployment mock is already set by Set")
 	}
 
-	if mmGetAllPerson.defaultExpectation == nil {
-		mmGetAllPerson.defaultExpectation = &PersonRepositoryMockGetAllPersonExpectation{}
+	if mmUpdateEmployment.defaultExpectation == nil {
+		mmUpdateEmployment.defaultExpectation = &PersonRepositoryMockUpdateEmploymentExpectation{}
 	}
 
-	return mmGetAllPerson
+	if mmUpdateEmployment.defaultExpectation.paramPtrs != nil {
+		mmUpdateEmployment.mock.t.Fatalf("PersonRepositoryMock.UpdateEmployment mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateEmployment.defaultExpectation.params = &PersonRepositoryMockUpdateEmploymentParams{personID, employmentID, employment}
+	mmUpdateEmployment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateEmployment.expectations {
+		if minimock.Equal(e.params, mmUpdateEmployment.defaultExpectation.params) {
+			mmUpdateEmployment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateEmployment.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateEmployment
 }
 
-// Inspect accepts an inspector function that has same arguments as the personRepository.GetAllPerson
-func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Inspect(f func()) *mPersonRepositoryMockGetAllPerson {
-	if mmGetAllPerson.mock.inspectFuncGetAllPerson != nil {
-		mmGetAllPerson.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetAllPerson")
+// ExpectPersonIDParam1 sets up expected param personID for PersonRepository.UpdateEmployment
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) ExpectPersonIDParam1(personID int32) *mPersonRepositoryMockUpdateEmployment {
+	if mmUpdateEmployment.mock.funcUpdateEmployment != nil {
+		mmUpdateEmployment.mock.t.Fatalf("PersonRepositoryMock.UpdateEmployment mock is already set by Set")
 	}
 
-	mmGetAllPerson.mock.inspectFuncGetAllPerson = f
+	if mmUpdateEmployment.defaultExpectation == nil {
+		mmUpdateEmployment.defaultExpectation = &PersonRepositoryMockUpdateEmploymentExpectation{}
+	}
 
-	return mmGetAllPerson
+	if mmUpdateEmployment.defaultExpectation.params != nil {
+		mmUpdateEmployment.mock.t.Fatalf("PersonRepositoryMock.UpdateEmployment mock is already set by Expect")
+	}
+
+	if mmUpdateEmployment.defaultExpectation.paramPtrs == nil {
+		mmUpdateEmployment.defaultExpectation.paramPtrs = &PersonRepositoryMockUpdateEmploymentParamPtrs{}
+	}
+	mmUpdateEmployment.defaultExpectation.paramPtrs.personID = &personID
+	mmUpdateEmployment.defaultExpectation.expectationOrigins.originPersonID = minimock.CallerInfo(1)
+
+	return mmUpdateEmployment
 }
 
-// Return sets up results that will be returned by personRepository.GetAllPerson
-func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Return(pa1 []models.Person, err error) *PersonRepositoryMock {
-	if mmGetAllPerson.mock.funcGetAllPerson != nil {
-		mmGetAllPerson.mock.t.Fatalf("PersonRepositoryMock.GetAllPerson mock is already set by Set")
+// ExpectEmploymentIDParam2 sets up expected param employmentID for PersonRepository.UpdateEmployment
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) ExpectEmploymentIDParam2(employmentID int32) *mPersonRepositoryMockUpdateEmployment {
+	if mmUpdateEmployment.mock.funcUpdateEmployment != nil {
+		mmUpdateEmployment.mock.t.Fatalf("PersonRepositoryMock.UpdateEmployment mock is already set by Set")
 	}
 
-	if mmGetAllPerson.defaultExpectation == nil {
-		mmGetAllPerson.defaultExpectation = &PersonRepositoryMockGetAllPersonExpectation{mock: mmGetAllPerson.mock}
+	if mmUpdateEmployment.defaultExpectation == nil {
+		mmUpdateEmployment.defaultExpectation = &PersonRepositoryMockUpdateEmploymentExpectation{}
 	}
-	mmGetAllPerson.defaultExpectation.results = &PersonRepositoryMockGetAllPersonResults{pa1, err}
-	mmGetAllPerson.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetAllPerson.mock
+
+	if mmUpdateEmployment.defaultExpectation.params != nil {
+		mmUpdateEmployment.mock.t.Fatalf("PersonRepositoryMock.UpdateEmployment mock is already set by Expect")
+	}
+
+	if mmUpdateEmployment.defaultExpectation.paramPtrs == nil {
+		mmUpdateEmployment.defaultExpectation.paramPtrs = &PersonRepositoryMockUpdateEmploymentParamPtrs{}
+	}
+	mmUpdateEmployment.defaultExpectation.paramPtrs.employmentID = &employmentID
+	mmUpdateEmployment.defaultExpectation.expectationOrigins.originEmploymentID = minimock.CallerInfo(1)
+
+	return mmUpdateEmployment
 }
 
-// Set uses given function f to mock the personRepository.GetAllPerson method
-func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Set(f func() (pa1 []models.Person, err error)) *PersonRepositoryMock {
-	if mmGetAllPerson.defaultExpectation != nil {
-		mmGetAllPerson.mock.t.Fatalf("Default expectation is already set for the personRepository.GetAllPerson method")
+// ExpectEmploymentParam3 sets up expected param employment for PersonRepository.UpdateEmployment
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) ExpectEmploymentParam3(employment models.Employment) *mPersonRepositoryMockUpdateEmployment {
+	if mmUpdateEmployment.mock.funcUpdateEmployment != nil {
+		mmUpdateEmployment.mock.t.Fatalf("PersonRepositoryMock.UpdateEmployment mock is already set by Set")
 	}
 
-	if len(mmGetAllPerson.expectations) > 0 {
-		mmGetAllPerson.mock.t.Fatalf("Some expectations are already set for the personRepository.GetAllPerson method")
+	if mmUpdateEmployment.defaultExpectation == nil {
+		mmUpdateEmployment.defaultExpectation = &PersonRepositoryMockUpdateEmploymentExpectation{}
 	}
 
-	mmGetAllPerson.mock.funcGetAllPerson = f
-	mmGetAllPerson.mock.funcGetAllPersonOrigin = minimock.CallerInfo(1)
-	return mmGetAllPerson.mock
+	if mmUpdateEmployment.defaultExpectation.params != nil {
+		mmUpdateEmployment.mock.t.Fatalf("PersonRepositoryMock.UpdateEmployment mock is already set by Expect")
+	}
+
+	if mmUpdateEmployment.defaultExpectation.paramPtrs == nil {
+		mmUpdateEmployment.defaultExpectation.paramPtrs = &PersonRepositoryMockUpdateEmploymentParamPtrs{}
+	}
+	mmUpdateEmployment.defaultExpectation.paramPtrs.employment = &employment
+	mmUpdateEmployment.defaultExpectation.expectationOrigins.originEmployment = minimock.CallerInfo(1)
+
+	return mmUpdateEmployment
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.UpdateEmployment
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) Inspect(f func(personID int32, employmentID int32, employment models.Employment)) *mPersonRepositoryMockUpdateEmployment {
+	if mmUpdateEmployment.mock.inspectFuncUpdateEmployment != nil {
+		mmUpdateEmployment.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.UpdateEmployment")
+	}
+
+	mmUpdateEmployment.mock.inspectFuncUpdateEmployment = f
+
+	return mmUpdateEmployment
+}
+
+// Return sets up results that will be returned by PersonRepository.UpdateEmployment
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) Return(err error) *PersonRepositoryMock {
+	if mmUpdateEmployment.mock.funcUpdateEmployment != nil {
+		mmUpdateEmployment.mock.t.Fatalf("PersonRepositoryMock.UpdateEmployment mock is already set by Set")
+	}
+
+	if mmUpdateEmployment.defaultExpectation == nil {
+		mmUpdateEmployment.defaultExpectation = &PersonRepositoryMockUpdateEmploymentExpectation{mock: mmUpdateEmployment.mock}
+	}
+	mmUpdateEmployment.defaultExpectation.results = &PersonRepositoryMockUpdateEmploymentResults{err}
+	mmUpdateEmployment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateEmployment.mock
+}
+
+// Set uses given function f to mock the PersonRepository.UpdateEmployment method
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) Set(f func(personID int32, employmentID int32, employment models.Employment) (err error)) *PersonRepositoryMock {
+	if mmUpdateEmployment.defaultExpectation != nil {
+		mmUpdateEmployment.mock.t.Fatalf("Default expectation is already set for the PersonRepository.UpdateEmployment method")
+	}
+
+	if len(mmUpdateEmployment.expectations) > 0 {
+		mmUpdateEmployment.mock.t.Fatalf("Some expectations are already set for the PersonRepository.UpdateEmployment method")
+	}
+
+	mmUpdateEmployment.mock.funcUpdateEmployment = f
+	mmUpdateEmployment.mock.funcUpdateEmploymentOrigin = minimock.CallerInfo(1)
+	return mmUpdateEmployment.mock
+}
+
+// When sets expectation for the PersonRepository.UpdateEmployment which will trigger the result defined by the following
+// Then helper
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) When(personID int32, employmentID int32, employment models.Employment) *PersonRepositoryMockUpdateEmploymentExpectation {
+	if mmUpdateEmployment.mock.funcUpdateEmployment != nil {
+		mmUpdateEmployment.mock.t.Fatalf("PersonRepositoryMock.UpdateEmployment mock is already set by Set")
+	}
+
+	expectation := &PersonRepositoryMockUpdateEmploymentExpectation{
+		mock:               mmUpdateEmployment.mock,
+		params:             &PersonRepositoryMockUpdateEmploymentParams{personID, employmentID, employment},
+		expectationOrigins: PersonRepositoryMockUpdateEmploymentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateEmployment.expectations = append(mmUpdateEmployment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonRepository.UpdateEmployment return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockUpdateEmploymentExpectation) Then(err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockUpdateEmploymentResults{err}
+	return e.mock
 }
 
-// Times sets number of times personRepository.GetAllPerson should be invoked
-func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) Times(n uint64) *mPersonRepositoryMockGetAllPerson {
+// Times sets number of times PersonRepository.UpdateEmployment should be invoked
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) Times(n uint64) *mPersonRepositoryMockUpdateEmployment {
 	if n == 0 {
-		mmGetAllPerson.mock.t.Fatalf("Times of PersonRepositoryMock.GetAllPerson mock can not be zero")
+		mmUpdateEmployment.mock.t.Fatalf("Times of PersonRepositoryMock.UpdateEmployment mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetAllPerson.expectedInvocations, n)
-	mmGetAllPerson.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetAllPerson
+	mm_atomic.StoreUint64(&mmUpdateEmployment.expectedInvocations, n)
+	mmUpdateEmployment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateEmployment
 }
 
-func (mmGetAllPerson *mPersonRepositoryMockGetAllPerson) invocationsDone() bool {
-	if len(mmGetAllPerson.expectations) == 0 && mmGetAllPerson.defaultExpectation == nil && mmGetAllPerson.mock.funcGetAllPerson == nil {
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) invocationsDone() bool {
+	if len(mmUpdateEmployment.expectations) == 0 && mmUpdateEmployment.defaultExpectation == nil && mmUpdateEmployment.mock.funcUpdateEmployment == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetAllPerson.mock.afterGetAllPersonCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetAllPerson.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateEmployment.mock.afterUpdateEmploymentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateEmployment.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetAllPerson implements personRepository
-func (mmGetAllPerson *PersonRepositoryMock) GetAllPerson() (pa1 []models.Person, err error) {
-	mm_atomic.AddUint64(&mmGetAllPerson.beforeGetAllPersonCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetAllPerson.afterGetAllPersonCounter, 1)
+// UpdateEmployment implements PersonRepository
+func (mmUpdateEmployment *PersonRepositoryMock) UpdateEmployment(personID int32, employmentID int32, employment models.Employment) (err error) {
+	mm_atomic.AddUint64(&mmUpdateEmployment.beforeUpdateEmploymentCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateEmployment.afterUpdateEmploymentCounter, 1)
 
-	mmGetAllPerson.t.Helper()
+	mmUpdateEmployment.t.Helper()
 
-	if mmGetAllPerson.inspectFuncGetAllPerson != nil {
-		mmGetAllPerson.inspectFuncGetAllPerson()
+	if mmUpdateEmployment.inspectFuncUpdateEmployment != nil {
+		mmUpdateEmployment.inspectFuncUpdateEmployment(personID, employmentID, employment)
 	}
 
-	if mmGetAllPerson.GetAllPersonMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetAllPerson.GetAllPersonMock.defaultExpectation.Counter, 1)
+	mm_params := PersonRepositoryMockUpdateEmploymentParams{personID, employmentID, employment}
 
-		mm_results := mmGetAllPerson.GetAllPersonMock.defaultExpectation.results
+	// Record call args
+	mmUpdateEmployment.UpdateEmploymentMock.mutex.Lock()
+	mmUpdateEmployment.UpdateEmploymentMock.callArgs = append(mmUpdateEmployment.UpdateEmploymentMock.callArgs, &mm_params)
+	mmUpdateEmployment.UpdateEmploymentMock.mutex.Unlock()
+
+	for _, e := range mmUpdateEmployment.UpdateEmploymentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateEmployment.UpdateEmploymentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateEmployment.UpdateEmploymentMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateEmployment.UpdateEmploymentMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateEmployment.UpdateEmploymentMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonRepositoryMockUpdateEmploymentParams{personID, employmentID, employment}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.personID != nil && !minimock.Equal(*mm_want_ptrs.personID, mm_got.personID) {
+				mmUpdateEmployment.t.Errorf("PersonRepositoryMock.UpdateEmployment got unexpected parameter personID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEmployment.UpdateEmploymentMock.defaultExpectation.expectationOrigins.originPersonID, *mm_want_ptrs.personID, mm_got.personID, minimock.Diff(*mm_want_ptrs.personID, mm_got.personID))
+			}
+
+			if mm_want_ptrs.employmentID != nil && !minimock.Equal(*mm_want_ptrs.employmentID, mm_got.employmentID) {
+				mmUpdateEmployment.t.Errorf("PersonRepositoryMock.UpdateEmployment got unexpected parameter employmentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEmployment.UpdateEmploymentMock.defaultExpectation.expectationOrigins.originEmploymentID, *mm_want_ptrs.employmentID, mm_got.employmentID, minimock.Diff(*mm_want_ptrs.employmentID, mm_got.employmentID))
+			}
+
+			if mm_want_ptrs.employment != nil && !minimock.Equal(*mm_want_ptrs.employment, mm_got.employment) {
+				mmUpdateEmployment.t.Errorf("PersonRepositoryMock.UpdateEmployment got unexpected parameter employment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEmployment.UpdateEmploymentMock.defaultExpectation.expectationOrigins.originEmployment, *mm_want_ptrs.employment, mm_got.employment, minimock.Diff(*mm_want_ptrs.employment, mm_got.employment))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateEmployment.t.Errorf("PersonRepositoryMock.UpdateEmployment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateEmployment.UpdateEmploymentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateEmployment.UpdateEmploymentMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetAllPerson.t.Fatal("No results are set for the PersonRepositoryMock.GetAllPerson")
+			mmUpdateEmployment.t.Fatal("No results are set for the PersonRepositoryMock.UpdateEmployment")
 		}
-		return (*mm_results).pa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetAllPerson.funcGetAllPerson != nil {
-		return mmGetAllPerson.funcGetAllPerson()
+	if mmUpdateEmployment.funcUpdateEmployment != nil {
+		return mmUpdateEmployment.funcUpdateEmployment(personID, employmentID, employment)
 	}
-	mmGetAllPerson.t.Fatalf("Unexpected call to PersonRepositoryMock.GetAllPerson.")
+	mmUpdateEmployment.t.Fatalf("Unexpected call to PersonRepositoryMock.UpdateEmployment. %v %v %v", personID, employmentID, employment)
 	return
 }
 
-// GetAllPersonAfterCounter returns a count of finished PersonRepositoryMock.GetAllPerson invocations
-func (mmGetAllPerson *PersonRepositoryMock) GetAllPersonAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetAllPerson.afterGetAllPersonCounter)
+// UpdateEmploymentAfterCounter returns a count of finished PersonRepositoryMock.UpdateEmployment invocations
+func (mmUpdateEmployment *PersonRepositoryMock) UpdateEmploymentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateEmployment.afterUpdateEmploymentCounter)
 }
 
-// GetAllPersonBeforeCounter returns a count of PersonRepositoryMock.GetAllPerson invocations
-func (mmGetAllPerson *PersonRepositoryMock) GetAllPersonBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetAllPerson.beforeGetAllPersonCounter)
+// UpdateEmploymentBeforeCounter returns a count of PersonRepositoryMock.UpdateEmployment invocations
+func (mmUpdateEmployment *PersonRepositoryMock) UpdateEmploymentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateEmployment.beforeUpdateEmploymentCounter)
 }
 
-// MinimockGetAllPersonDone returns true if the count of the GetAllPerson invocations corresponds
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.UpdateEmployment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateEmployment *mPersonRepositoryMockUpdateEmployment) Calls() []*PersonRepositoryMockUpdateEmploymentParams {
+	mmUpdateEmployment.mutex.RLock()
+
+	argCopy := make([]*PersonRepositoryMockUpdateEmploymentParams, len(mmUpdateEmployment.callArgs))
+	copy(argCopy, mmUpdateEmployment.callArgs)
+
+	mmUpdateEmployment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateEmploymentDone returns true if the count of the UpdateEmployment invocations corresponds
 // the number of defined expectations
-func (m *PersonRepositoryMock) MinimockGetAllPersonDone() bool {
-	if m.GetAllPersonMock.optional {
+func (m *PersonRepositoryMock) MinimockUpdateEmploymentDone() bool {
+	if m.UpdateEmploymentMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetAllPersonMock.expectations {
+	for _, e := range m.UpdateEmploymentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetAllPersonMock.invocationsDone()
+	return m.UpdateEmploymentMock.invocationsDone()
 }
 
-// MinimockGetAllPersonInspect logs each unmet expectation
-func (m *PersonRepositoryMock) MinimockGetAllPersonInspect() {
-	for _, e := range m.GetAllPersonMock.expectations {
+// MinimockUpdateEmploymentInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockUpdateEmploymentInspect() {
+	for _, e := range m.UpdateEmploymentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to PersonRepositoryMock.GetAllPerson")
+			m.t.Errorf("Expected call to PersonRepositoryMock.UpdateEmployment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetAllPersonCounter := mm_atomic.LoadUint64(&m.afterGetAllPersonCounter)
+	afterUpdateEmploymentCounter := mm_atomic.LoadUint64(&m.afterUpdateEmploymentCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetAllPersonMock.defaultExpectation != nil && afterGetAllPersonCounter < 1 {
-		m.t.Errorf("Expected call to PersonRepositoryMock.GetAllPerson at\n%s", m.GetAllPersonMock.defaultExpectation.returnOrigin)
+	if m.UpdateEmploymentMock.defaultExpectation != nil && afterUpdateEmploymentCounter < 1 {
+		if m.UpdateEmploymentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.UpdateEmployment at\n%s", m.UpdateEmploymentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonRepositoryMock.UpdateEmployment at\n%s with params: %#v", m.UpdateEmploymentMock.defaultExpectation.expectationOrigins.origin, *m.UpdateEmploymentMock.defaultExpectation.params)
+		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetAllPerson != nil && afterGetAllPersonCounter < 1 {
-		m.t.Errorf("Expected call to PersonRepositoryMock.GetAllPerson at\n%s", m.funcGetAllPersonOrigin)
+	if m.funcUpdateEmployment != nil && afterUpdateEmploymentCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.UpdateEmployment at\n%s", m.funcUpdateEmploymentOrigin)
 	}
 
-	if !m.GetAllPersonMock.invocationsDone() && afterGetAllPersonCounter > 0 {
-		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetAllPerson at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetAllPersonMock.expectedInvocations), m.GetAllPersonMock.expectedInvocationsOrigin, afterGetAllPersonCounter)
+	if !m.UpdateEmploymentMock.invocationsDone() && afterUpdateEmploymentCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.UpdateEmployment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateEmploymentMock.expectedInvocations), m.UpdateEmploymentMock.expectedInvocationsOrigin, afterUpdateEmploymentCounter)
 	}
 }
 
-type mPersonRepositoryMockGetPersonByID struct {
+type mPersonRepositoryMockUpdateOrganizationByID struct {
 	optional           bool
 	mock               *PersonRepositoryMock
-	defaultExpectation *PersonRepositoryMockGetPersonByIDExpectation
-	expectations       []*PersonRepositoryMockGetPersonByIDExpectation
+	defaultExpectation *PersonRepositoryMockUpdateOrganizationByIDExpectation
+	expectations       []*PersonRepositoryMockUpdateOrganizationByIDExpectation
 
-	callArgs []*PersonRepositoryMockGetPersonByIDParams
+	callArgs []*PersonRepositoryMockUpdateOrganizationByIDParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// PersonRepositoryMockGetPersonByIDExpectation specifies expectation struct of the personRepository.GetPersonByID
-type PersonRepositoryMockGetPersonByIDExpectation struct {
+// PersonRepositoryMockUpdateOrganizationByIDExpectation specifies expectation struct of the PersonRepository.UpdateOrganizationByID
+type PersonRepositoryMockUpdateOrganizationByIDExpectation struct {
 	mock               *PersonRepositoryMock
-	params             *PersonRepositoryMockGetPersonByIDParams
-	paramPtrs          *PersonRepositoryMockGetPersonByIDParamPtrs
-	expectationOrigins PersonRepositoryMockGetPersonByIDExpectationOrigins
-	results            *PersonRepositoryMockGetPersonByIDResults
+	params             *PersonRepositoryMockUpdateOrganizationByIDParams
+	paramPtrs          *PersonRepositoryMockUpdateOrganizationByIDParamPtrs
+	expectationOrigins PersonRepositoryMockUpdateOrganizationByIDExpectationOrigins
+	results            *PersonRepositoryMockUpdateOrganizationByIDResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// PersonRepositoryMockGetPersonByIDParams contains parameters of the personRepository.GetPersonByID
-type PersonRepositoryMockGetPersonByIDParams struct {
-	id int32
+// PersonRepositoryMockUpdateOrganizationByIDParams contains parameters of the PersonRepository.UpdateOrganizationByID
+type PersonRepositoryMockUpdateOrganizationByIDParams struct {
+	id           int32
+	organization models.Organization
 }
 
-// PersonRepositoryMockGetPersonByIDParamPtrs contains pointers to parameters of the personRepository.GetPersonByID
-type PersonRepositoryMockGetPersonByIDParamPtrs struct {
-	id *int32
+// PersonRepositoryMockUpdateOrganizationByIDParamPtrs contains pointers to parameters of the PersonRepository.UpdateOrganizationByID
+type PersonRepositoryMockUpdateOrganizationByIDParamPtrs struct {
+	id           *int32
+	organization *models.Organization
 }
 
-// PersonRepositoryMockGetPersonByIDResults contains results of the personRepository.GetPersonByID
-type PersonRepositoryMockGetPersonByIDResults struct {
-	p1  models.Person
+// PersonRepositoryMockUpdateOrganizationByIDResults contains results of the PersonRepository.UpdateOrganizationByID
+type PersonRepositoryMockUpdateOrganizationByIDResults struct {
 	err error
 }
 
-// PersonRepositoryMockGetPersonByIDOrigins contains origins of expectations of the personRepository.GetPersonByID
-type PersonRepositoryMockGetPersonByIDExpectationOrigins struct {
-	origin   string
-	originId string
+// PersonRepositoryMockUpdateOrganizationByIDOrigins contains origins of expectations of the PersonRepository.UpdateOrganizationByID
+type PersonRepositoryMockUpdateOrganizationByIDExpectationOrigins struct {
+	origin             string
+	originId           string
+	originOrganization string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -940,264 +8114,292 @@ type PersonRepositoryMockGetPersonByIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Optional() *mPersonRepositoryMockGetPersonByID {
-	mmGetPersonByID.optional = true
-	return mmGetPersonByID
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) Optional() *mPersonRepositoryMockUpdateOrganizationByID {
+	mmUpdateOrganizationByID.optional = true
+	return mmUpdateOrganizationByID
 }
 
-// Expect sets up expected params for personRepository.GetPersonByID
-func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Expect(id int32) *mPersonRepositoryMockGetPersonByID {
-	if mmGetPersonByID.mock.funcGetPersonByID != nil {
-		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by Set")
+// Expect sets up expected params for PersonRepository.UpdateOrganizationByID
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) Expect(id int32, organization models.Organization) *mPersonRepositoryMockUpdateOrganizationByID {
+	if mmUpdateOrganizationByID.mock.funcUpdateOrganizationByID != nil {
+		mmUpdateOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.UpdateOrganizationByID mock is already set by Set")
 	}
 
-	if mmGetPersonByID.defaultExpectation == nil {
-		mmGetPersonByID.defaultExpectation = &PersonRepositoryMockGetPersonByIDExpectation{}
+	if mmUpdateOrganizationByID.defaultExpectation == nil {
+		mmUpdateOrganizationByID.defaultExpectation = &PersonRepositoryMockUpdateOrganizationByIDExpectation{}
 	}
 
-	if mmGetPersonByID.defaultExpectation.paramPtrs != nil {
-		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by ExpectParams functions")
+	if mmUpdateOrganizationByID.defaultExpectation.paramPtrs != nil {
+		mmUpdateOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.UpdateOrganizationByID mock is already set by ExpectParams functions")
 	}
 
-	mmGetPersonByID.defaultExpectation.params = &PersonRepositoryMockGetPersonByIDParams{id}
-	mmGetPersonByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetPersonByID.expectations {
-		if minimock.Equal(e.params, mmGetPersonByID.defaultExpectation.params) {
-			mmGetPersonByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPersonByID.defaultExpectation.params)
+	mmUpdateOrganizationByID.defaultExpectation.params = &PersonRepositoryMockUpdateOrganizationByIDParams{id, organization}
+	mmUpdateOrganizationByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateOrganizationByID.expectations {
+		if minimock.Equal(e.params, mmUpdateOrganizationByID.defaultExpectation.params) {
+			mmUpdateOrganizationByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateOrganizationByID.defaultExpectation.params)
 		}
 	}
 
-	return mmGetPersonByID
+	return mmUpdateOrganizationByID
 }
 
-// ExpectIdParam1 sets up expected param id for personRepository.GetPersonByID
-func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) ExpectIdParam1(id int32) *mPersonRepositoryMockGetPersonByID {
-	if mmGetPersonByID.mock.funcGetPersonByID != nil {
-		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by Set")
+// ExpectIdParam1 sets up expected param id for PersonRepository.UpdateOrganizationByID
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) ExpectIdParam1(id int32) *mPersonRepositoryMockUpdateOrganizationByID {
+	if mmUpdateOrganizationByID.mock.funcUpdateOrganizationByID != nil {
+		mmUpdateOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.UpdateOrganizationByID mock is already set by Set")
 	}
 
-	if mmGetPersonByID.defaultExpectation == nil {
-		mmGetPersonByID.defaultExpectation = &PersonRepositoryMockGetPersonByIDExpectation{}
+	if mmUpdateOrganizationByID.defaultExpectation == nil {
+		mmUpdateOrganizationByID.defaultExpectation = &PersonRepositoryMockUpdateOrganizationByIDExpectation{}
 	}
 
-	if mmGetPersonByID.defaultExpectation.params != nil {
-		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by Expect")
+	if mmUpdateOrganizationByID.defaultExpectation.params != nil {
+		mmUpdateOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.UpdateOrganizationByID mock is already set by Expect")
 	}
 
-	if mmGetPersonByID.defaultExpectation.paramPtrs == nil {
-		mmGetPersonByID.defaultExpectation.paramPtrs = &PersonRepositoryMockGetPersonByIDParamPtrs{}
+	if mmUpdateOrganizationByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateOrganizationByID.defaultExpectation.paramPtrs = &PersonRepositoryMockUpdateOrganizationByIDParamPtrs{}
 	}
-	mmGetPersonByID.defaultExpectation.paramPtrs.id = &id
-	mmGetPersonByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmUpdateOrganizationByID.defaultExpectation.paramPtrs.id = &id
+	mmUpdateOrganizationByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmGetPersonByID
+	return mmUpdateOrganizationByID
 }
 
-// Inspect accepts an inspector function that has same arguments as the personRepository.GetPersonByID
-func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Inspect(f func(id int32)) *mPersonRepositoryMockGetPersonByID {
-	if mmGetPersonByID.mock.inspectFuncGetPersonByID != nil {
-		mmGetPersonByID.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.GetPersonByID")
+// ExpectOrganizationParam2 sets up expected param organization for PersonRepository.UpdateOrganizationByID
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) ExpectOrganizationParam2(organization models.Organization) *mPersonRepositoryMockUpdateOrganizationByID {
+	if mmUpdateOrganizationByID.mock.funcUpdateOrganizationByID != nil {
+		mmUpdateOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.UpdateOrganizationByID mock is already set by Set")
 	}
 
-	mmGetPersonByID.mock.inspectFuncGetPersonByID = f
+	if mmUpdateOrganizationByID.defaultExpectation == nil {
+		mmUpdateOrganizationByID.defaultExpectation = &PersonRepositoryMockUpdateOrganizationByIDExpectation{}
+	}
 
-	return mmGetPersonByID
+	if mmUpdateOrganizationByID.defaultExpectation.params != nil {
+		mmUpdateOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.UpdateOrganizationByID mock is already set by Expect")
+	}
+
+	if mmUpdateOrganizationByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateOrganizationByID.defaultExpectation.paramPtrs = &PersonRepositoryMockUpdateOrganizationByIDParamPtrs{}
+	}
+	mmUpdateOrganizationByID.defaultExpectation.paramPtrs.organization = &organization
+	mmUpdateOrganizationByID.defaultExpectation.expectationOrigins.originOrganization = minimock.CallerInfo(1)
+
+	return mmUpdateOrganizationByID
 }
 
-// Return sets up results that will be returned by personRepository.GetPersonByID
-func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Return(p1 models.Person, err error) *PersonRepositoryMock {
-	if mmGetPersonByID.mock.funcGetPersonByID != nil {
-		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.UpdateOrganizationByID
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) Inspect(f func(id int32, organization models.Organization)) *mPersonRepositoryMockUpdateOrganizationByID {
+	if mmUpdateOrganizationByID.mock.inspectFuncUpdateOrganizationByID != nil {
+		mmUpdateOrganizationByID.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.UpdateOrganizationByID")
 	}
 
-	if mmGetPersonByID.defaultExpectation == nil {
-		mmGetPersonByID.defaultExpectation = &PersonRepositoryMockGetPersonByIDExpectation{mock: mmGetPersonByID.mock}
+	mmUpdateOrganizationByID.mock.inspectFuncUpdateOrganizationByID = f
+
+	return mmUpdateOrganizationByID
+}
+
+// Return sets up results that will be returned by PersonRepository.UpdateOrganizationByID
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) Return(err error) *PersonRepositoryMock {
+	if mmUpdateOrganizationByID.mock.funcUpdateOrganizationByID != nil {
+		mmUpdateOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.UpdateOrganizationByID mock is already set by Set")
 	}
-	mmGetPersonByID.defaultExpectation.results = &PersonRepositoryMockGetPersonByIDResults{p1, err}
-	mmGetPersonByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetPersonByID.mock
+
+	if mmUpdateOrganizationByID.defaultExpectation == nil {
+		mmUpdateOrganizationByID.defaultExpectation = &PersonRepositoryMockUpdateOrganizationByIDExpectation{mock: mmUpdateOrganizationByID.mock}
+	}
+	mmUpdateOrganizationByID.defaultExpectation.results = &PersonRepositoryMockUpdateOrganizationByIDResults{err}
+	mmUpdateOrganizationByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrganizationByID.mock
 }
 
-// Set uses given function f to mock the personRepository.GetPersonByID method
-func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Set(f func(id int32) (p1 models.Person, err error)) *PersonRepositoryMock {
-	if mmGetPersonByID.defaultExpectation != nil {
-		mmGetPersonByID.mock.t.Fatalf("Default expectation is already set for the personRepository.GetPersonByID method")
+// Set uses given function f to mock the PersonRepository.UpdateOrganizationByID method
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) Set(f func(id int32, organization models.Organization) (err error)) *PersonRepositoryMock {
+	if mmUpdateOrganizationByID.defaultExpectation != nil {
+		mmUpdateOrganizationByID.mock.t.Fatalf("Default expectation is already set for the PersonRepository.UpdateOrganizationByID method")
 	}
 
-	if len(mmGetPersonByID.expectations) > 0 {
-		mmGetPersonByID.mock.t.Fatalf("Some expectations are already set for the personRepository.GetPersonByID method")
+	if len(mmUpdateOrganizationByID.expectations) > 0 {
+		mmUpdateOrganizationByID.mock.t.Fatalf("Some expectations are already set for the PersonRepository.UpdateOrganizationByID method")
 	}
 
-	mmGetPersonByID.mock.funcGetPersonByID = f
-	mmGetPersonByID.mock.funcGetPersonByIDOrigin = minimock.CallerInfo(1)
-	return mmGetPersonByID.mock
+	mmUpdateOrganizationByID.mock.funcUpdateOrganizationByID = f
+	mmUpdateOrganizationByID.mock.funcUpdateOrganizationByIDOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrganizationByID.mock
 }
 
-// When sets expectation for the personRepository.GetPersonByID which will trigger the result defined by the following
+// When sets expectation for the PersonRepository.UpdateOrganizationByID which will trigger the result defined by the following
 // Then helper
-func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) When(id int32) *PersonRepositoryMockGetPersonByIDExpectation {
-	if mmGetPersonByID.mock.funcGetPersonByID != nil {
-		mmGetPersonByID.mock.t.Fatalf("PersonRepositoryMock.GetPersonByID mock is already set by Set")
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) When(id int32, organization models.Organization) *PersonRepositoryMockUpdateOrganizationByIDExpectation {
+	if mmUpdateOrganizationByID.mock.funcUpdateOrganizationByID != nil {
+		mmUpdateOrganizationByID.mock.t.Fatalf("PersonRepositoryMock.UpdateOrganizationByID mock is already set by Set")
 	}
 
-	expectation := &PersonRepositoryMockGetPersonByIDExpectation{
-		mock:               mmGetPersonByID.mock,
-		params:             &PersonRepositoryMockGetPersonByIDParams{id},
-		expectationOrigins: PersonRepositoryMockGetPersonByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &PersonRepositoryMockUpdateOrganizationByIDExpectation{
+		mock:               mmUpdateOrganizationByID.mock,
+		params:             &PersonRepositoryMockUpdateOrganizationByIDParams{id, organization},
+		expectationOrigins: PersonRepositoryMockUpdateOrganizationByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetPersonByID.expectations = append(mmGetPersonByID.expectations, expectation)
+	mmUpdateOrganizationByID.expectations = append(mmUpdateOrganizationByID.expectations, expectation)
 	return expectation
 }
 
-// Then sets up personRepository.GetPersonByID return parameters for the expectation previously defined by the When method
-func (e *PersonRepositoryMockGetPersonByIDExpectation) Then(p1 models.Person, err error) *PersonRepositoryMock {
-	e.results = &PersonRepositoryMockGetPersonByIDResults{p1, err}
+// Then sets up PersonRepository.UpdateOrganizationByID return parameters for the expectation previously defined by the When method
+func (e *PersonRepositoryMockUpdateOrganizationByIDExpectation) Then(err error) *PersonRepositoryMock {
+	e.results = &PersonRepositoryMockUpdateOrganizationByIDResults{err}
 	return e.mock
 }
 
-// Times sets number of times personRepository.GetPersonByID should be invoked
-func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Times(n uint64) *mPersonRepositoryMockGetPersonByID {
+// Times sets number of times PersonRepository.UpdateOrganizationByID should be invoked
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) Times(n uint64) *mPersonRepositoryMockUpdateOrganizationByID {
 	if n == 0 {
-		mmGetPersonByID.mock.t.Fatalf("Times of PersonRepositoryMock.GetPersonByID mock can not be zero")
+		mmUpdateOrganizationByID.mock.t.Fatalf("Times of PersonRepositoryMock.UpdateOrganizationByID mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetPersonByID.expectedInvocations, n)
-	mmGetPersonByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetPersonByID
+	mm_atomic.StoreUint64(&mmUpdateOrganizationByID.expectedInvocations, n)
+	mmUpdateOrganizationByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrganizationByID
 }
 
-func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) invocationsDone() bool {
-	if len(mmGetPersonByID.expectations) == 0 && mmGetPersonByID.defaultExpectation == nil && mmGetPersonByID.mock.funcGetPersonByID == nil {
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) invocationsDone() bool {
+	if len(mmUpdateOrganizationByID.expectations) == 0 && mmUpdateOrganizationByID.defaultExpectation == nil && mmUpdateOrganizationByID.mock.funcUpdateOrganizationByID == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetPersonByID.mock.afterGetPersonByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetPersonByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateOrganizationByID.mock.afterUpdateOrganizationByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateOrganizationByID.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetPersonByID implements personRepository
-func (mmGetPersonByID *PersonRepositoryMock) GetPersonByID(id int32) (p1 models.Person, err error) {
-	mm_atomic.AddUint64(&mmGetPersonByID.beforeGetPersonByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetPersonByID.afterGetPersonByIDCounter, 1)
+// UpdateOrganizationByID implements PersonRepository
+func (mmUpdateOrganizationByID *PersonRepositoryMock) UpdateOrganizationByID(id int32, organization models.Organization) (err error) {
+	mm_atomic.AddUint64(&mmUpdateOrganizationByID.beforeUpdateOrganizationByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateOrganizationByID.afterUpdateOrganizationByIDCounter, 1)
 
-	mmGetPersonByID.t.Helper()
+	mmUpdateOrganizationByID.t.Helper()
 
-	if mmGetPersonByID.inspectFuncGetPersonByID != nil {
-		mmGetPersonByID.inspectFuncGetPersonByID(id)
+	if mmUpdateOrganizationByID.inspectFuncUpdateOrganizationByID != nil {
+		mmUpdateOrganizationByID.inspectFuncUpdateOrganizationByID(id, organization)
 	}
 
-	mm_params := PersonRepositoryMockGetPersonByIDParams{id}
+	mm_params := PersonRepositoryMockUpdateOrganizationByIDParams{id, organization}
 
 	// Record call args
-	mmGetPersonByID.GetPersonByIDMock.mutex.Lock()
-	mmGetPersonByID.GetPersonByIDMock.callArgs = append(mmGetPersonByID.GetPersonByIDMock.callArgs, &mm_params)
-	mmGetPersonByID.GetPersonByIDMock.mutex.Unlock()
+	mmUpdateOrganizationByID.UpdateOrganizationByIDMock.mutex.Lock()
+	mmUpdateOrganizationByID.UpdateOrganizationByIDMock.callArgs = append(mmUpdateOrganizationByID.UpdateOrganizationByIDMock.callArgs, &mm_params)
+	mmUpdateOrganizationByID.UpdateOrganizationByIDMock.mutex.Unlock()
 
-	for _, e := range mmGetPersonByID.GetPersonByIDMock.expectations {
+	for _, e := range mmUpdateOrganizationByID.UpdateOrganizationByIDMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.p1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetPersonByID.GetPersonByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetPersonByID.GetPersonByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetPersonByID.GetPersonByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetPersonByID.GetPersonByIDMock.defaultExpectation.paramPtrs
+	if mmUpdateOrganizationByID.UpdateOrganizationByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateOrganizationByID.UpdateOrganizationByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateOrganizationByID.UpdateOrganizationByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateOrganizationByID.UpdateOrganizationByIDMock.defaultExpectation.paramPtrs
 
-		mm_got := PersonRepositoryMockGetPersonByIDParams{id}
+		mm_got := PersonRepositoryMockUpdateOrganizationByIDParams{id, organization}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGetPersonByID.t.Errorf("PersonRepositoryMock.GetPersonByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetPersonByID.GetPersonByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmUpdateOrganizationByID.t.Errorf("PersonRepositoryMock.UpdateOrganizationByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOrganizationByID.UpdateOrganizationByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.organization != nil && !minimock.Equal(*mm_want_ptrs.organization, mm_got.organization) {
+				mmUpdateOrganizationByID.t.Errorf("PersonRepositoryMock.UpdateOrganizationByID got unexpected parameter organization, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOrganizationByID.UpdateOrganizationByIDMock.defaultExpectation.expectationOrigins.originOrganization, *mm_want_ptrs.organization, mm_got.organization, minimock.Diff(*mm_want_ptrs.organization, mm_got.organization))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetPersonByID.t.Errorf("PersonRepositoryMock.GetPersonByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetPersonByID.GetPersonByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateOrganizationByID.t.Errorf("PersonRepositoryMock.UpdateOrganizationByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateOrganizationByID.UpdateOrganizationByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetPersonByID.GetPersonByIDMock.defaultExpectation.results
+		mm_results := mmUpdateOrganizationByID.UpdateOrganizationByIDMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetPersonByID.t.Fatal("No results are set for the PersonRepositoryMock.GetPersonByID")
+			mmUpdateOrganizationByID.t.Fatal("No results are set for the PersonRepositoryMock.UpdateOrganizationByID")
 		}
-		return (*mm_results).p1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetPersonByID.funcGetPersonByID != nil {
-		return mmGetPersonByID.funcGetPersonByID(id)
+	if mmUpdateOrganizationByID.funcUpdateOrganizationByID != nil {
+		return mmUpdateOrganizationByID.funcUpdateOrganizationByID(id, organization)
 	}
-	mmGetPersonByID.t.Fatalf("Unexpected call to PersonRepositoryMock.GetPersonByID. %v", id)
+	mmUpdateOrganizationByID.t.Fatalf("Unexpected call to PersonRepositoryMock.UpdateOrganizationByID. %v %v", id, organization)
 	return
 }
 
-// GetPersonByIDAfterCounter returns a count of finished PersonRepositoryMock.GetPersonByID invocations
-func (mmGetPersonByID *PersonRepositoryMock) GetPersonByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetPersonByID.afterGetPersonByIDCounter)
+// UpdateOrganizationByIDAfterCounter returns a count of finished PersonRepositoryMock.UpdateOrganizationByID invocations
+func (mmUpdateOrganizationByID *PersonRepositoryMock) UpdateOrganizationByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrganizationByID.afterUpdateOrganizationByIDCounter)
 }
 
-// GetPersonByIDBeforeCounter returns a count of PersonRepositoryMock.GetPersonByID invocations
-func (mmGetPersonByID *PersonRepositoryMock) GetPersonByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetPersonByID.beforeGetPersonByIDCounter)
+// UpdateOrganizationByIDBeforeCounter returns a count of PersonRepositoryMock.UpdateOrganizationByID invocations
+func (mmUpdateOrganizationByID *PersonRepositoryMock) UpdateOrganizationByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrganizationByID.beforeUpdateOrganizationByIDCounter)
 }
 
-// Calls returns a list of arguments used in each call to PersonRepositoryMock.GetPersonByID.
+// Calls returns a list of arguments used in each call to PersonRepositoryMock.UpdateOrganizationByID.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetPersonByID *mPersonRepositoryMockGetPersonByID) Calls() []*PersonRepositoryMockGetPersonByIDParams {
-	mmGetPersonByID.mutex.RLock()
+func (mmUpdateOrganizationByID *mPersonRepositoryMockUpdateOrganizationByID) Calls() []*PersonRepositoryMockUpdateOrganizationByIDParams {
+	mmUpdateOrganizationByID.mutex.RLock()
 
-	argCopy := make([]*PersonRepositoryMockGetPersonByIDParams, len(mmGetPersonByID.callArgs))
-	copy(argCopy, mmGetPersonByID.callArgs)
+	argCopy := make([]*PersonRepositoryMockUpdateOrganizationByIDParams, len(mmUpdateOrganizationByID.callArgs))
+	copy(argCopy, mmUpdateOrganizationByID.callArgs)
 
-	mmGetPersonByID.mutex.RUnlock()
+	mmUpdateOrganizationByID.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetPersonByIDDone returns true if the count of the GetPersonByID invocations corresponds
+// MinimockUpdateOrganizationByIDDone returns true if the count of the UpdateOrganizationByID invocations corresponds
 // the number of defined expectations
-func (m *PersonRepositoryMock) MinimockGetPersonByIDDone() bool {
-	if m.GetPersonByIDMock.optional {
+func (m *PersonRepositoryMock) MinimockUpdateOrganizationByIDDone() bool {
+	if m.UpdateOrganizationByIDMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetPersonByIDMock.expectations {
+	for _, e := range m.UpdateOrganizationByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetPersonByIDMock.invocationsDone()
+	return m.UpdateOrganizationByIDMock.invocationsDone()
 }
 
-// MinimockGetPersonByIDInspect logs each unmet expectation
-func (m *PersonRepositoryMock) MinimockGetPersonByIDInspect() {
-	for _, e := range m.GetPersonByIDMock.expectations {
+// MinimockUpdateOrganizationByIDInspect logs each unmet expectation
+func (m *PersonRepositoryMock) MinimockUpdateOrganizationByIDInspect() {
+	for _, e := range m.UpdateOrganizationByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to PersonRepositoryMock.UpdateOrganizationByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetPersonByIDCounter := mm_atomic.LoadUint64(&m.afterGetPersonByIDCounter)
+	afterUpdateOrganizationByIDCounter := mm_atomic.LoadUint64(&m.afterUpdateOrganizationByIDCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetPersonByIDMock.defaultExpectation != nil && afterGetPersonByIDCounter < 1 {
-		if m.GetPersonByIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonByID at\n%s", m.GetPersonByIDMock.defaultExpectation.returnOrigin)
+	if m.UpdateOrganizationByIDMock.defaultExpectation != nil && afterUpdateOrganizationByIDCounter < 1 {
+		if m.UpdateOrganizationByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonRepositoryMock.UpdateOrganizationByID at\n%s", m.UpdateOrganizationByIDMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonByID at\n%s with params: %#v", m.GetPersonByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetPersonByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to PersonRepositoryMock.UpdateOrganizationByID at\n%s with params: %#v", m.UpdateOrganizationByIDMock.defaultExpectation.expectationOrigins.origin, *m.UpdateOrganizationByIDMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetPersonByID != nil && afterGetPersonByIDCounter < 1 {
-		m.t.Errorf("Expected call to PersonRepositoryMock.GetPersonByID at\n%s", m.funcGetPersonByIDOrigin)
+	if m.funcUpdateOrganizationByID != nil && afterUpdateOrganizationByIDCounter < 1 {
+		m.t.Errorf("Expected call to PersonRepositoryMock.UpdateOrganizationByID at\n%s", m.funcUpdateOrganizationByIDOrigin)
 	}
 
-	if !m.GetPersonByIDMock.invocationsDone() && afterGetPersonByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to PersonRepositoryMock.GetPersonByID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetPersonByIDMock.expectedInvocations), m.GetPersonByIDMock.expectedInvocationsOrigin, afterGetPersonByIDCounter)
+	if !m.UpdateOrganizationByIDMock.invocationsDone() && afterUpdateOrganizationByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonRepositoryMock.UpdateOrganizationByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateOrganizationByIDMock.expectedInvocations), m.UpdateOrganizationByIDMock.expectedInvocationsOrigin, afterUpdateOrganizationByIDCounter)
 	}
 }
 
@@ -1214,7 +8416,7 @@ type mPersonRepositoryMockUpdatePersonByID struct {
 	expectedInvocationsOrigin string
 }
 
-// PersonRepositoryMockUpdatePersonByIDExpectation specifies expectation struct of the personRepository.UpdatePersonByID
+// PersonRepositoryMockUpdatePersonByIDExpectation specifies expectation struct of the PersonRepository.UpdatePersonByID
 type PersonRepositoryMockUpdatePersonByIDExpectation struct {
 	mock               *PersonRepositoryMock
 	params             *PersonRepositoryMockUpdatePersonByIDParams
@@ -1225,24 +8427,24 @@ type PersonRepositoryMockUpdatePersonByIDExpectation struct {
 	Counter            uint64
 }
 
-// PersonRepositoryMockUpdatePersonByIDParams contains parameters of the personRepository.UpdatePersonByID
+// PersonRepositoryMockUpdatePersonByIDParams contains parameters of the PersonRepository.UpdatePersonByID
 type PersonRepositoryMockUpdatePersonByIDParams struct {
 	id     int32
 	person models.Person
 }
 
-// PersonRepositoryMockUpdatePersonByIDParamPtrs contains pointers to parameters of the personRepository.UpdatePersonByID
+// PersonRepositoryMockUpdatePersonByIDParamPtrs contains pointers to parameters of the PersonRepository.UpdatePersonByID
 type PersonRepositoryMockUpdatePersonByIDParamPtrs struct {
 	id     *int32
 	person *models.Person
 }
 
-// PersonRepositoryMockUpdatePersonByIDResults contains results of the personRepository.UpdatePersonByID
+// PersonRepositoryMockUpdatePersonByIDResults contains results of the PersonRepository.UpdatePersonByID
 type PersonRepositoryMockUpdatePersonByIDResults struct {
 	err error
 }
 
-// PersonRepositoryMockUpdatePersonByIDOrigins contains origins of expectations of the personRepository.UpdatePersonByID
+// PersonRepositoryMockUpdatePersonByIDOrigins contains origins of expectations of the PersonRepository.UpdatePersonByID
 type PersonRepositoryMockUpdatePersonByIDExpectationOrigins struct {
 	origin       string
 	originId     string
@@ -1259,7 +8461,7 @@ func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) Optional() *mPe
 	return mmUpdatePersonByID
 }
 
-// Expect sets up expected params for personRepository.UpdatePersonByID
+// Expect sets up expected params for PersonRepository.UpdatePersonByID
 func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) Expect(id int32, person models.Person) *mPersonRepositoryMockUpdatePersonByID {
 	if mmUpdatePersonByID.mock.funcUpdatePersonByID != nil {
 		mmUpdatePersonByID.mock.t.Fatalf("PersonRepositoryMock.UpdatePersonByID mock is already set by Set")
@@ -1284,7 +8486,7 @@ func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) Expect(id int32
 	return mmUpdatePersonByID
 }
 
-// ExpectIdParam1 sets up expected param id for personRepository.UpdatePersonByID
+// ExpectIdParam1 sets up expected param id for PersonRepository.UpdatePersonByID
 func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) ExpectIdParam1(id int32) *mPersonRepositoryMockUpdatePersonByID {
 	if mmUpdatePersonByID.mock.funcUpdatePersonByID != nil {
 		mmUpdatePersonByID.mock.t.Fatalf("PersonRepositoryMock.UpdatePersonByID mock is already set by Set")
@@ -1307,7 +8509,7 @@ func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) ExpectIdParam1(
 	return mmUpdatePersonByID
 }
 
-// ExpectPersonParam2 sets up expected param person for personRepository.UpdatePersonByID
+// ExpectPersonParam2 sets up expected param person for PersonRepository.UpdatePersonByID
 func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) ExpectPersonParam2(person models.Person) *mPersonRepositoryMockUpdatePersonByID {
 	if mmUpdatePersonByID.mock.funcUpdatePersonByID != nil {
 		mmUpdatePersonByID.mock.t.Fatalf("PersonRepositoryMock.UpdatePersonByID mock is already set by Set")
@@ -1330,7 +8532,7 @@ func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) ExpectPersonPar
 	return mmUpdatePersonByID
 }
 
-// Inspect accepts an inspector function that has same arguments as the personRepository.UpdatePersonByID
+// Inspect accepts an inspector function that has same arguments as the PersonRepository.UpdatePersonByID
 func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) Inspect(f func(id int32, person models.Person)) *mPersonRepositoryMockUpdatePersonByID {
 	if mmUpdatePersonByID.mock.inspectFuncUpdatePersonByID != nil {
 		mmUpdatePersonByID.mock.t.Fatalf("Inspect function is already set for PersonRepositoryMock.UpdatePersonByID")
@@ -1341,7 +8543,7 @@ func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) Inspect(f func(
 	return mmUpdatePersonByID
 }
 
-// Return sets up results that will be returned by personRepository.UpdatePersonByID
+// Return sets up results that will be returned by PersonRepository.UpdatePersonByID
 func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) Return(err error) *PersonRepositoryMock {
 	if mmUpdatePersonByID.mock.funcUpdatePersonByID != nil {
 		mmUpdatePersonByID.mock.t.Fatalf("PersonRepositoryMock.UpdatePersonByID mock is already set by Set")
@@ -1355,14 +8557,14 @@ func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) Return(err erro
 	return mmUpdatePersonByID.mock
 }
 
-// Set uses given function f to mock the personRepository.UpdatePersonByID method
+// Set uses given function f to mock the PersonRepository.UpdatePersonByID method
 func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) Set(f func(id int32, person models.Person) (err error)) *PersonRepositoryMock {
 	if mmUpdatePersonByID.defaultExpectation != nil {
-		mmUpdatePersonByID.mock.t.Fatalf("Default expectation is already set for the personRepository.UpdatePersonByID method")
+		mmUpdatePersonByID.mock.t.Fatalf("Default expectation is already set for the PersonRepository.UpdatePersonByID method")
 	}
 
 	if len(mmUpdatePersonByID.expectations) > 0 {
-		mmUpdatePersonByID.mock.t.Fatalf("Some expectations are already set for the personRepository.UpdatePersonByID method")
+		mmUpdatePersonByID.mock.t.Fatalf("Some expectations are already set for the PersonRepository.UpdatePersonByID method")
 	}
 
 	mmUpdatePersonByID.mock.funcUpdatePersonByID = f
@@ -1370,7 +8572,7 @@ func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) Set(f func(id i
 	return mmUpdatePersonByID.mock
 }
 
-// When sets expectation for the personRepository.UpdatePersonByID which will trigger the result defined by the following
+// When sets expectation for the PersonRepository.UpdatePersonByID which will trigger the result defined by the following
 // Then helper
 func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) When(id int32, person models.Person) *PersonRepositoryMockUpdatePersonByIDExpectation {
 	if mmUpdatePersonByID.mock.funcUpdatePersonByID != nil {
@@ -1386,13 +8588,13 @@ func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) When(id int32,
 	return expectation
 }
 
-// Then sets up personRepository.UpdatePersonByID return parameters for the expectation previously defined by the When method
+// Then sets up PersonRepository.UpdatePersonByID return parameters for the expectation previously defined by the When method
 func (e *PersonRepositoryMockUpdatePersonByIDExpectation) Then(err error) *PersonRepositoryMock {
 	e.results = &PersonRepositoryMockUpdatePersonByIDResults{err}
 	return e.mock
 }
 
-// Times sets number of times personRepository.UpdatePersonByID should be invoked
+// Times sets number of times PersonRepository.UpdatePersonByID should be invoked
 func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) Times(n uint64) *mPersonRepositoryMockUpdatePersonByID {
 	if n == 0 {
 		mmUpdatePersonByID.mock.t.Fatalf("Times of PersonRepositoryMock.UpdatePersonByID mock can not be zero")
@@ -1413,7 +8615,7 @@ func (mmUpdatePersonByID *mPersonRepositoryMockUpdatePersonByID) invocationsDone
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdatePersonByID implements personRepository
+// UpdatePersonByID implements PersonRepository
 func (mmUpdatePersonByID *PersonRepositoryMock) UpdatePersonByID(id int32, person models.Person) (err error) {
 	mm_atomic.AddUint64(&mmUpdatePersonByID.beforeUpdatePersonByIDCounter, 1)
 	defer mm_atomic.AddUint64(&mmUpdatePersonByID.afterUpdatePersonByIDCounter, 1)
@@ -1547,14 +8749,58 @@ func (m *PersonRepositoryMock) MinimockUpdatePersonByIDInspect() {
 func (m *PersonRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCreateEmploymentInspect()
+
+			m.MinimockCreateOrganizationInspect()
+
 			m.MinimockCreatePersonInspect()
 
+			m.MinimockCreatePersonsInspect()
+
+			m.MinimockCreateRelationInspect()
+
+			m.MinimockDeleteEmploymentInspect()
+
+			m.MinimockDeleteOrganizationByIDInspect()
+
 			m.MinimockDeletePersonByIDInspect()
 
+			m.MinimockDeleteRelationInspect()
+
 			m.MinimockGetAllPersonInspect()
 
+			m.MinimockGetDuplicateCandidatesInspect()
+
+			m.MinimockGetEmploymentsInspect()
+
+			m.MinimockGetMergedPersonIDInspect()
+
+			m.MinimockGetOrganizationByIDInspect()
+
+			m.MinimockGetOrganizationEmploymentsInspect()
+
 			m.MinimockGetPersonByIDInspect()
 
+			m.MinimockGetPersonsByIDsInspect()
+
+			m.MinimockGetPersonsUpdatedSinceInspect()
+
+			m.MinimockGetRelatedPersonsInspect()
+
+			m.MinimockListOrganizationsInspect()
+
+			m.MinimockListPersonsInspect()
+
+			m.MinimockMergePersonsInspect()
+
+			m.MinimockRefreshWorkInspect()
+
+			m.MinimockSessionInspect()
+
+			m.MinimockUpdateEmploymentInspect()
+
+			m.MinimockUpdateOrganizationByIDInspect()
+
 			m.MinimockUpdatePersonByIDInspect()
 		}
 	})
@@ -1579,9 +8825,31 @@ func (m *PersonRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *PersonRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCreateEmploymentDone() &&
+		m.MinimockCreateOrganizationDone() &&
 		m.MinimockCreatePersonDone() &&
+		m.MinimockCreatePersonsDone() &&
+		m.MinimockCreateRelationDone() &&
+		m.MinimockDeleteEmploymentDone() &&
+		m.MinimockDeleteOrganizationByIDDone() &&
 		m.MinimockDeletePersonByIDDone() &&
+		m.MinimockDeleteRelationDone() &&
 		m.MinimockGetAllPersonDone() &&
+		m.MinimockGetDuplicateCandidatesDone() &&
+		m.MinimockGetEmploymentsDone() &&
+		m.MinimockGetMergedPersonIDDone() &&
+		m.MinimockGetOrganizationByIDDone() &&
+		m.MinimockGetOrganizationEmploymentsDone() &&
 		m.MinimockGetPersonByIDDone() &&
+		m.MinimockGetPersonsByIDsDone() &&
+		m.MinimockGetPersonsUpdatedSinceDone() &&
+		m.MinimockGetRelatedPersonsDone() &&
+		m.MinimockListOrganizationsDone() &&
+		m.MinimockListPersonsDone() &&
+		m.MinimockMergePersonsDone() &&
+		m.MinimockRefreshWorkDone() &&
+		m.MinimockSessionDone() &&
+		m.MinimockUpdateEmploymentDone() &&
+		m.MinimockUpdateOrganizationByIDDone() &&
 		m.MinimockUpdatePersonByIDDone()
 }
@@ -0,0 +1,366 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/charmbracelet/log"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+	"net/http"
+	"time"
+)
+
+func organizationNotFoundError(c echo.Context) error {
+	return c.JSON(http.StatusNotFound, openapi.ErrorResponse{
+		Message: "organization not found",
+	})
+}
+
+func organizationConflictError(c echo.Context) error {
+	return c.JSON(http.StatusConflict, openapi.ErrorResponse{
+		Message: "name is already used by another organization",
+	})
+}
+
+func employmentNotFoundError(c echo.Context) error {
+	return c.JSON(http.StatusNotFound, openapi.ErrorResponse{
+		Message: "employment not found",
+	})
+}
+
+func (s *Server) ListOrganizations(c echo.Context, params openapi.ListOrganizationsParams) error {
+	name := ""
+	if params.Name != nil {
+		name = *params.Name
+	}
+
+	organizations, err := s.repo(c).ListOrganizations(name)
+	if err != nil {
+		log.Errorf("database error: %v", err)
+		return databaseError(c, err)
+	}
+
+	res := make([]openapi.Organization, 0, len(organizations))
+	for _, o := range organizations {
+		res = append(res, toOrganizationResponse(o))
+	}
+	return s.respondCached(c, jsonRepresentation, res, time.Time{})
+}
+
+func (s *Server) CreateOrganization(c echo.Context) error {
+	var req openapi.OrganizationRequest
+	err := s.decodeJSON(c, &req)
+	if err != nil {
+		return badRequestBody(c, err)
+	}
+
+	if err = validateOrganizationName(req.Name); err != nil {
+		log.Errorf("validation error: %v", err)
+		return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	organization, err := s.repo(c).CreateOrganization(models.Organization{Name: req.Name})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Errorf("organization %q already exists", req.Name)
+			return organizationConflictError(c)
+		}
+		log.Errorf("database error: %v", err)
+		return databaseError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, toOrganizationResponse(organization))
+}
+
+// validateOrganizationName refuses names that normalize to nothing, like
+// punctuation or a legal form alone, as they can not tell organizations
+// apart.
+func validateOrganizationName(name string) error {
+	if models.NormalizeOrganizationName(name) == "" {
+		return fmt.Errorf("name must contain letters or digits besides a legal form, got %q", name)
+	}
+	return nil
+}
+
+func (s *Server) GetOrganization(c echo.Context, id openapi.OrganizationID) error {
+	if id <= 0 {
+		log.Errorf("bad id %v", id)
+		return badIDError(c)
+	}
+
+	organization, err := s.repo(c).GetOrganizationByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("organization not found, id=%v", id)
+			return organizationNotFoundError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	return s.respondCached(c, jsonRepresentation, toOrganizationResponse(organization), time.Time{})
+}
+
+func (s *Server) EditOrganization(c echo.Context, id openapi.OrganizationID) error {
+	var req openapi.OrganizationRequest
+	err := s.decodeJSON(c, &req)
+	if err != nil {
+		return badRequestBody(c, err)
+	}
+
+	if err = validateOrganizationName(req.Name); err != nil {
+		log.Errorf("validation error: %v", err)
+		return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if id <= 0 {
+		log.Errorf("bad id %v", id)
+		return badIDError(c)
+	}
+
+	err = s.repo(c).UpdateOrganizationByID(id, models.Organization{Name: req.Name})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("organization not found, id=%v", id)
+			return organizationNotFoundError(c)
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Errorf("organization %q already exists", req.Name)
+			return organizationConflictError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	organization, err := s.repo(c).GetOrganizationByID(id)
+	if err != nil {
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toOrganizationResponse(organization))
+}
+
+func (s *Server) DeleteOrganization(c echo.Context, id openapi.OrganizationID) error {
+	if id <= 0 {
+		log.Errorf("bad id %v", id)
+		return badIDError(c)
+	}
+
+	err := s.repo(c).DeleteOrganizationByID(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			log.Errorf("organization not found, id=%v", id)
+			return organizationNotFoundError(c)
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			log.Errorf("organization has employments, id=%v", id)
+			return c.JSON(http.StatusConflict, openapi.ErrorResponse{
+				Message: "organization has employments",
+			})
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) ListOrganizationEmployments(c echo.Context, id openapi.OrganizationID) error {
+	if id <= 0 {
+		log.Errorf("bad id %v", id)
+		return badIDError(c)
+	}
+
+	if _, err := s.repo(c).GetOrganizationByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("organization not found, id=%v", id)
+			return organizationNotFoundError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	employments, err := s.repo(c).GetOrganizationEmployments(id)
+	if err != nil {
+		log.Errorf("database error: %v", err)
+		return databaseError(c, err)
+	}
+	return s.respondCached(c, jsonRepresentation, toEmploymentResponses(employments), time.Time{})
+}
+
+func (s *Server) ListEmployments(c echo.Context, id openapi.PersonID) error {
+	if id <= 0 {
+		log.Errorf("bad id %v", id)
+		return badIDError(c)
+	}
+
+	if _, err := s.repo(c).GetPersonByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("person not found, id=%v", id)
+			return notFoundError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	employments, err := s.repo(c).GetEmployments(id)
+	if err != nil {
+		log.Errorf("database error: %v", err)
+		return databaseError(c, err)
+	}
+	return s.respondCached(c, jsonRepresentation, toEmploymentResponses(employments), time.Time{})
+}
+
+func (s *Server) CreateEmployment(c echo.Context, id openapi.PersonID) error {
+	var req openapi.EmploymentRequest
+	err := s.decodeJSON(c, &req)
+	if err != nil {
+		return badRequestBody(c, err)
+	}
+
+	employment := fromEmploymentRequest(id, req)
+	if err = employment.Validate(); err != nil {
+		log.Errorf("validation error: %v", err)
+		return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	employment, err = s.repo(c).CreateEmployment(employment)
+	if err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			log.Errorf("person or organization not found, ids=%v, %v", id, req.OrganizationId)
+			return c.JSON(http.StatusNotFound, openapi.ErrorResponse{
+				Message: "person or organization not found",
+			})
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, toEmploymentResponse(employment))
+}
+
+func (s *Server) UpdateEmployment(c echo.Context, id openapi.PersonID, employmentID openapi.EmploymentID) error {
+	var req openapi.EmploymentRequest
+	err := s.decodeJSON(c, &req)
+	if err != nil {
+		return badRequestBody(c, err)
+	}
+
+	employment := fromEmploymentRequest(id, req)
+	if err = employment.Validate(); err != nil {
+		log.Errorf("validation error: %v", err)
+		return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if employmentID <= 0 {
+		log.Errorf("bad employment id %v", employmentID)
+		return badIDError(c)
+	}
+
+	err = s.repo(c).UpdateEmployment(id, employmentID, employment)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			log.Errorf("employment not found, id=%v, employment_id=%v", id, employmentID)
+			return employmentNotFoundError(c)
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			log.Errorf("organization not found, id=%v", req.OrganizationId)
+			return organizationNotFoundError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	employments, err := s.repo(c).GetEmployments(id)
+	if err != nil {
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+	for _, e := range employments {
+		if e.ID == employmentID {
+			return c.JSON(http.StatusOK, toEmploymentResponse(e))
+		}
+	}
+	// Removed right after it was replaced.
+	return employmentNotFoundError(c)
+}
+
+func (s *Server) DeleteEmployment(c echo.Context, id openapi.PersonID, employmentID openapi.EmploymentID) error {
+	if id <= 0 || employmentID <= 0 {
+		log.Errorf("bad id %v or employment id %v", id, employmentID)
+		return badIDError(c)
+	}
+
+	err := s.repo(c).DeleteEmployment(id, employmentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("employment not found, id=%v, employment_id=%v", id, employmentID)
+			return employmentNotFoundError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func fromEmploymentRequest(personID int32, req openapi.EmploymentRequest) models.Employment {
+	employment := models.Employment{
+		PersonID:       personID,
+		OrganizationID: req.OrganizationId,
+		Role:           req.Role,
+	}
+	if req.StartDate != nil {
+		startDate := req.StartDate.Time
+		employment.StartDate = &startDate
+	}
+	if req.EndDate != nil {
+		endDate := req.EndDate.Time
+		employment.EndDate = &endDate
+	}
+	return employment
+}
+
+func toOrganizationResponse(o models.Organization) openapi.Organization {
+	return openapi.Organization{
+		Id:        o.ID,
+		Name:      o.Name,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+func toEmploymentResponse(e models.Employment) openapi.Employment {
+	res := openapi.Employment{
+		Id:             e.ID,
+		PersonId:       e.PersonID,
+		OrganizationId: e.OrganizationID,
+		Role:           e.Role,
+		CreatedAt:      e.CreatedAt,
+	}
+	if e.StartDate != nil {
+		res.StartDate = &openapi_types.Date{Time: *e.StartDate}
+	}
+	if e.EndDate != nil {
+		res.EndDate = &openapi_types.Date{Time: *e.EndDate}
+	}
+	return res
+}
+
+func toEmploymentResponses(employments []models.Employment) []openapi.Employment {
+	res := make([]openapi.Employment, 0, len(employments))
+	for _, e := range employments {
+		res = append(res, toEmploymentResponse(e))
+	}
+	return res
+}
@@ -4,136 +4,263 @@ import (
 	"errors"
 	"fmt"
 	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
 	"github.com/charmbracelet/log"
 	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"gorm.io/gorm"
 	"net/http"
-	"strconv"
+	"time"
 )
 
-func (s *Server) getPersons(c echo.Context) error {
-	persons, err := s.pr.GetAllPerson()
+const (
+	headerNextCursor    = "X-Next-Cursor"
+	headerLink          = "Link"
+	defaultChangesLimit = 100
+	maxChangesLimit     = 1000
+)
+
+var _ openapi.ServerInterface = (*Server)(nil)
+
+func (s *Server) ListPersons(c echo.Context, params openapi.ListPersonsParams) error {
+	rep, ok := negotiate(c.Request().Header.Get(echo.HeaderAccept), personRepresentations)
+	if !ok {
+		return notAcceptableError(c, personRepresentations)
+	}
+
+	if params.UpdatedSince != nil {
+		return s.listPersonsUpdatedSince(c, rep, params)
+	}
+
+	persons, err := s.repo(c).GetAllPerson()
+	if err != nil {
+		log.Errorf("database error: %v", err)
+		return databaseError(c, err)
+	}
+	return s.respondCached(c, rep, toPersonResponses(persons), time.Time{})
+}
+
+func (s *Server) listPersonsUpdatedSince(c echo.Context, rep *representation, params openapi.ListPersonsParams) error {
+	var after *models.PersonCursor
+	if params.Cursor != nil && *params.Cursor != "" {
+		cursor, err := models.DecodePersonCursor(*params.Cursor)
+		if err != nil {
+			log.Errorf("can not parse cursor %v, err: %v", *params.Cursor, err)
+			return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+				Message: "bad cursor",
+			})
+		}
+		after = &cursor
+	}
+
+	limit := defaultChangesLimit
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+		if limit <= 0 || limit > maxChangesLimit {
+			log.Errorf("bad limit %v", limit)
+			return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+				Message: fmt.Sprintf("limit must be between 1 and %d", maxChangesLimit),
+			})
+		}
+	}
+
+	changes, err := s.repo(c).GetPersonsUpdatedSince(*params.UpdatedSince, after, limit)
 	if err != nil {
 		log.Errorf("database error: %v", err)
-		return c.JSON(http.StatusInternalServerError, echo.Map{
-			"error": "internal server error",
-		})
+		return databaseError(c, err)
 	}
-	return c.JSON(http.StatusOK, persons)
+
+	if len(changes) == limit {
+		next := models.NewPersonCursor(changes[len(changes)-1]).Encode()
+		nextURL := *c.Request().URL
+		query := nextURL.Query()
+		query.Set("cursor", next)
+		nextURL.RawQuery = query.Encode()
+
+		c.Response().Header().Set(headerNextCursor, next)
+		c.Response().Header().Set(headerLink, fmt.Sprintf(`<%s>; rel="next"`, nextURL.RequestURI()))
+	}
+
+	return s.respondCached(c, rep, toPersonChangeResponses(changes), time.Time{})
 }
 
-func (s *Server) createPerson(c echo.Context) error {
-	var req models.Person
-	err := c.Bind(&req)
+func (s *Server) CreatePerson(c echo.Context) error {
+	var req openapi.PersonRequest
+	err := s.decodePerson(c, &req)
 	if err != nil {
-		log.Errorf("can not bind request: %v", err)
-		return c.JSON(http.StatusBadRequest, echo.Map{
-			"errors": "bad json request",
-		})
+		return badRequestBody(c, err)
 	}
 
-	if err = c.Validate(req); err != nil {
+	person := fromPersonRequest(req)
+	if err = c.Validate(person); err != nil {
 		log.Errorf("validation error: %v", err)
-		return c.JSON(http.StatusBadRequest, echo.Map{
-			"errors": err.Error(),
-		})
+		return validationError(c, err)
 	}
 
-	req, err = s.pr.CreatePerson(req)
+	person, err = s.repo(c).CreatePerson(person)
 	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Errorf("email %q is already used", person.Email)
+			return conflictError(c)
+		}
 		log.Errorf("database error: %v", err)
-		return c.JSON(http.StatusInternalServerError, echo.Map{
-			"errors": "internal server error",
-		})
+		return databaseError(c, err)
 	}
 
-	c.Response().Header().Set(echo.HeaderLocation, fmt.Sprintf("%s/%d", c.Request().RequestURI, req.ID))
-	return c.JSON(http.StatusCreated, echo.Map{})
+	c.Response().Header().Set(echo.HeaderLocation, fmt.Sprintf("%s/%d", c.Request().URL.Path, person.ID))
+	return c.NoContent(http.StatusCreated)
 }
 
-func (s *Server) getPersonByID(c echo.Context) error {
-	rawId := c.Param("id")
-	id, err := strconv.Atoi(rawId)
-	if err != nil || id <= 0 {
-		log.Errorf("can not parse id %v, err: %v", rawId, err)
-		return c.JSON(http.StatusBadRequest, echo.Map{
-			"error": "bad request",
-		})
+func (s *Server) GetPerson(c echo.Context, id openapi.PersonID) error {
+	rep, ok := negotiate(c.Request().Header.Get(echo.HeaderAccept), personRepresentations)
+	if !ok {
+		return notAcceptableError(c, personRepresentations)
 	}
 
-	person, err := s.pr.GetPersonByID(int32(id))
+	if id <= 0 {
+		log.Errorf("bad id %v", id)
+		return badIDError(c)
+	}
+
+	person, err := s.repo(c).GetPersonByID(id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			log.Errorf("person not found, id=%v", id)
-			return c.JSON(http.StatusNotFound, echo.Map{})
+			return s.personGone(c, id)
 		}
-		log.Errorf("databese error %v", err)
-		return c.JSON(http.StatusInternalServerError, echo.Map{})
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, person)
+	return s.respondCached(c, rep, toPersonResponse(person), person.UpdatedAt)
 }
 
-func (s *Server) updatePerson(c echo.Context) error {
-	var req models.Person
-	err := c.Bind(&req)
+func (s *Server) EditPerson(c echo.Context, id openapi.PersonID) error {
+	rep, ok := negotiate(c.Request().Header.Get(echo.HeaderAccept), personRepresentations)
+	if !ok {
+		return notAcceptableError(c, personRepresentations)
+	}
+
+	var req openapi.PersonRequest
+	err := s.decodePerson(c, &req)
 	if err != nil {
-		log.Errorf("can not bind request: %v", err)
-		return c.JSON(http.StatusBadRequest, echo.Map{
-			"errors": "bad json request",
-		})
+		return badRequestBody(c, err)
 	}
 
-	if err = c.Validate(req); err != nil {
+	person := fromPersonRequest(req)
+	if err = c.Validate(person); err != nil {
 		log.Errorf("validation error: %v", err)
-		return c.JSON(http.StatusBadRequest, echo.Map{
-			"errors": err.Error(),
-		})
+		return validationError(c, err)
 	}
 
-	rawId := c.Param("id")
-	id, err := strconv.Atoi(rawId)
-	if err != nil || id <= 0 {
-		log.Errorf("can not parse id %v, err: %v", rawId, err)
-		return c.JSON(http.StatusBadRequest, echo.Map{
-			"error": "bad request",
-		})
+	if id <= 0 {
+		log.Errorf("bad id %v", id)
+		return badIDError(c)
 	}
 
-	req.ID = int32(id)
-	err = s.pr.UpdatePersonByID(req.ID, req)
+	person.ID = id
+	err = s.repo(c).UpdatePersonByID(person.ID, person)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			log.Errorf("person not found, id=%v", id)
-			return c.JSON(http.StatusNotFound, echo.Map{})
+			return s.personGone(c, id)
 		}
-		log.Errorf("databese error %v", err)
-		return c.JSON(http.StatusInternalServerError, echo.Map{})
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Errorf("email %q is already used", person.Email)
+			return conflictError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
 	}
 
-	person, err := s.pr.GetPersonByID(int32(id))
+	person, err = s.repo(c).GetPersonByID(id)
 	if err != nil {
-		log.Errorf("databese error %v", err)
-		return c.JSON(http.StatusInternalServerError, echo.Map{})
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, person)
+	return rep.respond(c, http.StatusOK, toPersonResponse(person))
 }
 
-func (s *Server) deletePersonByID(c echo.Context) error {
-	rawId := c.Param("id")
-	id, err := strconv.Atoi(rawId)
-	if err != nil || id <= 0 {
-		log.Errorf("can not parse id %v, err: %v", rawId, err)
-		return c.JSON(http.StatusBadRequest, echo.Map{
-			"error": "bad request",
-		})
+func (s *Server) DeletePerson(c echo.Context, id openapi.PersonID) error {
+	if id <= 0 {
+		log.Errorf("bad id %v", id)
+		return badIDError(c)
 	}
 
-	err = s.pr.DeletePersonByID(int32(id))
+	err := s.repo(c).DeletePersonByID(id)
 	if err != nil {
-		log.Errorf("databese error %v", err)
-		return c.JSON(http.StatusInternalServerError, echo.Map{})
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func fromPersonRequest(req openapi.PersonRequest) models.Person {
+	person := models.Person{
+		Name:    req.Name,
+		Age:     req.Age,
+		Email:   req.Email,
+		Phone:   req.Phone,
+		Address: req.Address,
+		Work:    req.Work,
+	}
+	if req.BirthDate != nil {
+		birthDate := req.BirthDate.Time
+		person.BirthDate = &birthDate
+		person.Age = 0
+	}
+	if req.PostalAddress != nil {
+		person.PostalAddress = models.PostalAddress{
+			Country:    req.PostalAddress.Country,
+			City:       req.PostalAddress.City,
+			Street:     req.PostalAddress.Street,
+			PostalCode: req.PostalAddress.PostalCode,
+		}
+	}
+	return person
+}
+
+func toPersonResponse(p models.Person) openapi.PersonResponse {
+	res := openapi.PersonResponse{
+		Id:        p.ID,
+		Name:      p.Name,
+		Age:       p.CurrentAge(),
+		Email:     p.Email,
+		Phone:     p.Phone,
+		Address:   p.DisplayAddress(),
+		Work:      p.Work,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+	if p.BirthDate != nil {
+		res.BirthDate = &openapi_types.Date{Time: *p.BirthDate}
+	}
+	if !p.PostalAddress.IsZero() {
+		res.PostalAddress = &openapi.PostalAddress{
+			Country:    p.PostalAddress.Country,
+			City:       p.PostalAddress.City,
+			Street:     p.PostalAddress.Street,
+			PostalCode: p.PostalAddress.PostalCode,
+		}
+	}
+	return res
+}
+
+func toPersonChangeResponses(changes []models.PersonChange) []openapi.PersonResponse {
+	res := make([]openapi.PersonResponse, 0, len(changes))
+	for _, c := range changes {
+		if c.Deleted {
+			res = append(res, openapi.PersonResponse{Id: c.ID, UpdatedAt: c.UpdatedAt, Deleted: true})
+			continue
+		}
+		res = append(res, toPersonResponse(c.Person))
+	}
+	return res
+}
+
+func toPersonResponses(persons []models.Person) []openapi.PersonResponse {
+	res := make([]openapi.PersonResponse, 0, len(persons))
+	for _, p := range persons {
+		res = append(res, toPersonResponse(p))
 	}
-	return c.JSON(http.StatusNoContent, echo.Map{})
+	return res
 }
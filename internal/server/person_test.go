@@ -3,9 +3,11 @@ package server
 import (
 	"encoding/json"
 	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
 	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/breaker"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
 	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/validation"
-	"github.com/go-playground/validator/v10"
 	"github.com/gojuno/minimock/v3"
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
@@ -15,6 +17,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 var regularPerson = models.Person{
@@ -25,14 +28,50 @@ var regularPerson = models.Person{
 	Work:    "test",
 }
 
+func newTestServer(t *testing.T, cfg config.Config, pr PersonRepository) *Server {
+	t.Helper()
+
+	s, err := New(cfg, pr)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return s
+}
+
+func newTestMultiTenantServer(t *testing.T, cfg config.Config, repos PersonRepositories) *Server {
+	t.Helper()
+
+	s, err := NewMultiTenant(cfg, repos)
+	if err != nil {
+		t.Fatalf("NewMultiTenant() error = %v", err)
+	}
+	return s
+}
+
+func wrapper(s *Server) *openapi.ServerInterfaceWrapper {
+	return &openapi.ServerInterfaceWrapper{Handler: s}
+}
+
+// serve runs h the way the echo router does: parameter errors returned by
+// the generated wrapper are written by the HTTP error handler.
+func serve(c echo.Context, h echo.HandlerFunc) error {
+	err := h(c)
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		c.Echo().HTTPErrorHandler(err, c)
+		return nil
+	}
+	return err
+}
+
 func TestServer_createPerson(t *testing.T) {
 	mc := minimock.NewController(t)
 	e := echo.New()
-	e.Validator = validation.MustRegisterCustomValidator(validator.New())
+	e.Validator = validation.NewEchoValidator()
 
 	type fields struct {
 		echo *echo.Echo
-		pr   personRepository
+		pr   PersonRepository
 	}
 	tests := []struct {
 		name               string
@@ -67,6 +106,33 @@ func TestServer_createPerson(t *testing.T) {
 			body:               &models.Person{Name: "test", Age: -10},
 			expectedHTTPStatus: 400,
 		},
+		{
+			name: "http-400: bad contacts",
+			fields: fields{
+				echo: e,
+				pr:   nil,
+			},
+			body:               &models.Person{Name: "test", Email: "test", Phone: "12-34"},
+			expectedHTTPStatus: 400,
+		},
+		{
+			name: "http-400: bad postal address",
+			fields: fields{
+				echo: e,
+				pr:   nil,
+			},
+			body:               &models.Person{Name: "test", PostalAddress: models.PostalAddress{Country: "Russia"}},
+			expectedHTTPStatus: 400,
+		},
+		{
+			name: "http-409: email is already used",
+			fields: fields{
+				echo: e,
+				pr:   NewPersonRepositoryMock(mc).CreatePersonMock.Return(models.Person{}, gorm.ErrDuplicatedKey),
+			},
+			body:               &models.Person{Name: "test", Email: "test@example.com", Phone: "+7 (999) 123-45-67"},
+			expectedHTTPStatus: 409,
+		},
 		{
 			name: "http-500: database error",
 			fields: fields{
@@ -98,7 +164,7 @@ func TestServer_createPerson(t *testing.T) {
 			rw := httptest.NewRecorder()
 			c := s.echo.NewContext(req, rw)
 
-			err = s.createPerson(c)
+			err = serve(c, wrapper(s).CreatePerson)
 			if err != nil {
 				t.Errorf("createPerson() error = %v", err)
 			}
@@ -112,14 +178,70 @@ func TestServer_createPerson(t *testing.T) {
 	}
 }
 
+func TestServer_validationErrorLanguage(t *testing.T) {
+	e := echo.New()
+	e.Validator = validation.NewEchoValidator()
+	s := &Server{echo: e}
+
+	tests := []struct {
+		acceptLanguage          string
+		expectedContentLanguage string
+		expectedErrors          map[string]string
+	}{
+		{
+			acceptLanguage:          "",
+			expectedContentLanguage: "en",
+			expectedErrors: map[string]string{
+				"age":                    "age must be between 1 and 150",
+				"postal_address.country": "country must be an ISO 3166-1 alpha-2 country code, like RU",
+			},
+		},
+		{
+			acceptLanguage:          "ru;q=0.9, fr",
+			expectedContentLanguage: "ru",
+			expectedErrors: map[string]string{
+				"age":                    "age должен быть от 1 до 150",
+				"postal_address.country": "country должен быть кодом страны ISO 3166-1 alpha-2, например RU",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expectedContentLanguage, func(t *testing.T) {
+			body := `{"name":"test","age":200,"postal_address":{"country":"Russia"}}`
+			req := httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(body))
+			req.Header.Set("Content-type", "application/json")
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
+			rw := httptest.NewRecorder()
+
+			if err := serve(s.echo.NewContext(req, rw), wrapper(s).CreatePerson); err != nil {
+				t.Fatalf("createPerson() error = %v", err)
+			}
+			if rw.Code != http.StatusBadRequest {
+				t.Fatalf("createPerson() http-code expected 400, but got %d", rw.Code)
+			}
+			if lang := rw.Header().Get("Content-Language"); lang != tt.expectedContentLanguage {
+				t.Errorf("createPerson() Content-Language expected %q, but got %q", tt.expectedContentLanguage, lang)
+			}
+
+			var res openapi.ValidationErrorResponse
+			if err := json.NewDecoder(rw.Body).Decode(&res); err != nil {
+				t.Fatalf("can not decode response: %v", err)
+			}
+			if !reflect.DeepEqual(res.Errors, tt.expectedErrors) {
+				t.Errorf("createPerson() errors expected %v, but got %v", tt.expectedErrors, res.Errors)
+			}
+		})
+	}
+}
+
 func TestServer_deletePersonByID(t *testing.T) {
 	mc := minimock.NewController(t)
 	e := echo.New()
-	e.Validator = validation.MustRegisterCustomValidator(validator.New())
+	e.Validator = validation.NewEchoValidator()
 
 	type fields struct {
 		echo *echo.Echo
-		pr   personRepository
+		pr   PersonRepository
 	}
 
 	tests := []struct {
@@ -169,7 +291,7 @@ func TestServer_deletePersonByID(t *testing.T) {
 			c.SetParamNames("id")
 			c.SetParamValues(tt.pathParams)
 
-			err := s.deletePersonByID(c)
+			err := serve(c, wrapper(s).DeletePerson)
 			if err != nil {
 				t.Errorf("deletePersonByID() error = %v", err)
 			}
@@ -185,11 +307,11 @@ func TestServer_deletePersonByID(t *testing.T) {
 func TestServer_getPersonByID(t *testing.T) {
 	mc := minimock.NewController(t)
 	e := echo.New()
-	e.Validator = validation.MustRegisterCustomValidator(validator.New())
+	e.Validator = validation.NewEchoValidator()
 
 	type fields struct {
 		echo *echo.Echo
-		pr   personRepository
+		pr   PersonRepository
 	}
 	tests := []struct {
 		name               string
@@ -221,7 +343,8 @@ func TestServer_getPersonByID(t *testing.T) {
 			name: "http-404: person not found",
 			fields: fields{
 				echo: e,
-				pr:   NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, gorm.ErrRecordNotFound),
+				pr: NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, gorm.ErrRecordNotFound).
+					GetMergedPersonIDMock.Return(0, gorm.ErrRecordNotFound),
 			},
 			pathParams:         "1",
 			expectedHTTPStatus: 404,
@@ -235,6 +358,16 @@ func TestServer_getPersonByID(t *testing.T) {
 			pathParams:         "1",
 			expectedHTTPStatus: 500,
 		},
+		{
+			name: "http-503: database unavailable",
+			fields: fields{
+				echo: e,
+				pr: NewPersonRepositoryMock(mc).GetPersonByIDMock.
+					Return(models.Person{}, &breaker.UnavailableError{RetryAfter: time.Second, Err: breaker.ErrOpen}),
+			},
+			pathParams:         "1",
+			expectedHTTPStatus: 503,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -250,7 +383,7 @@ func TestServer_getPersonByID(t *testing.T) {
 			c.SetParamNames("id")
 			c.SetParamValues(tt.pathParams)
 
-			err := s.getPersonByID(c)
+			err := serve(c, wrapper(s).GetPerson)
 			if err != nil {
 				t.Errorf("getPersonByID() error = %v", err)
 			}
@@ -279,14 +412,31 @@ func TestServer_getPersonByID(t *testing.T) {
 	}
 }
 
+func TestServer_databaseUnavailable(t *testing.T) {
+	mc := minimock.NewController(t)
+	unavailable := &breaker.UnavailableError{RetryAfter: 2500 * time.Millisecond, Err: breaker.ErrOpen}
+	s := newTestServer(t, config.Config{}, NewPersonRepositoryMock(mc).GetAllPersonMock.Return(nil, unavailable))
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/persons", nil)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("listPersons() http-code expected %d, but got %d", http.StatusServiceUnavailable, w.Code)
+	}
+	if retryAfter := w.Header().Get("Retry-After"); retryAfter != "3" {
+		t.Errorf("Retry-After expected 3, but got %q", retryAfter)
+	}
+}
+
 func TestServer_getPersons(t *testing.T) {
 	mc := minimock.NewController(t)
 	e := echo.New()
-	e.Validator = validation.MustRegisterCustomValidator(validator.New())
+	e.Validator = validation.NewEchoValidator()
 
 	type fields struct {
 		echo *echo.Echo
-		pr   personRepository
+		pr   PersonRepository
 	}
 	tests := []struct {
 		name               string
@@ -323,7 +473,7 @@ func TestServer_getPersons(t *testing.T) {
 			w := httptest.NewRecorder()
 			c := s.echo.NewContext(r, w)
 
-			err := s.getPersons(c)
+			err := serve(c, wrapper(s).ListPersons)
 			if err != nil {
 				t.Errorf("getPersons() error = %v", err)
 			}
@@ -355,11 +505,11 @@ func TestServer_getPersons(t *testing.T) {
 func TestServer_updatePerson(t *testing.T) {
 	mc := minimock.NewController(t)
 	e := echo.New()
-	e.Validator = validation.MustRegisterCustomValidator(validator.New())
+	e.Validator = validation.NewEchoValidator()
 
 	type fields struct {
 		echo *echo.Echo
-		pr   personRepository
+		pr   PersonRepository
 	}
 	tests := []struct {
 		name               string
@@ -414,7 +564,8 @@ func TestServer_updatePerson(t *testing.T) {
 			name: "http-404: person not found",
 			fields: fields{
 				echo: e,
-				pr:   NewPersonRepositoryMock(mc).UpdatePersonByIDMock.Return(gorm.ErrRecordNotFound),
+				pr: NewPersonRepositoryMock(mc).UpdatePersonByIDMock.Return(gorm.ErrRecordNotFound).
+					GetMergedPersonIDMock.Return(0, gorm.ErrRecordNotFound),
 			},
 			pathParams:         "1",
 			expectedHTTPStatus: 404,
@@ -455,7 +606,7 @@ func TestServer_updatePerson(t *testing.T) {
 			c.SetParamNames("id")
 			c.SetParamValues(tt.pathParams)
 
-			err = s.updatePerson(c)
+			err = serve(c, wrapper(s).EditPerson)
 			if err != nil {
 				t.Errorf("updatePerson() error = %v", err)
 			}
@@ -483,3 +634,171 @@ func TestServer_updatePerson(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_getPersonsUpdatedSince(t *testing.T) {
+	mc := minimock.NewController(t)
+	e := echo.New()
+	e.Validator = validation.NewEchoValidator()
+
+	updatedPerson := regularPerson
+	updatedPerson.UpdatedAt = time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	updated := models.PersonChange{Person: updatedPerson, Version: 42}
+	deleted := models.PersonChange{Person: models.Person{ID: 2, UpdatedAt: updatedPerson.UpdatedAt}, Deleted: true, Version: 43}
+
+	type fields struct {
+		echo *echo.Echo
+		pr   PersonRepository
+	}
+	tests := []struct {
+		name               string
+		fields             fields
+		query              string
+		expectedHTTPStatus int
+		expectedCursor     string
+		expectedBody       string
+	}{
+		{
+			name: "http-200: last page",
+			fields: fields{
+				echo: e,
+				pr:   NewPersonRepositoryMock(mc).GetPersonsUpdatedSinceMock.Return([]models.PersonChange{updated}, nil),
+			},
+			query:              "updated_since=2024-10-01T00:00:00Z",
+			expectedHTTPStatus: 200,
+		},
+		{
+			name: "http-200: deleted person",
+			fields: fields{
+				echo: e,
+				pr:   NewPersonRepositoryMock(mc).GetPersonsUpdatedSinceMock.Return([]models.PersonChange{deleted}, nil),
+			},
+			query:              "updated_since=2024-10-01T00:00:00Z",
+			expectedHTTPStatus: 200,
+			expectedBody:       `"deleted":true`,
+		},
+		{
+			name: "http-200: full page has next cursor",
+			fields: fields{
+				echo: e,
+				pr:   NewPersonRepositoryMock(mc).GetPersonsUpdatedSinceMock.Return([]models.PersonChange{updated}, nil),
+			},
+			query:              "updated_since=2024-10-01T00:00:00Z&limit=1",
+			expectedHTTPStatus: 200,
+			expectedCursor:     models.NewPersonCursor(updated).Encode(),
+		},
+		{
+			name: "http-200: cursor is passed to repository",
+			fields: fields{
+				echo: e,
+				pr: NewPersonRepositoryMock(mc).GetPersonsUpdatedSinceMock.
+					Expect(time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), &models.PersonCursor{Version: 42, ID: 1}, defaultChangesLimit).
+					Return(nil, nil),
+			},
+			query:              "updated_since=2024-10-01T00:00:00Z&cursor=" + models.NewPersonCursor(updated).Encode(),
+			expectedHTTPStatus: 200,
+		},
+		{
+			name: "http-400: bad updated_since",
+			fields: fields{
+				echo: e,
+				pr:   nil,
+			},
+			query:              "updated_since=yesterday",
+			expectedHTTPStatus: 400,
+		},
+		{
+			name: "http-400: bad cursor",
+			fields: fields{
+				echo: e,
+				pr:   nil,
+			},
+			query:              "updated_since=2024-10-01T00:00:00Z&cursor=qwerty",
+			expectedHTTPStatus: 400,
+		},
+		{
+			name: "http-400: bad limit",
+			fields: fields{
+				echo: e,
+				pr:   nil,
+			},
+			query:              "updated_since=2024-10-01T00:00:00Z&limit=0",
+			expectedHTTPStatus: 400,
+		},
+		{
+			name: "http-500: database error",
+			fields: fields{
+				echo: e,
+				pr:   NewPersonRepositoryMock(mc).GetPersonsUpdatedSinceMock.Return(nil, errors.New("database error")),
+			},
+			query:              "updated_since=2024-10-01T00:00:00Z",
+			expectedHTTPStatus: 500,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{
+				echo: tt.fields.echo,
+				pr:   tt.fields.pr,
+			}
+
+			r := httptest.NewRequest(http.MethodGet, "/test?"+tt.query, nil)
+			w := httptest.NewRecorder()
+			c := s.echo.NewContext(r, w)
+
+			err := serve(c, wrapper(s).ListPersons)
+			if err != nil {
+				t.Errorf("getPersons() error = %v", err)
+			}
+
+			code := w.Result().StatusCode
+			if code != tt.expectedHTTPStatus {
+				t.Errorf("getPersons() http-code expected %d, but got %d", tt.expectedHTTPStatus, code)
+			}
+
+			cursor := w.Result().Header.Get(headerNextCursor)
+			if cursor != tt.expectedCursor {
+				t.Errorf("getPersons() next cursor expected %q, but got %q", tt.expectedCursor, cursor)
+			}
+			if !strings.Contains(w.Body.String(), tt.expectedBody) {
+				t.Errorf("getPersons() body expected to contain %s, but got %s", tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestToPersonResponse(t *testing.T) {
+	birthDate := time.Now().UTC().AddDate(-30, 0, -1).Truncate(24 * time.Hour)
+	p := models.Person{
+		ID:        1,
+		Name:      "test",
+		Age:       99,
+		BirthDate: &birthDate,
+		PostalAddress: models.PostalAddress{
+			Country:    "RU",
+			City:       "Moscow",
+			Street:     "2nd Baumanskaya, 5",
+			PostalCode: "105005",
+		},
+	}
+
+	res := toPersonResponse(p)
+	if res.Age != 30 {
+		t.Errorf("toPersonResponse() expected age derived from birth date 30, but got %d", res.Age)
+	}
+	if res.BirthDate == nil || !res.BirthDate.Time.Equal(birthDate) {
+		t.Errorf("toPersonResponse() expected birth date %v, but got %v", birthDate, res.BirthDate)
+	}
+	if res.Address != "2nd Baumanskaya, 5, Moscow, 105005, RU" {
+		t.Errorf("toPersonResponse() expected legacy address from postal address, but got %q", res.Address)
+	}
+
+	p.Address = "legacy"
+	if res = toPersonResponse(p); res.Address != "legacy" {
+		t.Errorf("toPersonResponse() expected legacy address to be kept, but got %q", res.Address)
+	}
+
+	p.BirthDate = nil
+	if res = toPersonResponse(p); res.Age != 99 {
+		t.Errorf("toPersonResponse() expected stored age without birth date, but got %d", res.Age)
+	}
+}
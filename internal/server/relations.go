@@ -0,0 +1,155 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/charmbracelet/log"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRelationDepth = 1
+	// maxRelationDepth bounds the walk, which takes a query per depth.
+	maxRelationDepth = 10
+)
+
+func (s *Server) FindRelatedPersons(c echo.Context, id openapi.PersonID, params openapi.FindRelatedPersonsParams) error {
+	if id <= 0 {
+		log.Errorf("bad id %v", id)
+		return badIDError(c)
+	}
+
+	q := models.RelationQuery{PersonID: id, Direction: models.RelationOut, Depth: defaultRelationDepth}
+	if params.Type != nil {
+		for _, t := range *params.Type {
+			q.Types = append(q.Types, models.RelationType(t))
+		}
+	}
+	if params.Direction != nil {
+		q.Direction = models.RelationDirection(*params.Direction)
+	}
+	if params.Depth != nil {
+		q.Depth = int(*params.Depth)
+	}
+	if err := validateRelationQuery(q); err != nil {
+		log.Errorf("bad relation query: %v", err)
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: err.Error()})
+	}
+
+	if _, err := s.repo(c).GetPersonByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("person not found, id=%v", id)
+			return notFoundError(c)
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	related, err := s.repo(c).GetRelatedPersons(q)
+	if err != nil {
+		log.Errorf("database error: %v", err)
+		return databaseError(c, err)
+	}
+
+	res := make([]openapi.RelatedPerson, 0, len(related))
+	for _, r := range related {
+		res = append(res, openapi.RelatedPerson{
+			RelationId: r.RelationID,
+			Type:       openapi.RelationType(r.Type),
+			Depth:      int32(r.Depth),
+			Path:       r.Path,
+			Person:     toPersonResponse(r.Person),
+		})
+	}
+	return s.respondCached(c, jsonRepresentation, res, time.Time{})
+}
+
+func validateRelationQuery(q models.RelationQuery) error {
+	for _, t := range q.Types {
+		if !t.Valid() {
+			return fmt.Errorf("unknown relation type %q", t)
+		}
+	}
+	if !q.Direction.Valid() {
+		return fmt.Errorf("direction must be out, in or both, got %q", q.Direction)
+	}
+	if q.Depth < 1 || q.Depth > maxRelationDepth {
+		return fmt.Errorf("depth must be between 1 and %d", maxRelationDepth)
+	}
+	return nil
+}
+
+func (s *Server) CreateRelation(c echo.Context, id openapi.PersonID) error {
+	var req openapi.RelationRequest
+	err := s.decodeJSON(c, &req)
+	if err != nil {
+		return badRequestBody(c, err)
+	}
+
+	relation := models.PersonRelation{
+		FromID:        id,
+		ToID:          req.ToId,
+		Type:          models.RelationType(req.Type),
+		Bidirectional: req.Bidirectional,
+	}.Normalize()
+	if err = relation.Validate(); err != nil {
+		log.Errorf("validation error: %v", err)
+		return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	relation, err = s.repo(c).CreateRelation(relation)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			log.Errorf("person not found, ids=%v, %v", id, req.ToId)
+			return notFoundError(c)
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			log.Errorf("relation already exists, ids=%v, %v", id, req.ToId)
+			return c.JSON(http.StatusConflict, openapi.ErrorResponse{
+				Message: fmt.Sprintf("persons already have a %s relation", relation.Type),
+			})
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, toRelationResponse(relation))
+}
+
+func (s *Server) DeleteRelation(c echo.Context, id openapi.PersonID, relationID int32) error {
+	if id <= 0 || relationID <= 0 {
+		log.Errorf("bad id %v or relation id %v", id, relationID)
+		return badIDError(c)
+	}
+
+	err := s.repo(c).DeleteRelation(id, relationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("relation not found, id=%v, relation_id=%v", id, relationID)
+			return c.JSON(http.StatusNotFound, openapi.ErrorResponse{
+				Message: "relation not found",
+			})
+		}
+		log.Errorf("database error %v", err)
+		return databaseError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toRelationResponse(r models.PersonRelation) openapi.Relation {
+	return openapi.Relation{
+		Id:            r.ID,
+		FromId:        r.FromID,
+		ToId:          r.ToID,
+		Type:          openapi.RelationType(r.Type),
+		Bidirectional: r.Bidirectional,
+		CreatedAt:     r.CreatedAt,
+	}
+}
@@ -0,0 +1,502 @@
+package server
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/labstack/echo/v4"
+	"github.com/vmihailenco/msgpack/v5"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	mimeApplicationMsgpack = "application/msgpack"
+	mimeTextCSV            = "text/csv"
+	mimeTextCSVCharsetUTF8 = mimeTextCSV + "; charset=UTF-8"
+
+	xmlPersonElement  = "person"
+	xmlPersonsElement = "persons"
+)
+
+// representation is a media type persons are read and written in. Every
+// representation is converted to and from the JSON data model, so decoding
+// rules and validation are the same whatever the media type is.
+type representation struct {
+	mediaType   string
+	contentType string
+	aliases     []string
+
+	// toJSON converts a request body to JSON. String values of formats
+	// without other scalars are converted to the types of the fields of t.
+	toJSON func(body []byte, t reflect.Type) ([]byte, error)
+	// fromJSON converts a response value, decoded from JSON with numbers
+	// kept as json.Number, to the body.
+	fromJSON func(v any) ([]byte, error)
+}
+
+var (
+	jsonRepresentation = &representation{
+		mediaType:   echo.MIMEApplicationJSON,
+		contentType: echo.MIMEApplicationJSON,
+	}
+	xmlRepresentation = &representation{
+		mediaType:   echo.MIMEApplicationXML,
+		contentType: echo.MIMEApplicationXMLCharsetUTF8,
+		aliases:     []string{echo.MIMETextXML},
+		toJSON:      xmlToJSON,
+		fromJSON:    xmlFromJSON,
+	}
+	msgpackRepresentation = &representation{
+		mediaType:   mimeApplicationMsgpack,
+		contentType: mimeApplicationMsgpack,
+		aliases:     []string{"application/x-msgpack", "application/vnd.msgpack"},
+		toJSON:      msgpackToJSON,
+		fromJSON:    msgpackFromJSON,
+	}
+	csvRepresentation = &representation{
+		mediaType:   mimeTextCSV,
+		contentType: mimeTextCSVCharsetUTF8,
+		toJSON:      csvToJSON,
+		fromJSON:    csvFromJSON,
+	}
+
+	// personRepresentations are in the order of preference, the first one
+	// is the default.
+	personRepresentations = []*representation{
+		jsonRepresentation,
+		xmlRepresentation,
+		msgpackRepresentation,
+		csvRepresentation,
+	}
+)
+
+// personCSVColumns are the columns of persons in CSV, nested fields are
+// joined with a dot.
+var personCSVColumns = []string{
+	"id", "name", "age", "email", "phone", "birth_date", "address",
+	"postal_address.country", "postal_address.city", "postal_address.street", "postal_address.postal_code",
+	"work", "created_at", "updated_at", "deleted",
+}
+
+func (r *representation) matches(mediaType string) bool {
+	if mediaType == r.mediaType {
+		return true
+	}
+	for _, alias := range r.aliases {
+		if mediaType == alias {
+			return true
+		}
+	}
+	return false
+}
+
+func requestRepresentation(contentType string, reps []*representation) (*representation, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, false
+	}
+	for _, r := range reps {
+		if r.matches(mediaType) {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// negotiate breaks ties in quality by the order of reps.
+func negotiate(accept string, reps []*representation) (*representation, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return reps[0], true
+	}
+
+	type mediaRange struct {
+		mediaType string
+		q         float64
+	}
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		if q > 0 {
+			ranges = append(ranges, mediaRange{mediaType: mediaType, q: q})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	for _, mr := range ranges {
+		for _, r := range reps {
+			if mr.mediaType == "*/*" || r.matches(mr.mediaType) ||
+				strings.HasSuffix(mr.mediaType, "/*") && strings.HasPrefix(r.mediaType, strings.TrimSuffix(mr.mediaType, "*")) {
+				return r, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func mediaTypes(reps []*representation) string {
+	res := make([]string, 0, len(reps))
+	for _, r := range reps {
+		res = append(res, r.mediaType)
+	}
+	return strings.Join(res, ", ")
+}
+
+func (r *representation) respond(c echo.Context, status int, v any) error {
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+	if r.fromJSON == nil {
+		return c.JSON(status, v)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var tree any
+	if err = dec.Decode(&tree); err != nil {
+		return err
+	}
+
+	body, err := r.fromJSON(tree)
+	if err != nil {
+		return err
+	}
+	return c.Blob(status, r.contentType, body)
+}
+
+func notAcceptableError(c echo.Context, reps []*representation) error {
+	return c.JSON(http.StatusNotAcceptable, openapi.ErrorResponse{
+		Message: fmt.Sprintf("none of the accepted media types is supported, supported are %s", mediaTypes(reps)),
+	})
+}
+
+func xmlToJSON(body []byte, t reflect.Type) ([]byte, error) {
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("no root element")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := tok.(xml.StartElement); ok {
+			break
+		}
+	}
+
+	tree, err := xmlElement(dec)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(coerce(tree, t))
+}
+
+// xmlElement reads the content of an element: its text when it has no child
+// elements, and a map of children by name otherwise. Repeated children are
+// collected in a slice, attributes are ignored.
+func xmlElement(dec *xml.Decoder) (any, error) {
+	var (
+		children map[string]any
+		text     bytes.Buffer
+	)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			child, err := xmlElement(dec)
+			if err != nil {
+				return nil, err
+			}
+			if children == nil {
+				children = make(map[string]any)
+			}
+			switch prev := children[tok.Name.Local].(type) {
+			case nil:
+				children[tok.Name.Local] = child
+			case []any:
+				children[tok.Name.Local] = append(prev, child)
+			default:
+				children[tok.Name.Local] = []any{prev, child}
+			}
+		case xml.CharData:
+			text.Write(tok)
+		case xml.EndElement:
+			if children != nil {
+				return children, nil
+			}
+			return text.String(), nil
+		}
+	}
+}
+
+func xmlFromJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+
+	var err error
+	if items, ok := v.([]any); ok {
+		err = writeXMLList(enc, xmlPersonsElement, xmlPersonElement, items)
+	} else {
+		err = writeXML(enc, xmlPersonElement, v)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = enc.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeXMLList(enc *xml.Encoder, name, itemName string, items []any) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := writeXML(enc, itemName, item); err != nil {
+			return err
+		}
+	}
+	return enc.EncodeToken(start.End())
+}
+
+// writeXML writes v as the element name. Object fields become child
+// elements in the order of their names, and slices repeat the element.
+func writeXML(enc *xml.Encoder, name string, v any) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		for _, item := range v {
+			if err := writeXML(enc, name, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]any:
+		if err := enc.EncodeToken(start); err != nil {
+			return err
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := writeXML(enc, k, v[k]); err != nil {
+				return err
+			}
+		}
+		return enc.EncodeToken(start.End())
+	default:
+		return enc.EncodeElement(fmt.Sprint(v), start)
+	}
+}
+
+func msgpackToJSON(body []byte, _ reflect.Type) ([]byte, error) {
+	dec := msgpack.NewDecoder(bytes.NewReader(body))
+	var tree any
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+	if _, err := dec.PeekCode(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the value")
+	}
+	return json.Marshal(tree)
+}
+
+func msgpackFromJSON(v any) ([]byte, error) {
+	return msgpack.Marshal(msgpackValue(v))
+}
+
+func msgpackValue(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		for i := range v {
+			v[i] = msgpackValue(v[i])
+		}
+	case map[string]any:
+		for k := range v {
+			v[k] = msgpackValue(v[k])
+		}
+	}
+	return v
+}
+
+// csvToJSON reads a header and exactly one record. Empty cells are left out,
+// and a dot in a column name nests the field.
+func csvToJSON(body []byte, t reflect.Type) ([]byte, error) {
+	records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) != 2 {
+		return nil, errors.New("a header and exactly one record are expected")
+	}
+
+	tree := make(map[string]any)
+	for i, column := range records[0] {
+		if records[1][i] == "" {
+			continue
+		}
+		obj := tree
+		path := strings.Split(column, ".")
+		for _, key := range path[:len(path)-1] {
+			next, ok := obj[key].(map[string]any)
+			if !ok {
+				next = make(map[string]any)
+				obj[key] = next
+			}
+			obj = next
+		}
+		obj[path[len(path)-1]] = records[1][i]
+	}
+	return json.Marshal(coerce(tree, t))
+}
+
+func csvFromJSON(v any) ([]byte, error) {
+	items, ok := v.([]any)
+	if !ok {
+		items = []any{v}
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(personCSVColumns); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		record := make([]string, 0, len(personCSVColumns))
+		for _, column := range personCSVColumns {
+			record = append(record, csvCell(item, strings.Split(column, ".")))
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func csvCell(v any, path []string) string {
+	for _, key := range path {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return ""
+		}
+		v = obj[key]
+	}
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// coerce converts strings in tree, which XML and CSV have as the only
+// scalars, to numbers and booleans where fields of t are numbers and
+// booleans. Strings that do not convert are kept, so that decoding reports
+// them as type errors.
+func coerce(tree any, t reflect.Type) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if _, ok := tree.([]any); !ok && t.Kind() == reflect.Slice {
+		tree = []any{tree}
+	}
+
+	switch tree := tree.(type) {
+	case map[string]any:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for k, v := range tree {
+				if ft, ok := fields[k]; ok {
+					tree[k] = coerce(v, ft)
+				}
+			}
+		case reflect.Map:
+			for k, v := range tree {
+				tree[k] = coerce(v, t.Elem())
+			}
+		}
+		return tree
+	case []any:
+		if t.Kind() == reflect.Slice {
+			for i, v := range tree {
+				tree[i] = coerce(v, t.Elem())
+			}
+		}
+		return tree
+	case string:
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if n, err := strconv.ParseInt(strings.TrimSpace(tree), 10, 64); err == nil {
+				return n
+			}
+		case reflect.Float32, reflect.Float64:
+			if f, err := strconv.ParseFloat(strings.TrimSpace(tree), 64); err == nil {
+				return f
+			}
+		case reflect.Bool:
+			if b, err := strconv.ParseBool(strings.TrimSpace(tree)); err == nil {
+				return b
+			}
+		}
+		return tree
+	}
+	return tree
+}
+
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	res := make(map[string]reflect.Type, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		res[name] = f.Type
+	}
+	return res
+}
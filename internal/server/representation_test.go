@@ -0,0 +1,313 @@
+package server
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/gojuno/minimock/v3"
+	"github.com/labstack/echo/v4"
+	"github.com/vmihailenco/msgpack/v5"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name     string
+		accept   string
+		expected *representation
+	}{
+		{name: "no accept", accept: "", expected: jsonRepresentation},
+		{name: "any", accept: "*/*", expected: jsonRepresentation},
+		{name: "xml", accept: "application/xml", expected: xmlRepresentation},
+		{name: "xml alias", accept: "text/xml", expected: xmlRepresentation},
+		{name: "msgpack alias", accept: "application/x-msgpack", expected: msgpackRepresentation},
+		{name: "csv with params", accept: "text/csv; charset=utf-8", expected: csvRepresentation},
+		{name: "subtype wildcard", accept: "text/*", expected: csvRepresentation},
+		{name: "quality", accept: "application/json;q=0.5, application/xml", expected: xmlRepresentation},
+		{name: "order on equal quality", accept: "text/csv, application/xml", expected: csvRepresentation},
+		{name: "unsupported then any", accept: "text/html, */*;q=0.1", expected: jsonRepresentation},
+		{name: "excluded", accept: "application/xml;q=0", expected: nil},
+		{name: "unsupported", accept: "text/html", expected: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rep, ok := negotiate(tt.accept, personRepresentations)
+			if ok != (tt.expected != nil) || rep != tt.expected {
+				t.Errorf("negotiate(%q) expected %v, but got %v", tt.accept, tt.expected, rep)
+			}
+		})
+	}
+}
+
+func TestServer_responseRepresentations(t *testing.T) {
+	mc := minimock.NewController(t)
+	person := regularPerson
+	person.PostalAddress = models.PostalAddress{Country: "RU", City: "Moscow"}
+
+	tests := []struct {
+		name                string
+		accept              string
+		target              string
+		expectedHTTPStatus  int
+		expectedContentType string
+		check               func(t *testing.T, body []byte)
+	}{
+		{
+			name:                "get xml",
+			accept:              "application/xml",
+			target:              "/api/v1/persons/1",
+			expectedHTTPStatus:  http.StatusOK,
+			expectedContentType: echo.MIMEApplicationXMLCharsetUTF8,
+			check: func(t *testing.T, body []byte) {
+				var res struct {
+					XMLName xml.Name `xml:"person"`
+					ID      int32    `xml:"id"`
+					Name    string   `xml:"name"`
+					Country string   `xml:"postal_address>country"`
+				}
+				if err := xml.Unmarshal(body, &res); err != nil {
+					t.Fatalf("can not decode xml: %v\n%s", err, body)
+				}
+				if res.ID != 1 || res.Name != "test" || res.Country != "RU" {
+					t.Errorf("unexpected xml %s", body)
+				}
+			},
+		},
+		{
+			name:                "list xml",
+			accept:              "text/xml",
+			target:              "/api/v1/persons",
+			expectedHTTPStatus:  http.StatusOK,
+			expectedContentType: echo.MIMEApplicationXMLCharsetUTF8,
+			check: func(t *testing.T, body []byte) {
+				var res struct {
+					XMLName xml.Name `xml:"persons"`
+					Persons []struct {
+						Name string `xml:"name"`
+					} `xml:"person"`
+				}
+				if err := xml.Unmarshal(body, &res); err != nil {
+					t.Fatalf("can not decode xml: %v\n%s", err, body)
+				}
+				if len(res.Persons) != 2 || res.Persons[0].Name != "test" {
+					t.Errorf("unexpected xml %s", body)
+				}
+			},
+		},
+		{
+			name:                "get msgpack",
+			accept:              "application/msgpack",
+			target:              "/api/v1/persons/1",
+			expectedHTTPStatus:  http.StatusOK,
+			expectedContentType: mimeApplicationMsgpack,
+			check: func(t *testing.T, body []byte) {
+				var res map[string]any
+				if err := msgpack.Unmarshal(body, &res); err != nil {
+					t.Fatalf("can not decode msgpack: %v", err)
+				}
+				if fmt.Sprint(res["id"]) != "1" || res["name"] != "test" || res["postal_address"].(map[string]any)["city"] != "Moscow" {
+					t.Errorf("unexpected msgpack %v", res)
+				}
+			},
+		},
+		{
+			name:                "list csv",
+			accept:              "text/csv",
+			target:              "/api/v1/persons",
+			expectedHTTPStatus:  http.StatusOK,
+			expectedContentType: mimeTextCSVCharsetUTF8,
+			check: func(t *testing.T, body []byte) {
+				records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+				if err != nil {
+					t.Fatalf("can not decode csv: %v", err)
+				}
+				if len(records) != 3 || !reflect.DeepEqual(records[0], personCSVColumns) {
+					t.Fatalf("unexpected csv %s", body)
+				}
+				if records[1][0] != "1" || records[1][1] != "test" || records[1][7] != "RU" || records[2][7] != "" {
+					t.Errorf("unexpected csv %s", body)
+				}
+			},
+		},
+		{
+			name:               "not acceptable",
+			accept:             "text/html",
+			target:             "/api/v1/persons/1",
+			expectedHTTPStatus: http.StatusNotAcceptable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := NewPersonRepositoryMock(mc)
+			if tt.expectedHTTPStatus == http.StatusOK {
+				pr.GetPersonByIDMock.Optional().Return(person, nil).
+					GetAllPersonMock.Optional().Return([]models.Person{person, regularPerson}, nil)
+			}
+			s := newTestServer(t, config.Config{}, pr)
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			req.Header.Set("Accept", tt.accept)
+			rw := httptest.NewRecorder()
+			s.ServeHTTP(rw, req)
+
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Fatalf("http-code expected %d, but got %d: %s", tt.expectedHTTPStatus, rw.Code, rw.Body)
+			}
+			if tt.check == nil {
+				return
+			}
+			if ct := rw.Header().Get("Content-Type"); ct != tt.expectedContentType {
+				t.Errorf("content type expected %q, but got %q", tt.expectedContentType, ct)
+			}
+			if vary := rw.Header().Values("Vary"); !slices.Contains(vary, "Accept") {
+				t.Errorf("expected Vary: Accept, but got %q", vary)
+			}
+			tt.check(t, rw.Body.Bytes())
+		})
+	}
+}
+
+func TestServer_requestRepresentations(t *testing.T) {
+	mc := minimock.NewController(t)
+	expected := models.Person{
+		Name:          "test",
+		Age:           20,
+		PostalAddress: models.PostalAddress{Country: "RU", City: "Moscow"},
+	}
+	msgpackBody, err := msgpack.Marshal(map[string]any{
+		"name":           "test",
+		"age":            20,
+		"postal_address": map[string]any{"country": "RU", "city": "Moscow"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name               string
+		contentType        string
+		body               string
+		expectedHTTPStatus int
+		expectedErrors     map[string]string
+	}{
+		{
+			name:               "xml",
+			contentType:        "application/xml",
+			body:               `<?xml version="1.0"?><person><name>test</name><age>20</age><postal_address><country>RU</country><city>Moscow</city></postal_address></person>`,
+			expectedHTTPStatus: http.StatusCreated,
+		},
+		{
+			name:               "msgpack",
+			contentType:        "application/msgpack",
+			body:               string(msgpackBody),
+			expectedHTTPStatus: http.StatusCreated,
+		},
+		{
+			name:               "csv",
+			contentType:        "text/csv",
+			body:               "name,age,email,postal_address.country,postal_address.city\ntest,20,,RU,Moscow\n",
+			expectedHTTPStatus: http.StatusCreated,
+		},
+		{
+			name:               "xml unknown element",
+			contentType:        "application/xml",
+			body:               `<person><name>test</name><nickname>t</nickname></person>`,
+			expectedHTTPStatus: http.StatusBadRequest,
+			expectedErrors:     map[string]string{"nickname": "unknown field"},
+		},
+		{
+			name:               "xml read-only id",
+			contentType:        "text/xml",
+			body:               `<person><id>5</id><name>test</name></person>`,
+			expectedHTTPStatus: http.StatusBadRequest,
+			expectedErrors:     map[string]string{"id": "field is read-only"},
+		},
+		{
+			name:               "xml wrong type",
+			contentType:        "application/xml",
+			body:               `<person><name>test</name><age>ten</age></person>`,
+			expectedHTTPStatus: http.StatusBadRequest,
+			expectedErrors:     map[string]string{"age": "must be int32, not string"},
+		},
+		{
+			name:               "xml syntax error",
+			contentType:        "application/xml",
+			body:               `<person><name>test</person>`,
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "csv many records",
+			contentType:        "text/csv",
+			body:               "name\na\nb\n",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "msgpack garbage",
+			contentType:        "application/msgpack",
+			body:               "\xc1",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "unsupported",
+			contentType:        "application/yaml",
+			body:               "name: test",
+			expectedHTTPStatus: http.StatusUnsupportedMediaType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := NewPersonRepositoryMock(mc)
+			if tt.expectedHTTPStatus == http.StatusCreated {
+				pr.CreatePersonMock.Expect(expected).Return(regularPerson, nil)
+			}
+			s := newTestServer(t, config.Config{}, pr)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			rw := httptest.NewRecorder()
+			s.ServeHTTP(rw, req)
+
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Fatalf("http-code expected %d, but got %d: %s", tt.expectedHTTPStatus, rw.Code, rw.Body)
+			}
+			if tt.expectedErrors == nil {
+				return
+			}
+
+			var res openapi.ValidationErrorResponse
+			if err := json.Unmarshal(rw.Body.Bytes(), &res); err != nil {
+				t.Fatalf("can not decode response: %v", err)
+			}
+			if !reflect.DeepEqual(res.Errors, tt.expectedErrors) {
+				t.Errorf("errors expected %v, but got %v", tt.expectedErrors, res.Errors)
+			}
+			if res.Position != nil {
+				t.Errorf("position expected only for json bodies, but got %+v", res.Position)
+			}
+		})
+	}
+}
+
+func TestServer_editNotAcceptable(t *testing.T) {
+	s := newTestServer(t, config.Config{}, NewPersonRepositoryMock(minimock.NewController(t)))
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/persons/1", strings.NewReader(`{"name":"test"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "image/png")
+	rw := httptest.NewRecorder()
+	s.ServeHTTP(rw, req)
+
+	if rw.Code != http.StatusNotAcceptable {
+		t.Errorf("editPerson() http-code expected %d, but got %d", http.StatusNotAcceptable, rw.Code)
+	}
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// defaultContentSecurityPolicy allows the docs page, which loads Swagger UI
+// from this server and styles it inline, and nothing from other origins.
+const defaultContentSecurityPolicy = "default-src 'self'; img-src 'self' data:; style-src 'self' 'unsafe-inline'; " +
+	"connect-src %s; frame-ancestors 'none'; base-uri 'self'; form-action 'self'"
+
+// secureHeaders sets headers that keep browsers from sniffing content types,
+// framing the pages, leaking the URL in Referer and, on HTTPS, from falling
+// back to HTTP.
+func secureHeaders(cfg config.Config) echo.MiddlewareFunc {
+	return middleware.SecureWithConfig(middleware.SecureConfig{
+		// The XSS auditor is gone from browsers, and was a source of leaks.
+		XSSProtection:         "0",
+		ContentTypeNosniff:    "nosniff",
+		XFrameOptions:         "DENY",
+		HSTSMaxAge:            int(cfg.HSTSMaxAge.Seconds()),
+		ContentSecurityPolicy: contentSecurityPolicy(cfg),
+		ReferrerPolicy:        cfg.ReferrerPolicy,
+	})
+}
+
+func contentSecurityPolicy(cfg config.Config) string {
+	if cfg.ContentSecurityPolicy != "" {
+		return cfg.ContentSecurityPolicy
+	}
+	// "Try it out" of the docs calls the API at the public URL.
+	connect := "'self'"
+	if cfg.PublicURL != "" {
+		connect += " " + cfg.PublicBaseURL()
+	}
+	return fmt.Sprintf(defaultContentSecurityPolicy, connect)
+}
+
+func (s *Server) secure(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		secure := s.settings().secure
+		if secure == nil {
+			return next(c)
+		}
+		return secure(next)(c)
+	}
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/gojuno/minimock/v3"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServer_corsPreflight(t *testing.T) {
+	cfg := config.Config{
+		CORSAllowOrigins:     []string{"https://app.example"},
+		CORSAllowMethods:     []string{"GET", "PATCH"},
+		CORSAllowHeaders:     []string{"Content-Type", "If-None-Match"},
+		CORSAllowCredentials: true,
+		CORSMaxAge:           10 * time.Minute,
+	}
+
+	tests := []struct {
+		name               string
+		cfg                config.Config
+		origin             string
+		expectedHTTPStatus int
+		expectedHeaders    map[string]string
+	}{
+		{
+			name:               "http-204: allowed origin",
+			cfg:                cfg,
+			origin:             "https://app.example",
+			expectedHTTPStatus: 204,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://app.example",
+				"Access-Control-Allow-Methods":     "GET,PATCH",
+				"Access-Control-Allow-Headers":     "Content-Type,If-None-Match",
+				"Access-Control-Allow-Credentials": "true",
+				"Access-Control-Max-Age":           "600",
+			},
+		},
+		{
+			name:               "http-204: other origin",
+			cfg:                cfg,
+			origin:             "https://evil.example",
+			expectedHTTPStatus: 204,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "",
+				"Access-Control-Allow-Methods":     "",
+				"Access-Control-Allow-Credentials": "",
+			},
+		},
+		{
+			name:               "http-204: any origin without credentials",
+			cfg:                config.Config{CORSAllowOrigins: []string{"*"}},
+			origin:             "https://evil.example",
+			expectedHTTPStatus: 204,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "*",
+				"Access-Control-Allow-Credentials": "",
+			},
+		},
+		{
+			name: "http-204: tenant header",
+			cfg: config.Config{
+				CORSAllowOrigins:  []string{"*"},
+				CORSAllowHeaders:  []string{"Content-Type"},
+				CORSExposeHeaders: []string{"X-Next-Cursor"},
+				TenantSource:      config.TenantSourceHeader,
+				TenantHeader:      "X-Tenant-ID",
+				Tenants:           []string{"acme"},
+			},
+			origin:             "https://app.example",
+			expectedHTTPStatus: 204,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "*",
+				"Access-Control-Allow-Headers": "Content-Type,X-Tenant-ID",
+			},
+		},
+		{
+			name:               "http-204: no origins",
+			cfg:                config.Config{},
+			origin:             "https://app.example",
+			expectedHTTPStatus: 204,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "",
+				"Access-Control-Allow-Methods": "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, tt.cfg, NewPersonRepositoryMock(minimock.NewController(t)))
+
+			req := httptest.NewRequest(http.MethodOptions, "/api/v1/persons/1", nil)
+			req.Header.Set("Origin", tt.origin)
+			req.Header.Set("Access-Control-Request-Method", http.MethodPatch)
+			req.Header.Set("Access-Control-Request-Headers", "Content-Type")
+			rw := httptest.NewRecorder()
+			s.ServeHTTP(rw, req)
+
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Errorf("preflight http-code expected %d, but got %d", tt.expectedHTTPStatus, rw.Code)
+			}
+			for header, expected := range tt.expectedHeaders {
+				if got := rw.Header().Get(header); got != expected {
+					t.Errorf("%s expected %q, but got %q", header, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_secureHeaders(t *testing.T) {
+	cfg := config.Config{
+		PublicURL:      "https://persons.example",
+		HSTSMaxAge:     24 * time.Hour,
+		ReferrerPolicy: "no-referrer",
+	}
+	s := newTestServer(t, cfg, NewPersonRepositoryMock(minimock.NewController(t)))
+	docs := func(proto string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, docsPath+"/", nil)
+		req.Header.Set("X-Forwarded-Proto", proto)
+		rw := httptest.NewRecorder()
+		s.ServeHTTP(rw, req)
+		return rw
+	}
+
+	rw := docs("https")
+	if rw.Code != http.StatusOK {
+		t.Fatalf("docs http-code expected 200, but got %d", rw.Code)
+	}
+	expected := map[string]string{
+		"X-Content-Type-Options":    "nosniff",
+		"X-Frame-Options":           "DENY",
+		"Referrer-Policy":           "no-referrer",
+		"Strict-Transport-Security": "max-age=86400; includeSubdomains",
+	}
+	for header, value := range expected {
+		if got := rw.Header().Get(header); got != value {
+			t.Errorf("%s expected %q, but got %q", header, value, got)
+		}
+	}
+	csp := rw.Header().Get("Content-Security-Policy")
+	for _, directive := range []string{"default-src 'self'", "connect-src 'self' https://persons.example", "frame-ancestors 'none'"} {
+		if !strings.Contains(csp, directive) {
+			t.Errorf("Content-Security-Policy expected to contain %q, but got %q", directive, csp)
+		}
+	}
+
+	if hsts := docs("http").Header().Get("Strict-Transport-Security"); hsts != "" {
+		t.Errorf("Strict-Transport-Security expected only over HTTPS, but got %q", hsts)
+	}
+}
@@ -3,79 +3,143 @@ package server
 import (
 	"context"
 	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
 	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/validation"
-	"github.com/go-playground/validator/v10"
-	"os"
-	"os/signal"
-	"syscall"
+	"github.com/graphql-go/graphql"
+	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/charmbracelet/log"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 )
 
 type Server struct {
 	echo *echo.Echo
-	pr   personRepository
+	// pr serves the requests of a Server that was not made by New, which
+	// take repositories of their tenant from repos.
+	pr        PersonRepository
+	repos     PersonRepositories
+	tenants   *tenantResolver
+	gqlSchema graphql.Schema
+	docs      *apiDocs
+
+	maintenance *Maintenance
+
+	current atomic.Pointer[settings]
+	// certs is nil when the server serves plain HTTP.
+	certs             *certReloader
+	tlsReloadInterval time.Duration
 }
 
-const gracefulShutdownDeadline = 10 * time.Second
+func New(cfg config.Config, pr PersonRepository) (*Server, error) {
+	return NewMultiTenant(cfg, singleTenant(pr))
+}
 
-func New(pr personRepository) *Server {
+func NewMultiTenant(cfg config.Config, repos PersonRepositories) (*Server, error) {
 	e := echo.New()
 	s := &Server{
-		echo: e,
-		pr:   pr,
+		echo:        e,
+		repos:       repos,
+		tenants:     newTenantResolver(cfg),
+		maintenance: &Maintenance{},
+	}
+	s.Reload(cfg)
+
+	if cfg.TLSEnabled() {
+		certs, err := newCertReloader(cfg)
+		if err != nil {
+			return nil, err
+		}
+		s.certs, s.tlsReloadInterval = certs, cfg.TLSReloadInterval
 	}
 
-	s.echo.Validator = validation.MustRegisterCustomValidator(validator.New())
+	s.echo.Validator = validation.NewEchoValidator()
+	s.echo.IPExtractor = ipExtractor(cfg.TrustedProxies)
 
-	s.echo.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins:     []string{"*"},
-		AllowCredentials: true,
-	}))
+	schema, err := newGraphQLSchema(validation.Default(), s.maintenance)
+	if err != nil {
+		return nil, err
+	}
+	s.gqlSchema = schema
 
+	s.echo.Use(s.secure)
+	s.echo.Use(s.cors)
+	s.echo.Use(clientPrincipal)
 	s.echo.Use(s.logRequest)
+	s.echo.Use(s.rateLimit)
+	s.echo.Use(s.refuseWrites)
+	s.echo.Use(s.tenant)
+	s.echo.Use(s.markWrites)
+	s.echo.Use(s.compress)
+
+	if cfg.AppEnv == config.EnvDev {
+		sv, err := newSpecValidator()
+		if err != nil {
+			return nil, err
+		}
+		s.echo.Use(sv.middleware)
+	}
 
-	api := s.echo.Group("/api/v1")
+	docs, err := newAPIDocs(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.docs = docs
+
+	openapi.RegisterHandlers(s.echo, s)
+	s.registerDocs()
 
-	persons := api.Group("/persons")
-	persons.POST("", s.createPerson)
-	persons.GET("", s.getPersons)
-	persons.GET("/:id", s.getPersonByID)
-	persons.PATCH("/:id", s.updatePerson)
-	persons.DELETE("/:id", s.deletePersonByID)
+	s.echo.GET(graphqlPath, s.graphql)
+	s.echo.POST(graphqlPath, s.graphql)
 
-	return s
+	return s, nil
 }
 
-func (s *Server) Run(port int) {
-	portStr := fmt.Sprintf(":%d", port)
-	go func() {
-		log.Info("server starting on", "port", portStr)
-		if err := s.echo.Start(portStr); err != nil {
-			log.Fatal(err)
+func singleTenant(pr PersonRepository) PersonRepositories {
+	return func(tenant string) (PersonRepository, error) {
+		if tenant != models.DefaultTenant {
+			return nil, fmt.Errorf("tenant %q: %w", tenant, models.ErrUnknownTenant)
 		}
-	}()
+		return pr, nil
+	}
+}
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+// Maintenance is shared with the gRPC server and toggled by the admin server.
+func (s *Server) Maintenance() *Maintenance {
+	return s.maintenance
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), gracefulShutdownDeadline)
-	defer cancel()
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.echo.ServeHTTP(w, r)
+}
 
-	log.Info("server shutting down")
-	if err := s.echo.Server.Shutdown(ctx); err != nil {
-		log.Fatal(err)
+func (s *Server) Run(port int) error {
+	if s.certs != nil {
+		ctx, stop := context.WithCancel(context.Background())
+		defer stop()
+		go s.certs.watch(ctx, s.tlsReloadInterval)
 	}
+
+	portStr := fmt.Sprintf(":%d", port)
+	log.Info("server starting on", "port", portStr, "tls", s.certs != nil)
+	return s.start(portStr)
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	log.Info("server shutting down")
+	return s.echo.Server.Shutdown(ctx)
 }
 
 func (s *Server) logRequest(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		err := next(c)
 		logStr := fmt.Sprintf("%s %s %d", c.Request().Method, c.Request().RequestURI, c.Response().Status)
+		if p, ok := principal(c); ok {
+			logStr = fmt.Sprintf("%s, client: %s", logStr, p)
+		}
 		if err != nil {
 			logStr = fmt.Sprintf("%s, err: %v", logStr, err)
 			log.Error(logStr)
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// headerLastWrite carries the time of the last write of a client. Writes
+// answer it and clients send it back, so that their reads see their writes
+// whichever instance serves them.
+const (
+	headerLastWrite   = "X-Last-Write"
+	metadataLastWrite = "x-last-write"
+)
+
+type wroteCtxKey struct{}
+
+// A missing or malformed time leaves reads to the replicas.
+func session(repo PersonRepository, lastWrite string) PersonRepository {
+	at, err := time.Parse(time.RFC3339Nano, lastWrite)
+	if err != nil {
+		return repo
+	}
+	return repo.Session(at)
+}
+
+func lastWriteNow() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// The GraphQL handler marks mutations itself.
+func (s *Server) markWrites(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		switch c.Request().Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return next(c)
+		}
+		if c.Path() == graphqlPath {
+			return next(c)
+		}
+
+		res := c.Response()
+		res.Before(func() {
+			if res.Status < http.StatusMultipleChoices {
+				res.Header().Set(headerLastWrite, lastWriteNow())
+			}
+		})
+		return next(c)
+	}
+}
+
+func markWritesUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err == nil && grpcWrites[info.FullMethod] {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(metadataLastWrite, lastWriteNow()))
+	}
+	return resp, err
+}
+
+func wrote(ctx context.Context) {
+	if w, ok := ctx.Value(wroteCtxKey{}).(*atomic.Bool); ok {
+		w.Store(true)
+	}
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	personv1 "github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/person/v1"
+	"github.com/gojuno/minimock/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServer_markWrites(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		body            string
+		pr              func(mc *minimock.Controller) PersonRepository
+		expectedMarking bool
+	}{
+		{
+			name:   "create",
+			method: http.MethodPost,
+			path:   "/api/v1/persons",
+			body:   `{"name": "Ivan"}`,
+			pr: func(mc *minimock.Controller) PersonRepository {
+				return NewPersonRepositoryMock(mc).CreatePersonMock.Return(regularPerson, nil)
+			},
+			expectedMarking: true,
+		},
+		{
+			name:   "failed delete",
+			method: http.MethodDelete,
+			path:   "/api/v1/persons/1",
+			pr: func(mc *minimock.Controller) PersonRepository {
+				return NewPersonRepositoryMock(mc).DeletePersonByIDMock.Return(gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name:   "read",
+			method: http.MethodGet,
+			path:   "/api/v1/persons/1",
+			pr: func(mc *minimock.Controller) PersonRepository {
+				return NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(regularPerson, nil)
+			},
+		},
+		{
+			name:   "graphql mutation",
+			method: http.MethodPost,
+			path:   "/graphql",
+			body:   `{"query": "mutation { deletePerson(id: 1) }"}`,
+			pr: func(mc *minimock.Controller) PersonRepository {
+				return NewPersonRepositoryMock(mc).DeletePersonByIDMock.Return(nil)
+			},
+			expectedMarking: true,
+		},
+		{
+			name:   "graphql query",
+			method: http.MethodPost,
+			path:   "/graphql",
+			body:   `{"query": "{ person(id: 1) { name } }"}`,
+			pr: func(mc *minimock.Controller) PersonRepository {
+				return NewPersonRepositoryMock(mc).GetPersonsByIDsMock.Return(nil, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, config.Config{}, tt.pr(minimock.NewController(t)))
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rw := httptest.NewRecorder()
+			s.ServeHTTP(rw, req)
+
+			lastWrite := rw.Header().Get(headerLastWrite)
+			if marked := lastWrite != ""; marked != tt.expectedMarking {
+				t.Fatalf("%s %s marked as a write expected %v, but got %q", tt.method, tt.path, tt.expectedMarking, lastWrite)
+			}
+			if _, err := time.Parse(time.RFC3339Nano, lastWrite); tt.expectedMarking && err != nil {
+				t.Errorf("%s expected a time, but got %q", headerLastWrite, lastWrite)
+			}
+		})
+	}
+}
+
+func TestServer_session(t *testing.T) {
+	lastWrite := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	mc := minimock.NewController(t)
+	pr := NewPersonRepositoryMock(mc)
+	pr.SessionMock.Expect(lastWrite).Return(pr)
+	pr.GetPersonByIDMock.Return(regularPerson, nil)
+	s := newTestServer(t, config.Config{}, pr)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/1", nil)
+	req.Header.Set(headerLastWrite, lastWrite.Format(time.RFC3339Nano))
+	rw := httptest.NewRecorder()
+	s.ServeHTTP(rw, req)
+
+	if rw.Code != http.StatusOK {
+		t.Errorf("http-code expected %d, but got %d", http.StatusOK, rw.Code)
+	}
+}
+
+func TestGRPCServer_markWrites(t *testing.T) {
+	tests := []struct {
+		method          string
+		err             error
+		expectedMarking bool
+	}{
+		{method: personv1.PersonService_GetPerson_FullMethodName},
+		{method: personv1.PersonService_CreatePerson_FullMethodName, expectedMarking: true},
+		{method: personv1.PersonService_DeletePerson_FullMethodName, err: status.Error(codes.NotFound, "not found")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			handler := func(context.Context, any) (any, error) { return &personv1.Person{}, tt.err }
+
+			_, _ = markWritesUnary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if marked := len(stream.header.Get(metadataLastWrite)) > 0; marked != tt.expectedMarking {
+				t.Errorf("%s marked as a write expected %v, but got %v", tt.method, tt.expectedMarking, marked)
+			}
+		})
+	}
+}
+
+// headerStream keeps the headers set by a unary call.
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return "" }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerStream) SetTrailer(metadata.MD) error { return nil }
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+	"math"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const rateLimiterExpiresIn = 3 * time.Minute
+
+// settings are the parts of the config that Reload changes while the server
+// runs. They are replaced as a whole, so a request that loads them once sees
+// either the old or the new ones.
+type settings struct {
+	strictDecoding     bool
+	maxBodySize        int64
+	compressionMinSize int
+	cacheMaxAge        time.Duration
+
+	cors   echo.MiddlewareFunc
+	secure echo.MiddlewareFunc
+	// limiter is nil when requests are not limited.
+	limiter        *middleware.RateLimiterMemoryStore
+	rateLimit      float64
+	rateLimitBurst int
+}
+
+var noSettings = &settings{}
+
+func (s *Server) settings() *settings {
+	if st := s.current.Load(); st != nil {
+		return st
+	}
+	return noSettings
+}
+
+// Reload lets requests in flight finish with the settings they started with.
+func (s *Server) Reload(cfg config.Config) {
+	st := &settings{
+		strictDecoding:     !cfg.LenientDecoding,
+		maxBodySize:        cfg.MaxBodySize,
+		compressionMinSize: cfg.CompressionMinSize,
+		cacheMaxAge:        cfg.CacheMaxAge,
+		cors:               corsMiddleware(cfg),
+		secure:             secureHeaders(cfg),
+		rateLimit:          cfg.RateLimit,
+		rateLimitBurst:     cfg.RateLimitBurst,
+	}
+
+	// Clients keep their budget unless the limit changes.
+	prev := s.settings()
+	switch {
+	case st.rateLimit <= 0:
+	case prev.limiter != nil && prev.rateLimit == st.rateLimit && prev.rateLimitBurst == st.rateLimitBurst:
+		st.limiter = prev.limiter
+	default:
+		st.limiter = middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+			Rate:      rate.Limit(st.rateLimit),
+			Burst:     st.rateLimitBurst,
+			ExpiresIn: rateLimiterExpiresIn,
+		})
+	}
+
+	s.current.Store(st)
+}
+
+// corsMiddleware is nil without allowed origins, as echo would allow any.
+func corsMiddleware(cfg config.Config) echo.MiddlewareFunc {
+	if len(cfg.CORSAllowOrigins) == 0 {
+		return nil
+	}
+	allow, expose := cfg.CORSAllowHeaders, cfg.CORSExposeHeaders
+	if cfg.TenantSource == config.TenantSourceHeader {
+		allow = withHeader(allow, cfg.TenantHeader)
+		expose = withHeader(expose, cfg.TenantHeader)
+	}
+	return middleware.CORSWithConfig(middleware.CORSConfig{
+		AllowOrigins:     cfg.CORSAllowOrigins,
+		AllowMethods:     cfg.CORSAllowMethods,
+		AllowHeaders:     allow,
+		ExposeHeaders:    expose,
+		AllowCredentials: cfg.CORSAllowCredentials,
+		MaxAge:           int(cfg.CORSMaxAge.Seconds()),
+	})
+}
+
+// withHeader returns headers with name, without changing headers.
+func withHeader(headers []string, name string) []string {
+	for _, h := range headers {
+		if http.CanonicalHeaderKey(h) == http.CanonicalHeaderKey(name) {
+			return headers
+		}
+	}
+	return append(slices.Clip(headers), name)
+}
+
+func (s *Server) cors(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		cors := s.settings().cors
+		if cors == nil {
+			return next(c)
+		}
+		return cors(next)(c)
+	}
+}
+
+// ipExtractor takes X-Forwarded-For from the trusted proxies only, so that
+// clients can not pick the IP they are limited by.
+func ipExtractor(trustedProxies []string) echo.IPExtractor {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	opts := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, cidr := range trustedProxies {
+		// The config is validated.
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+			opts = append(opts, echo.TrustIPRange(ipNet))
+		}
+	}
+	return echo.ExtractIPFromXFFHeader(opts...)
+}
+
+func (s *Server) rateLimit(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		st := s.settings()
+		if st.limiter == nil {
+			return next(c)
+		}
+		if allowed, err := st.limiter.Allow(c.RealIP()); err != nil || allowed {
+			return next(c)
+		}
+
+		retryAfter := int(math.Ceil(1 / st.rateLimit))
+		c.Response().Header().Set(headerRetryAfter, strconv.Itoa(max(retryAfter, 1)))
+		return c.JSON(http.StatusTooManyRequests, openapi.ErrorResponse{
+			Message: "too many requests, retry later",
+		})
+	}
+}
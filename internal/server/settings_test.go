@@ -0,0 +1,120 @@
+package server
+
+import (
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/gojuno/minimock/v3"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestServer_Reload(t *testing.T) {
+	mc := minimock.NewController(t)
+	cfg := config.Config{CORSAllowOrigins: []string{"https://a.example"}}
+	s := newTestServer(t, cfg, NewPersonRepositoryMock(mc).GetAllPersonMock.Return([]models.Person{regularPerson}, nil))
+	list := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/persons", nil)
+		req.Header.Set("Origin", "https://b.example")
+		rw := httptest.NewRecorder()
+		s.ServeHTTP(rw, req)
+		return rw
+	}
+
+	rw := list()
+	if origin := rw.Header().Get("Access-Control-Allow-Origin"); origin != "" {
+		t.Errorf("Access-Control-Allow-Origin expected none, but got %q", origin)
+	}
+	if cc := rw.Header().Get("Cache-Control"); cc != "no-cache" {
+		t.Errorf("Cache-Control expected %q, but got %q", "no-cache", cc)
+	}
+
+	cfg.CORSAllowOrigins = append(cfg.CORSAllowOrigins, "https://b.example")
+	cfg.CacheMaxAge = time.Minute
+	s.Reload(cfg)
+
+	rw = list()
+	if origin := rw.Header().Get("Access-Control-Allow-Origin"); origin != "https://b.example" {
+		t.Errorf("Access-Control-Allow-Origin expected %q, but got %q", "https://b.example", origin)
+	}
+	if cc := rw.Header().Get("Cache-Control"); cc != "max-age=60" {
+		t.Errorf("Cache-Control expected %q, but got %q", "max-age=60", cc)
+	}
+}
+
+func TestServer_rateLimit(t *testing.T) {
+	mc := minimock.NewController(t)
+	cfg := config.Config{RateLimit: 0.5, RateLimitBurst: 2}
+	s := newTestServer(t, cfg, NewPersonRepositoryMock(mc).GetAllPersonMock.Return(nil, nil))
+	list := func(ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/persons", nil)
+		req.RemoteAddr = ip + ":1234"
+		rw := httptest.NewRecorder()
+		s.ServeHTTP(rw, req)
+		return rw
+	}
+
+	for i := 0; i < 2; i++ {
+		if rw := list("10.0.0.1"); rw.Code != http.StatusOK {
+			t.Fatalf("request %d within the burst expected 200, but got %d", i, rw.Code)
+		}
+	}
+	rw := list("10.0.0.1")
+	if rw.Code != http.StatusTooManyRequests {
+		t.Errorf("request over the limit expected 429, but got %d", rw.Code)
+	}
+	if retryAfter := rw.Header().Get("Retry-After"); retryAfter != "2" {
+		t.Errorf("Retry-After expected 2, but got %q", retryAfter)
+	}
+	if rw = list("10.0.0.2"); rw.Code != http.StatusOK {
+		t.Errorf("request of another client expected 200, but got %d", rw.Code)
+	}
+
+	// The same limit keeps the budgets, no limit lets everything through.
+	s.Reload(cfg)
+	if rw = list("10.0.0.1"); rw.Code != http.StatusTooManyRequests {
+		t.Errorf("request after reloading the same limit expected 429, but got %d", rw.Code)
+	}
+	cfg.RateLimit = 0
+	s.Reload(cfg)
+	if rw = list("10.0.0.1"); rw.Code != http.StatusOK {
+		t.Errorf("request without a limit expected 200, but got %d", rw.Code)
+	}
+}
+
+func TestServer_rateLimitClientIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		remoteAddr     string
+		expectedCode   int
+	}{
+		{name: "forwarded for by a client", remoteAddr: "10.0.0.1", expectedCode: http.StatusTooManyRequests},
+		{name: "forwarded for by an untrusted proxy", trustedProxies: []string{"192.168.0.0/16"}, remoteAddr: "10.0.0.1", expectedCode: http.StatusTooManyRequests},
+		{name: "forwarded for by a trusted proxy", trustedProxies: []string{"10.0.0.0/8"}, remoteAddr: "10.0.0.1", expectedCode: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			cfg := config.Config{RateLimit: 0.5, RateLimitBurst: 1, TrustedProxies: tt.trustedProxies}
+			s := newTestServer(t, cfg, NewPersonRepositoryMock(mc).GetAllPersonMock.Optional().Return(nil, nil))
+			list := func(forwardedFor string) int {
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/persons", nil)
+				req.RemoteAddr = tt.remoteAddr + ":1234"
+				req.Header.Set(echo.HeaderXForwardedFor, forwardedFor)
+				rw := httptest.NewRecorder()
+				s.ServeHTTP(rw, req)
+				return rw.Code
+			}
+
+			if code := list("203.0.113.1"); code != http.StatusOK {
+				t.Fatalf("first request expected 200, but got %d", code)
+			}
+			if code := list("203.0.113.2"); code != tt.expectedCode {
+				t.Errorf("request with another X-Forwarded-For expected %d, but got %d", tt.expectedCode, code)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/charmbracelet/log"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/labstack/echo/v4"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// specValidator checks requests and responses of the routes described in
+// person-service.yaml. Invalid requests are rejected with 400, invalid
+// responses are only logged. Only JSON bodies are checked, other
+// representations are converted to and from JSON by the handlers.
+type specValidator struct {
+	router routers.Router
+}
+
+func newSpecValidator() (*specValidator, error) {
+	spec, err := openapi.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("load openapi spec error: %w", err)
+	}
+	spec.Servers = nil
+
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("build openapi router error: %w", err)
+	}
+	return &specValidator{router: router}, nil
+}
+
+func (v *specValidator) middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		route, pathParams, err := v.router.FindRoute(req)
+		if err != nil {
+			return next(c)
+		}
+
+		reqInput := &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError:         true,
+				ExcludeRequestBody: !isJSON(req.Header.Get(echo.HeaderContentType)),
+				AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			},
+		}
+		if err = openapi3filter.ValidateRequest(req.Context(), reqInput); err != nil {
+			log.Errorf("request does not match spec: %v", err)
+			return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
+				Message: "request does not match the API specification",
+				Errors:  specErrors(err),
+			})
+		}
+
+		body := &bytes.Buffer{}
+		c.Response().Writer = &teeWriter{ResponseWriter: c.Response().Writer, body: body}
+
+		if err = next(c); err != nil {
+			c.Error(err)
+		}
+
+		respInput := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: reqInput,
+			Status:                 c.Response().Status,
+			Header:                 c.Response().Header(),
+			Body:                   io.NopCloser(body),
+			Options: &openapi3filter.Options{
+				IncludeResponseStatus: true,
+				MultiError:            true,
+				ExcludeResponseBody:   !isJSON(c.Response().Header().Get(echo.HeaderContentType)),
+			},
+		}
+		if err = openapi3filter.ValidateResponse(req.Context(), respInput); err != nil {
+			log.Errorf("response of %s %s does not match spec: %v", req.Method, req.URL.Path, err)
+		}
+		return nil
+	}
+}
+
+func isJSON(contentType string) bool {
+	rep, ok := requestRepresentation(contentType, personRepresentations)
+	return !ok || rep == jsonRepresentation
+}
+
+func specErrors(err error) map[string]string {
+	var merr openapi3.MultiError
+	if !errors.As(err, &merr) {
+		merr = openapi3.MultiError{err}
+	}
+
+	res := make(map[string]string, len(merr))
+	for _, e := range merr {
+		key := "body"
+		var reqErr *openapi3filter.RequestError
+		if errors.As(e, &reqErr) && reqErr.Parameter != nil {
+			key = reqErr.Parameter.Name
+		}
+		var schemaErr *openapi3.SchemaError
+		if errors.As(e, &schemaErr) && len(schemaErr.JSONPointer()) > 0 {
+			key = strings.Join(schemaErr.JSONPointer(), ".")
+		}
+
+		if prev, ok := res[key]; ok {
+			res[key] = prev + "; " + e.Error()
+			continue
+		}
+		res[key] = e.Error()
+	}
+	return res
+}
+
+type teeWriter struct {
+	http.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *teeWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,614 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gojuno/minimock/v3"
+	"gorm.io/gorm"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSpec_routesMatchServer(t *testing.T) {
+	spec, err := openapi.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	s := newTestServer(t, config.Config{}, nil)
+	registered := make(map[string]bool)
+	for _, r := range s.echo.Routes() {
+		if strings.HasPrefix(r.Path, "/api/v1/") {
+			registered[r.Method+" "+r.Path] = true
+		}
+	}
+
+	documented := make(map[string]bool)
+	for path, item := range spec.Paths.Map() {
+		for method := range item.Operations() {
+			key := method + " " + strings.ReplaceAll(strings.ReplaceAll(path, "{", ":"), "}", "")
+			documented[key] = true
+			if !registered[key] {
+				t.Errorf("%s is described in person-service.yaml but not served", key)
+			}
+		}
+	}
+
+	for key := range registered {
+		if !documented[key] {
+			t.Errorf("%s is served but not described in person-service.yaml", key)
+		}
+	}
+}
+
+var (
+	regularOrganization = models.Organization{ID: 1, Name: "Sber"}
+	regularEmployment   = models.Employment{ID: 1, PersonID: 1, OrganizationID: 1, Role: "engineer"}
+)
+
+func TestSpec_responsesMatchSpec(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	spec, err := openapi.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+	spec.Servers = nil
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		t.Fatalf("NewRouter() error = %v", err)
+	}
+
+	dbErr := errors.New("database error")
+	tests := []struct {
+		name               string
+		pr                 PersonRepository
+		method             string
+		target             string
+		body               string
+		expectedHTTPStatus int
+	}{
+		{
+			name:               "list",
+			pr:                 NewPersonRepositoryMock(mc).GetAllPersonMock.Return([]models.Person{regularPerson}, nil),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons",
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "list changes with next page",
+			pr:                 NewPersonRepositoryMock(mc).GetPersonsUpdatedSinceMock.Return([]models.PersonChange{{Person: regularPerson}}, nil),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons?updated_since=2024-10-01T00:00:00Z&limit=1",
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "list changes with bad cursor",
+			pr:                 nil,
+			method:             http.MethodGet,
+			target:             "/api/v1/persons?updated_since=2024-10-01T00:00:00Z&cursor=qwerty",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "list with bad updated_since",
+			pr:                 nil,
+			method:             http.MethodGet,
+			target:             "/api/v1/persons?updated_since=yesterday",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "list database error",
+			pr:                 NewPersonRepositoryMock(mc).GetAllPersonMock.Return(nil, dbErr),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons",
+			expectedHTTPStatus: http.StatusInternalServerError,
+		},
+		{
+			name:               "create",
+			pr:                 NewPersonRepositoryMock(mc).CreatePersonMock.Return(regularPerson, nil),
+			method:             http.MethodPost,
+			target:             "/api/v1/persons",
+			body:               `{"name":"test","age":1}`,
+			expectedHTTPStatus: http.StatusCreated,
+		},
+		{
+			name:               "create validation error",
+			pr:                 nil,
+			method:             http.MethodPost,
+			target:             "/api/v1/persons",
+			body:               `{"name":"test","age":-10}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "create bad json",
+			pr:                 nil,
+			method:             http.MethodPost,
+			target:             "/api/v1/persons",
+			body:               `{"name":`,
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "create database error",
+			pr:                 NewPersonRepositoryMock(mc).CreatePersonMock.Return(models.Person{}, dbErr),
+			method:             http.MethodPost,
+			target:             "/api/v1/persons",
+			body:               `{"name":"test"}`,
+			expectedHTTPStatus: http.StatusInternalServerError,
+		},
+		{
+			name:               "get",
+			pr:                 NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(regularPerson, nil),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1",
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "get bad id",
+			pr:                 nil,
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/qwerty",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name: "get not found",
+			pr: NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, gorm.ErrRecordNotFound).
+				GetMergedPersonIDMock.Return(0, gorm.ErrRecordNotFound),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1",
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name: "get merged",
+			pr: NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, gorm.ErrRecordNotFound).
+				GetMergedPersonIDMock.Return(2, nil),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1",
+			expectedHTTPStatus: http.StatusPermanentRedirect,
+		},
+		{
+			name:               "get database error",
+			pr:                 NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, dbErr),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1",
+			expectedHTTPStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "edit",
+			pr: NewPersonRepositoryMock(mc).UpdatePersonByIDMock.Return(nil).
+				GetPersonByIDMock.Return(regularPerson, nil),
+			method:             http.MethodPatch,
+			target:             "/api/v1/persons/1",
+			body:               `{"name":"test"}`,
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "edit validation error",
+			pr:                 nil,
+			method:             http.MethodPatch,
+			target:             "/api/v1/persons/1",
+			body:               `{"age":-10}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name: "edit not found",
+			pr: NewPersonRepositoryMock(mc).UpdatePersonByIDMock.Return(gorm.ErrRecordNotFound).
+				GetMergedPersonIDMock.Return(0, gorm.ErrRecordNotFound),
+			method:             http.MethodPatch,
+			target:             "/api/v1/persons/1",
+			body:               `{"name":"test"}`,
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name:               "edit database error",
+			pr:                 NewPersonRepositoryMock(mc).UpdatePersonByIDMock.Return(dbErr),
+			method:             http.MethodPatch,
+			target:             "/api/v1/persons/1",
+			body:               `{"name":"test"}`,
+			expectedHTTPStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "duplicates",
+			pr: NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(regularPerson, nil).
+				GetDuplicateCandidatesMock.Return([]models.Person{{ID: 2, Name: "test", Address: "test"}}, nil),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1/duplicates?min_score=0.1&limit=5",
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "duplicates not found",
+			pr:                 NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, gorm.ErrRecordNotFound),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1/duplicates",
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name:               "merge",
+			pr:                 NewPersonRepositoryMock(mc).MergePersonsMock.Return(regularPerson, nil),
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/merge",
+			body:               `{"target_id":1,"source_ids":[2],"fields":{"work":2}}`,
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "merge validation error",
+			pr:                 nil,
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/merge",
+			body:               `{"target_id":1,"source_ids":[1]}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "merge not found",
+			pr:                 NewPersonRepositoryMock(mc).MergePersonsMock.Return(models.Person{}, gorm.ErrRecordNotFound),
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/merge",
+			body:               `{"target_id":1,"source_ids":[2]}`,
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name:               "merge email conflict",
+			pr:                 NewPersonRepositoryMock(mc).MergePersonsMock.Return(models.Person{}, gorm.ErrDuplicatedKey),
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/merge",
+			body:               `{"target_id":1,"source_ids":[2],"fields":{"email":2}}`,
+			expectedHTTPStatus: http.StatusConflict,
+		},
+		{
+			name: "relations",
+			pr: NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(regularPerson, nil).
+				GetRelatedPersonsMock.Expect(models.RelationQuery{
+				PersonID: 1, Types: []models.RelationType{models.RelationManager, models.RelationParent}, Direction: models.RelationBoth, Depth: 3,
+			}).Return([]models.RelatedPerson{{
+				Person: models.Person{ID: 2, Name: "test"}, RelationID: 1, Type: models.RelationManager, Depth: 1, Path: []int32{1, 2},
+			}}, nil),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1/relations?type=manager&type=parent&direction=both&depth=3",
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "relations bad depth",
+			pr:                 nil,
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1/relations?depth=11",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "relations not found",
+			pr:                 NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, gorm.ErrRecordNotFound),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1/relations",
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name: "create relation",
+			pr: NewPersonRepositoryMock(mc).CreateRelationMock.Return(models.PersonRelation{
+				ID: 1, FromID: 1, ToID: 2, Type: models.RelationSpouse, Bidirectional: true,
+			}, nil),
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/1/relations",
+			body:               `{"to_id":2,"type":"spouse"}`,
+			expectedHTTPStatus: http.StatusCreated,
+		},
+		{
+			name:               "create relation to itself",
+			pr:                 nil,
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/1/relations",
+			body:               `{"to_id":1,"type":"manager"}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "create relation not found",
+			pr:                 NewPersonRepositoryMock(mc).CreateRelationMock.Return(models.PersonRelation{}, gorm.ErrForeignKeyViolated),
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/1/relations",
+			body:               `{"to_id":2,"type":"manager"}`,
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name:               "create relation conflict",
+			pr:                 NewPersonRepositoryMock(mc).CreateRelationMock.Return(models.PersonRelation{}, gorm.ErrDuplicatedKey),
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/1/relations",
+			body:               `{"to_id":2,"type":"parent"}`,
+			expectedHTTPStatus: http.StatusConflict,
+		},
+		{
+			name:               "delete relation",
+			pr:                 NewPersonRepositoryMock(mc).DeleteRelationMock.Return(nil),
+			method:             http.MethodDelete,
+			target:             "/api/v1/persons/1/relations/1",
+			expectedHTTPStatus: http.StatusNoContent,
+		},
+		{
+			name:               "delete relation not found",
+			pr:                 NewPersonRepositoryMock(mc).DeleteRelationMock.Return(gorm.ErrRecordNotFound),
+			method:             http.MethodDelete,
+			target:             "/api/v1/persons/1/relations/1",
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name:               "employments",
+			pr:                 NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(regularPerson, nil).GetEmploymentsMock.Return([]models.Employment{regularEmployment}, nil),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1/employments",
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "employments not found",
+			pr:                 NewPersonRepositoryMock(mc).GetPersonByIDMock.Return(models.Person{}, gorm.ErrRecordNotFound),
+			method:             http.MethodGet,
+			target:             "/api/v1/persons/1/employments",
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name:               "create employment",
+			pr:                 NewPersonRepositoryMock(mc).CreateEmploymentMock.Return(regularEmployment, nil),
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/1/employments",
+			body:               `{"organization_id":1,"role":"engineer","start_date":"2020-01-01"}`,
+			expectedHTTPStatus: http.StatusCreated,
+		},
+		{
+			name:               "create employment ending before start",
+			pr:                 nil,
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/1/employments",
+			body:               `{"organization_id":1,"start_date":"2020-01-01","end_date":"2019-01-01"}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "create employment not found",
+			pr:                 NewPersonRepositoryMock(mc).CreateEmploymentMock.Return(models.Employment{}, gorm.ErrForeignKeyViolated),
+			method:             http.MethodPost,
+			target:             "/api/v1/persons/1/employments",
+			body:               `{"organization_id":1}`,
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name: "update employment",
+			pr: NewPersonRepositoryMock(mc).UpdateEmploymentMock.Return(nil).
+				GetEmploymentsMock.Return([]models.Employment{regularEmployment}, nil),
+			method:             http.MethodPut,
+			target:             "/api/v1/persons/1/employments/1",
+			body:               `{"organization_id":1,"start_date":"2020-01-01","end_date":"2021-01-01"}`,
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "update employment not found",
+			pr:                 NewPersonRepositoryMock(mc).UpdateEmploymentMock.Return(gorm.ErrRecordNotFound),
+			method:             http.MethodPut,
+			target:             "/api/v1/persons/1/employments/1",
+			body:               `{"organization_id":1}`,
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name:               "delete employment",
+			pr:                 NewPersonRepositoryMock(mc).DeleteEmploymentMock.Return(nil),
+			method:             http.MethodDelete,
+			target:             "/api/v1/persons/1/employments/1",
+			expectedHTTPStatus: http.StatusNoContent,
+		},
+		{
+			name:               "delete employment not found",
+			pr:                 NewPersonRepositoryMock(mc).DeleteEmploymentMock.Return(gorm.ErrRecordNotFound),
+			method:             http.MethodDelete,
+			target:             "/api/v1/persons/1/employments/1",
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name:               "organizations",
+			pr:                 NewPersonRepositoryMock(mc).ListOrganizationsMock.Expect("сбер").Return([]models.Organization{regularOrganization}, nil),
+			method:             http.MethodGet,
+			target:             "/api/v1/organizations?name=%D1%81%D0%B1%D0%B5%D1%80",
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "create organization",
+			pr:                 NewPersonRepositoryMock(mc).CreateOrganizationMock.Return(regularOrganization, nil),
+			method:             http.MethodPost,
+			target:             "/api/v1/organizations",
+			body:               `{"name":"Sber"}`,
+			expectedHTTPStatus: http.StatusCreated,
+		},
+		{
+			name:               "create organization of a legal form",
+			pr:                 nil,
+			method:             http.MethodPost,
+			target:             "/api/v1/organizations",
+			body:               `{"name":"ООО"}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "create organization conflict",
+			pr:                 NewPersonRepositoryMock(mc).CreateOrganizationMock.Return(models.Organization{}, gorm.ErrDuplicatedKey),
+			method:             http.MethodPost,
+			target:             "/api/v1/organizations",
+			body:               `{"name":"ООО «Сбер»"}`,
+			expectedHTTPStatus: http.StatusConflict,
+		},
+		{
+			name:               "organization",
+			pr:                 NewPersonRepositoryMock(mc).GetOrganizationByIDMock.Return(regularOrganization, nil),
+			method:             http.MethodGet,
+			target:             "/api/v1/organizations/1",
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "organization not found",
+			pr:                 NewPersonRepositoryMock(mc).GetOrganizationByIDMock.Return(models.Organization{}, gorm.ErrRecordNotFound),
+			method:             http.MethodGet,
+			target:             "/api/v1/organizations/1",
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name: "edit organization",
+			pr: NewPersonRepositoryMock(mc).UpdateOrganizationByIDMock.Return(nil).
+				GetOrganizationByIDMock.Return(regularOrganization, nil),
+			method:             http.MethodPatch,
+			target:             "/api/v1/organizations/1",
+			body:               `{"name":"Sber"}`,
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "edit organization conflict",
+			pr:                 NewPersonRepositoryMock(mc).UpdateOrganizationByIDMock.Return(gorm.ErrDuplicatedKey),
+			method:             http.MethodPatch,
+			target:             "/api/v1/organizations/1",
+			body:               `{"name":"Google"}`,
+			expectedHTTPStatus: http.StatusConflict,
+		},
+		{
+			name:               "delete organization",
+			pr:                 NewPersonRepositoryMock(mc).DeleteOrganizationByIDMock.Return(nil),
+			method:             http.MethodDelete,
+			target:             "/api/v1/organizations/1",
+			expectedHTTPStatus: http.StatusNoContent,
+		},
+		{
+			name:               "delete missing organization",
+			pr:                 NewPersonRepositoryMock(mc).DeleteOrganizationByIDMock.Return(gorm.ErrRecordNotFound),
+			method:             http.MethodDelete,
+			target:             "/api/v1/organizations/1",
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name:               "delete organization with employments",
+			pr:                 NewPersonRepositoryMock(mc).DeleteOrganizationByIDMock.Return(gorm.ErrForeignKeyViolated),
+			method:             http.MethodDelete,
+			target:             "/api/v1/organizations/1",
+			expectedHTTPStatus: http.StatusConflict,
+		},
+		{
+			name: "organization employments",
+			pr: NewPersonRepositoryMock(mc).GetOrganizationByIDMock.Return(regularOrganization, nil).
+				GetOrganizationEmploymentsMock.Return([]models.Employment{regularEmployment}, nil),
+			method:             http.MethodGet,
+			target:             "/api/v1/organizations/1/employments",
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "delete",
+			pr:                 NewPersonRepositoryMock(mc).DeletePersonByIDMock.Return(nil),
+			method:             http.MethodDelete,
+			target:             "/api/v1/persons/1",
+			expectedHTTPStatus: http.StatusNoContent,
+		},
+		{
+			name:               "delete bad id",
+			pr:                 nil,
+			method:             http.MethodDelete,
+			target:             "/api/v1/persons/0",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "delete database error",
+			pr:                 NewPersonRepositoryMock(mc).DeletePersonByIDMock.Return(dbErr),
+			method:             http.MethodDelete,
+			target:             "/api/v1/persons/1",
+			expectedHTTPStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, config.Config{}, tt.pr)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-type", "application/json")
+			}
+			rw := httptest.NewRecorder()
+			s.echo.ServeHTTP(rw, req)
+
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Errorf("%s %s http-code expected %d, but got %d", tt.method, tt.target, tt.expectedHTTPStatus, rw.Code)
+			}
+
+			route, pathParams, err := router.FindRoute(httptest.NewRequest(tt.method, tt.target, nil))
+			if err != nil {
+				t.Fatalf("FindRoute() error = %v", err)
+			}
+			err = openapi3filter.ValidateResponse(context.Background(), &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{
+					Request:    req,
+					PathParams: pathParams,
+					Route:      route,
+				},
+				Status:  rw.Code,
+				Header:  rw.Header(),
+				Body:    io.NopCloser(bytes.NewReader(rw.Body.Bytes())),
+				Options: &openapi3filter.Options{IncludeResponseStatus: true},
+			})
+			if err != nil {
+				t.Errorf("%s %s response does not match person-service.yaml: %v", tt.method, tt.target, err)
+			}
+
+			if resp := route.Operation.Responses.Status(rw.Code); resp != nil && len(resp.Value.Content) == 0 && rw.Body.Len() != 0 {
+				t.Errorf("%s %s response must have no body according to person-service.yaml, but got %s", tt.method, tt.target, rw.Body.String())
+			}
+		})
+	}
+}
+
+func TestSpec_devModeRejectsInvalidRequests(t *testing.T) {
+	s := newTestServer(t, config.Config{AppEnv: config.EnvDev}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(`{"name":"test","age":"ten"}`))
+	req.Header.Set("Content-type", "application/json")
+	rw := httptest.NewRecorder()
+	s.echo.ServeHTTP(rw, req)
+
+	if rw.Code != http.StatusBadRequest {
+		t.Errorf("createPerson() http-code expected %d, but got %d", http.StatusBadRequest, rw.Code)
+	}
+	if !strings.Contains(rw.Body.String(), `"age"`) {
+		t.Errorf("createPerson() expected error keyed by age, but got %s", rw.Body.String())
+	}
+}
+
+func TestSpec_personMediaTypes(t *testing.T) {
+	spec, err := openapi.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	for _, op := range []struct{ method, path string }{
+		{method: http.MethodGet, path: "/api/v1/persons"},
+		{method: http.MethodPost, path: "/api/v1/persons"},
+		{method: http.MethodGet, path: "/api/v1/persons/{id}"},
+		{method: http.MethodPatch, path: "/api/v1/persons/{id}"},
+	} {
+		operation := spec.Paths.Find(op.path).GetOperation(op.method)
+		if operation == nil {
+			t.Fatalf("%s %s is not described in person-service.yaml", op.method, op.path)
+		}
+
+		var contents []openapi3.Content
+		if operation.RequestBody != nil {
+			contents = append(contents, operation.RequestBody.Value.Content)
+		}
+		if resp := operation.Responses.Status(http.StatusOK); resp != nil {
+			contents = append(contents, resp.Value.Content)
+		}
+		for _, content := range contents {
+			for _, r := range personRepresentations {
+				if content.Get(r.mediaType) == nil {
+					t.Errorf("%s %s does not list %s in person-service.yaml", op.method, op.path, r.mediaType)
+				}
+			}
+		}
+		if op.method != http.MethodPost && operation.Responses.Status(http.StatusNotAcceptable) == nil {
+			t.Errorf("%s %s does not list 406 in person-service.yaml", op.method, op.path)
+		}
+	}
+}
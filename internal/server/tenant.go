@@ -0,0 +1,216 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"github.com/charmbracelet/log"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const tenantKey = "tenant"
+
+var (
+	errNoTenant     = errors.New("tenant is required")
+	errTenantToken  = errors.New("bearer token with a tenant is required")
+	errTenantDomain = errors.New("host is not a tenant domain")
+)
+
+type repositoryCtxKey struct{}
+
+type tenantResolver struct {
+	source string
+	header string
+	claim  string
+	secret []byte
+	domain string
+}
+
+func newTenantResolver(cfg config.Config) *tenantResolver {
+	return &tenantResolver{
+		source: cfg.TenantSource,
+		header: cfg.TenantHeader,
+		claim:  cfg.TenantJWTClaim,
+		secret: []byte(cfg.TenantJWTSecret),
+		domain: strings.ToLower(strings.TrimPrefix(cfg.TenantDomain, ".")),
+	}
+}
+
+// header and host come from HTTP headers or gRPC metadata.
+func (r *tenantResolver) resolve(header func(name string) string, host string) (string, error) {
+	switch r.source {
+	case config.TenantSourceHeader:
+		if tenant := header(r.header); tenant != "" {
+			return tenant, nil
+		}
+		return "", errNoTenant
+	case config.TenantSourceJWT:
+		return r.fromToken(header("Authorization"))
+	case config.TenantSourceSubdomain:
+		return r.fromHost(host)
+	default:
+		return models.DefaultTenant, nil
+	}
+}
+
+// tokenParser accepts HS256 tokens only, which must expire.
+var tokenParser = jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+
+// fromToken returns the tenant claim of an unexpired HS256 bearer token
+// signed with the secret.
+func (r *tenantResolver) fromToken(authorization string) (string, error) {
+	raw, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return "", errTenantToken
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := tokenParser.ParseWithClaims(raw, claims, func(*jwt.Token) (any, error) {
+		return r.secret, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", errTenantToken, err)
+	}
+	tenant, _ := claims[r.claim].(string)
+	if tenant == "" {
+		return "", errTenantToken
+	}
+	return tenant, nil
+}
+
+// fromHost returns the subdomain of host under the tenant domain, acme for
+// acme.persons.example.com.
+func (r *tenantResolver) fromHost(host string) (string, error) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	tenant, ok := strings.CutSuffix(strings.ToLower(host), "."+r.domain)
+	if !ok || tenant == "" || strings.Contains(tenant, ".") {
+		return "", errTenantDomain
+	}
+	return tenant, nil
+}
+
+// Docs are shared by all tenants.
+func tenantScoped(path string) bool {
+	return path == graphqlPath ||
+		strings.HasPrefix(path, "/api/v1/persons") ||
+		strings.HasPrefix(path, "/api/v1/organizations")
+}
+
+// Handlers reach persons only through the repository of the tenant.
+func (s *Server) tenant(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if s.tenants == nil || !tenantScoped(c.Path()) {
+			return next(c)
+		}
+
+		req := c.Request()
+		tenant, err := s.tenants.resolve(req.Header.Get, req.Host)
+		if err != nil {
+			return tenantError(c, err)
+		}
+		repo, err := s.repos(tenant)
+		if err != nil {
+			return tenantError(c, err)
+		}
+
+		c.Set(tenantKey, tenant)
+		c.SetRequest(req.WithContext(context.WithValue(req.Context(), repositoryCtxKey{}, repo)))
+		return next(c)
+	}
+}
+
+func tenantError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, errTenantToken):
+		return c.JSON(http.StatusUnauthorized, openapi.ErrorResponse{Message: errTenantToken.Error()})
+	case errors.Is(err, models.ErrUnknownTenant):
+		return c.JSON(http.StatusForbidden, openapi.ErrorResponse{Message: "unknown tenant"})
+	case errors.Is(err, errNoTenant), errors.Is(err, errTenantDomain):
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: err.Error()})
+	default:
+		log.Errorf("can not get the repository of the tenant: %v", err)
+		return internalError(c)
+	}
+}
+
+func (s *Server) repo(c echo.Context) PersonRepository {
+	repo := repositoryFrom(c.Request().Context())
+	if repo == nil {
+		repo = s.pr
+	}
+	return session(repo, c.Request().Header.Get(headerLastWrite))
+}
+
+func repositoryFrom(ctx context.Context) PersonRepository {
+	repo, _ := ctx.Value(repositoryCtxKey{}).(PersonRepository)
+	return repo
+}
+
+func (s *GRPCServer) scopeTenant(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	header := func(name string) string {
+		if v := md.Get(name); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+
+	tenant, err := s.tenants.resolve(header, header(":authority"))
+	if err == nil {
+		var repo PersonRepository
+		if repo, err = s.repos(tenant); err == nil {
+			repo = session(repo, header(metadataLastWrite))
+			return context.WithValue(ctx, repositoryCtxKey{}, repo), nil
+		}
+	}
+
+	switch {
+	case errors.Is(err, errTenantToken):
+		return nil, status.Error(codes.Unauthenticated, errTenantToken.Error())
+	case errors.Is(err, models.ErrUnknownTenant):
+		return nil, status.Error(codes.PermissionDenied, "unknown tenant")
+	case errors.Is(err, errNoTenant), errors.Is(err, errTenantDomain):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		log.Errorf("can not get the repository of the tenant: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+}
+
+func (s *GRPCServer) scopeTenantUnary(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := s.scopeTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *GRPCServer) scopeTenantStream(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.scopeTenant(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &tenantStream{ServerStream: ss, ctx: ctx})
+}
+
+type tenantStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantStream) Context() context.Context {
+	return s.ctx
+}
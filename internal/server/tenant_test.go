@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/memory"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testTenantSecret = "0123456789abcdef0123456789abcdef"
+
+// memoryTenants serves acme and globex from one in-memory database.
+func memoryTenants() PersonRepositories {
+	db := memory.NewDB()
+	return func(tenant string) (PersonRepository, error) {
+		if tenant != "acme" && tenant != "globex" {
+			return nil, fmt.Errorf("tenant %q: %w", tenant, models.ErrUnknownTenant)
+		}
+		return db.Tenant(tenant), nil
+	}
+}
+
+func tenantToken(t *testing.T, method jwt.SigningMethod, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "Bearer " + token
+}
+
+func TestServer_tenant(t *testing.T) {
+	expires := time.Now().Add(time.Hour).Unix()
+	tests := []struct {
+		name               string
+		cfg                config.Config
+		host               string
+		headers            map[string]string
+		path               string
+		expectedHTTPStatus int
+	}{
+		{
+			name:               "http-200: header",
+			cfg:                config.Config{TenantSource: config.TenantSourceHeader, TenantHeader: "X-Tenant-ID"},
+			headers:            map[string]string{"X-Tenant-ID": "acme"},
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "http-400: no header",
+			cfg:                config.Config{TenantSource: config.TenantSourceHeader, TenantHeader: "X-Tenant-ID"},
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "http-403: unknown tenant",
+			cfg:                config.Config{TenantSource: config.TenantSourceHeader, TenantHeader: "X-Tenant-ID"},
+			headers:            map[string]string{"X-Tenant-ID": "initech"},
+			expectedHTTPStatus: http.StatusForbidden,
+		},
+		{
+			name:               "http-200: docs are not scoped",
+			cfg:                config.Config{TenantSource: config.TenantSourceHeader, TenantHeader: "X-Tenant-ID"},
+			path:               specJSONPath,
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "http-200: jwt",
+			cfg:                config.Config{TenantSource: config.TenantSourceJWT, TenantJWTClaim: "tenant", TenantJWTSecret: testTenantSecret},
+			headers:            map[string]string{"Authorization": tenantToken(t, jwt.SigningMethodHS256, testTenantSecret, jwt.MapClaims{"tenant": "globex", "exp": expires})},
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "http-401: jwt signed with another secret",
+			cfg:                config.Config{TenantSource: config.TenantSourceJWT, TenantJWTClaim: "tenant", TenantJWTSecret: testTenantSecret},
+			headers:            map[string]string{"Authorization": tenantToken(t, jwt.SigningMethodHS256, strings.Repeat("x", 32), jwt.MapClaims{"tenant": "globex", "exp": expires})},
+			expectedHTTPStatus: http.StatusUnauthorized,
+		},
+		{
+			name:               "http-401: jwt without the claim",
+			cfg:                config.Config{TenantSource: config.TenantSourceJWT, TenantJWTClaim: "tenant", TenantJWTSecret: testTenantSecret},
+			headers:            map[string]string{"Authorization": tenantToken(t, jwt.SigningMethodHS256, testTenantSecret, jwt.MapClaims{"sub": "globex", "exp": expires})},
+			expectedHTTPStatus: http.StatusUnauthorized,
+		},
+		{
+			name:               "http-401: jwt without exp",
+			cfg:                config.Config{TenantSource: config.TenantSourceJWT, TenantJWTClaim: "tenant", TenantJWTSecret: testTenantSecret},
+			headers:            map[string]string{"Authorization": tenantToken(t, jwt.SigningMethodHS256, testTenantSecret, jwt.MapClaims{"tenant": "globex"})},
+			expectedHTTPStatus: http.StatusUnauthorized,
+		},
+		{
+			name:               "http-401: expired jwt",
+			cfg:                config.Config{TenantSource: config.TenantSourceJWT, TenantJWTClaim: "tenant", TenantJWTSecret: testTenantSecret},
+			headers:            map[string]string{"Authorization": tenantToken(t, jwt.SigningMethodHS256, testTenantSecret, jwt.MapClaims{"tenant": "globex", "exp": time.Now().Add(-time.Hour).Unix()})},
+			expectedHTTPStatus: http.StatusUnauthorized,
+		},
+		{
+			name:               "http-401: jwt signed with HS512",
+			cfg:                config.Config{TenantSource: config.TenantSourceJWT, TenantJWTClaim: "tenant", TenantJWTSecret: testTenantSecret},
+			headers:            map[string]string{"Authorization": tenantToken(t, jwt.SigningMethodHS512, testTenantSecret, jwt.MapClaims{"tenant": "globex", "exp": expires})},
+			expectedHTTPStatus: http.StatusUnauthorized,
+		},
+		{
+			name:               "http-401: header is ignored for jwt",
+			cfg:                config.Config{TenantSource: config.TenantSourceJWT, TenantJWTClaim: "tenant", TenantJWTSecret: testTenantSecret},
+			headers:            map[string]string{"X-Tenant-ID": "acme"},
+			expectedHTTPStatus: http.StatusUnauthorized,
+		},
+		{
+			name:               "http-200: subdomain",
+			cfg:                config.Config{TenantSource: config.TenantSourceSubdomain, TenantDomain: "persons.example.com"},
+			host:               "acme.persons.example.com:8080",
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name:               "http-400: host of another domain",
+			cfg:                config.Config{TenantSource: config.TenantSourceSubdomain, TenantDomain: "persons.example.com"},
+			host:               "acme.example.com",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "http-400: nested subdomain",
+			cfg:                config.Config{TenantSource: config.TenantSourceSubdomain, TenantDomain: "persons.example.com"},
+			host:               "a.acme.persons.example.com",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestMultiTenantServer(t, tt.cfg, memoryTenants())
+			path := tt.path
+			if path == "" {
+				path = "/api/v1/persons"
+			}
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			if tt.host != "" {
+				req.Host = tt.host
+			}
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			rw := httptest.NewRecorder()
+			s.ServeHTTP(rw, req)
+
+			if rw.Code != tt.expectedHTTPStatus {
+				t.Errorf("status expected %d, but got %d: %s", tt.expectedHTTPStatus, rw.Code, rw.Body)
+			}
+		})
+	}
+}
+
+func TestServer_tenantIsolation(t *testing.T) {
+	s := newTestMultiTenantServer(t, config.Config{TenantSource: config.TenantSourceHeader, TenantHeader: "X-Tenant-ID"}, memoryTenants())
+	do := func(tenant, method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Tenant-ID", tenant)
+		rw := httptest.NewRecorder()
+		s.ServeHTTP(rw, req)
+		return rw
+	}
+
+	rw := do("acme", http.MethodPost, "/api/v1/persons", `{"name":"test","age":1,"address":"test","work":"test"}`)
+	if rw.Code != http.StatusCreated {
+		t.Fatalf("create status expected %d, but got %d: %s", http.StatusCreated, rw.Code, rw.Body)
+	}
+	location := rw.Header().Get("Location")
+
+	if rw = do("acme", http.MethodGet, location, ""); rw.Code != http.StatusOK {
+		t.Errorf("get of the own person status expected %d, but got %d", http.StatusOK, rw.Code)
+	}
+	if rw = do("globex", http.MethodGet, location, ""); rw.Code != http.StatusNotFound {
+		t.Errorf("get of a person of another tenant status expected %d, but got %d", http.StatusNotFound, rw.Code)
+	}
+	if rw = do("globex", http.MethodPatch, location, `{"name":"changed"}`); rw.Code != http.StatusNotFound {
+		t.Errorf("update of a person of another tenant status expected %d, but got %d", http.StatusNotFound, rw.Code)
+	}
+
+	rw = do("globex", http.MethodGet, "/api/v1/persons", "")
+	var persons []json.RawMessage
+	if err := json.Unmarshal(rw.Body.Bytes(), &persons); err != nil {
+		t.Fatal(err)
+	}
+	if len(persons) != 0 {
+		t.Errorf("persons of another tenant expected none, but got %d", len(persons))
+	}
+
+	const query = `{"query":"{ persons { edges { node { id } } } }"}`
+	if rw = do("acme", http.MethodPost, graphqlPath, query); !strings.Contains(rw.Body.String(), `"id"`) {
+		t.Errorf("graphql persons of the tenant expected the person, but got %s", rw.Body)
+	}
+	if rw = do("globex", http.MethodPost, graphqlPath, query); !strings.Contains(rw.Body.String(), `"edges":[]`) {
+		t.Errorf("graphql persons of another tenant expected none, but got %s", rw.Body)
+	}
+}
+
+func TestGRPCServer_scopeTenant(t *testing.T) {
+	cfg := config.Config{TenantSource: config.TenantSourceHeader, TenantHeader: "X-Tenant-ID"}
+	s := NewMultiTenantGRPC(cfg, memoryTenants(), nil, nil)
+
+	tests := []struct {
+		name         string
+		md           metadata.MD
+		expectedCode codes.Code
+	}{
+		{name: "OK: tenant", md: metadata.Pairs("x-tenant-id", "acme"), expectedCode: codes.OK},
+		{name: "InvalidArgument: no tenant", md: metadata.MD{}, expectedCode: codes.InvalidArgument},
+		{name: "PermissionDenied: unknown tenant", md: metadata.Pairs("x-tenant-id", "initech"), expectedCode: codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := s.scopeTenant(metadata.NewIncomingContext(context.Background(), tt.md))
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Fatalf("code expected %s, but got %s", tt.expectedCode, code)
+			}
+			if err == nil && repositoryFrom(ctx) == nil {
+				t.Errorf("repository of the tenant expected in the context")
+			}
+		})
+	}
+}
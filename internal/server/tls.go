@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/charmbracelet/log"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTLSReloadInterval = time.Minute
+
+	principalKey = "principal"
+)
+
+// certReloader keeps the server certificate and the client CAs, and reads
+// them again when their files change, so that certificates are rotated
+// without a restart.
+type certReloader struct {
+	certFile, keyFile, caFile string
+	clientAuth                tls.ClientAuthType
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+func newCertReloader(cfg config.Config) (*certReloader, error) {
+	r := &certReloader{
+		certFile:   cfg.TLSCertFile,
+		keyFile:    cfg.TLSKeyFile,
+		caFile:     cfg.TLSClientCAFile,
+		clientAuth: tls.NoClientCert,
+	}
+	if r.caFile != "" {
+		r.clientAuth = tls.RequireAndVerifyClientCert
+		if cfg.TLSClientCertOptional {
+			r.clientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// load keeps the previous certificates if any of the files is invalid.
+func (r *certReloader) load() error {
+	modTimes, err := r.stat()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("load tls certificate error: %w", err)
+	}
+	var clientCAs *x509.CertPool
+	if r.caFile != "" {
+		pem, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("load tls client CA error: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("load tls client CA error: no certificates in %s", r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert, r.clientCAs, r.modTimes = &cert, clientCAs, modTimes
+	return nil
+}
+
+func (r *certReloader) stat() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time, 3)
+	for _, path := range []string{r.certFile, r.keyFile, r.caFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("load tls files error: %w", err)
+		}
+		modTimes[path] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+func (r *certReloader) changed() bool {
+	modTimes, err := r.stat()
+	if err != nil {
+		// A file is being replaced, it is checked again next time.
+		return false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for path, modTime := range modTimes {
+		if !modTime.Equal(r.modTimes[path]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *certReloader) watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultTLSReloadInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if !r.changed() {
+			continue
+		}
+		if err := r.load(); err != nil {
+			log.Error("tls certificates not reloaded, keeping the previous ones", "err", err)
+			continue
+		}
+		log.Info("tls certificates reloaded", "cert", r.certFile)
+	}
+}
+
+// Every handshake gets the certificates loaded last.
+func (r *certReloader) tlsConfig(nextProtos ...string) *tls.Config {
+	base := &tls.Config{MinVersion: tls.VersionTLS12, NextProtos: nextProtos}
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		return &tls.Config{
+			MinVersion:   base.MinVersion,
+			Certificates: []tls.Certificate{*r.cert},
+			ClientCAs:    r.clientCAs,
+			ClientAuth:   r.clientAuth,
+			// net/http adds h2 to the base config when it starts serving.
+			NextProtos: base.NextProtos,
+		}, nil
+	}
+	return base
+}
+
+// GRPCCredentials are nil when the server serves plain HTTP.
+func (s *Server) GRPCCredentials() credentials.TransportCredentials {
+	if s.certs == nil {
+		return nil
+	}
+	return credentials.NewTLS(s.certs.tlsConfig("h2"))
+}
+
+func clientPrincipal(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		state := c.Request().TLS
+		if state != nil && len(state.VerifiedChains) > 0 && len(state.VerifiedChains[0]) > 0 {
+			c.Set(principalKey, state.VerifiedChains[0][0].Subject.String())
+		}
+		return next(c)
+	}
+}
+
+func grpcPrincipal(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	return info.State.VerifiedChains[0][0].Subject.String(), true
+}
+
+// principal is like CN=orders,O=Example.
+func principal(c echo.Context) (string, bool) {
+	p, ok := c.Get(principalKey).(string)
+	return p, ok
+}
+
+func (s *Server) start(addr string) error {
+	srv := s.echo.Server
+	srv.Addr = addr
+	if s.certs != nil {
+		srv.TLSConfig = s.certs.tlsConfig()
+	}
+
+	err := s.echo.StartServer(srv)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
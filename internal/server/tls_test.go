@@ -0,0 +1,273 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	personv1 "github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/person/v1"
+	"github.com/gojuno/minimock/v3"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+// newTestCert issues a certificate signed by parent, or a self-signed CA
+// when parent is nil.
+func newTestCert(t *testing.T, parent *testCert, serial int64, subject pkix.Name, usage x509.ExtKeyUsage) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA, tmpl.BasicConstraintsValid = true, true
+		tmpl.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+func (c *testCert) write(t *testing.T, certPath, keyPath string) {
+	t.Helper()
+
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if keyPath == "" {
+		return
+	}
+	if err = os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.der}, PrivateKey: c.key}
+}
+
+func TestServer_mTLS(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath, caPath := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.crt")
+
+	ca := newTestCert(t, nil, 1, pkix.Name{CommonName: "test CA"}, x509.ExtKeyUsageAny)
+	ca.write(t, caPath, "")
+	newTestCert(t, ca, 2, pkix.Name{CommonName: "person"}, x509.ExtKeyUsageServerAuth).write(t, certPath, keyPath)
+	client := newTestCert(t, ca, 3, pkix.Name{CommonName: "orders", Organization: []string{"Example"}}, x509.ExtKeyUsageClientAuth)
+	other := newTestCert(t, nil, 4, pkix.Name{CommonName: "other CA"}, x509.ExtKeyUsageAny)
+
+	cfg := config.Config{TLSCertFile: certPath, TLSKeyFile: keyPath, TLSClientCAFile: caPath}
+	s := newTestServer(t, cfg, NewPersonRepositoryMock(minimock.NewController(t)))
+	s.echo.HideBanner, s.echo.HidePort = true, true
+	s.echo.GET("/test/principal", func(c echo.Context) error {
+		p, _ := principal(c)
+		return c.String(http.StatusOK, p)
+	})
+
+	go func() {
+		if err := s.start("127.0.0.1:0"); err != nil {
+			t.Error(err)
+		}
+	}()
+	t.Cleanup(func() {
+		if err := s.echo.Server.Shutdown(context.Background()); err != nil {
+			t.Error(err)
+		}
+	})
+	var addr net.Addr
+	for i := 0; i < 100 && addr == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+		addr = s.echo.TLSListenerAddr()
+	}
+	if addr == nil {
+		t.Fatal("server did not start")
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	get := func(certs ...tls.Certificate) (*http.Response, *x509.Certificate, error) {
+		var serverCert *x509.Certificate
+		httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			RootCAs:      roots,
+			Certificates: certs,
+			VerifyConnection: func(state tls.ConnectionState) error {
+				serverCert = state.PeerCertificates[0]
+				return nil
+			},
+		}}}
+		res, err := httpClient.Get("https://" + addr.String() + "/test/principal")
+		return res, serverCert, err
+	}
+
+	res, serverCert, err := get(client.tlsCertificate())
+	if err != nil {
+		t.Fatalf("request with a client certificate error = %v", err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if string(body) != "CN=orders,O=Example" {
+		t.Errorf("principal expected %q, but got %q", "CN=orders,O=Example", body)
+	}
+	if serverCert.SerialNumber.Int64() != 2 {
+		t.Errorf("server certificate expected serial 2, but got %d", serverCert.SerialNumber)
+	}
+
+	if _, _, err = get(); err == nil {
+		t.Errorf("request without a client certificate expected to fail")
+	}
+	if _, _, err = get(other.tlsCertificate()); err == nil {
+		t.Errorf("request with a certificate of another CA expected to fail")
+	}
+
+	// Rotation: new connections get the new certificate.
+	newTestCert(t, ca, 5, pkix.Name{CommonName: "person"}, x509.ExtKeyUsageServerAuth).write(t, certPath, keyPath)
+	later := time.Now().Add(time.Minute)
+	if err = os.Chtimes(certPath, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if !s.certs.changed() {
+		t.Fatalf("changed() expected true after the certificate was replaced")
+	}
+	if err = s.certs.load(); err != nil {
+		t.Fatal(err)
+	}
+
+	res, serverCert, err = get(client.tlsCertificate())
+	if err != nil {
+		t.Fatalf("request after rotation error = %v", err)
+	}
+	res.Body.Close()
+	if serverCert.SerialNumber.Int64() != 5 {
+		t.Errorf("server certificate after rotation expected serial 5, but got %d", serverCert.SerialNumber)
+	}
+}
+
+func TestGRPCServer_mTLS(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath, caPath := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.crt")
+
+	ca := newTestCert(t, nil, 1, pkix.Name{CommonName: "test CA"}, x509.ExtKeyUsageAny)
+	ca.write(t, caPath, "")
+	newTestCert(t, ca, 2, pkix.Name{CommonName: "person"}, x509.ExtKeyUsageServerAuth).write(t, certPath, keyPath)
+	client := newTestCert(t, ca, 3, pkix.Name{CommonName: "orders"}, x509.ExtKeyUsageClientAuth)
+
+	cfg := config.Config{TLSCertFile: certPath, TLSKeyFile: keyPath, TLSClientCAFile: caPath}
+	pr := NewPersonRepositoryMock(minimock.NewController(t)).GetPersonByIDMock.Return(regularPerson, nil)
+	s := newTestServer(t, cfg, pr)
+	g := NewMultiTenantGRPC(cfg, singleTenant(pr), nil, s.GRPCCredentials())
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		_ = g.Serve(lis)
+	}()
+	t.Cleanup(func() {
+		if err := g.Shutdown(context.Background()); err != nil {
+			t.Error(err)
+		}
+	})
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	call := func(certs ...tls.Certificate) error {
+		creds := credentials.NewTLS(&tls.Config{RootCAs: roots, Certificates: certs})
+		conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		_, err = personv1.NewPersonServiceClient(conn).GetPerson(context.Background(), &personv1.GetPersonRequest{Id: 1})
+		return err
+	}
+
+	if err = call(client.tlsCertificate()); err != nil {
+		t.Errorf("call with a client certificate error = %v", err)
+	}
+	if err = call(); err == nil {
+		t.Errorf("call without a client certificate expected to fail")
+	}
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	_, err = personv1.NewPersonServiceClient(conn).GetPerson(context.Background(), &personv1.GetPersonRequest{Id: 1})
+	if err == nil {
+		t.Errorf("plaintext call expected to fail")
+	}
+}
+
+func TestCertReloader_invalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	newTestCert(t, nil, 1, pkix.Name{CommonName: "person"}, x509.ExtKeyUsageServerAuth).write(t, certPath, keyPath)
+
+	r, err := newCertReloader(config.Config{TLSCertFile: certPath, TLSKeyFile: keyPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(keyPath, []byte("not a key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err = r.load(); err == nil {
+		t.Errorf("load() of an invalid key expected an error")
+	}
+	if r.cert == nil || len(r.cert.Certificate) == 0 {
+		t.Errorf("previous certificate expected to be kept")
+	}
+}
+
+func TestNew_missingCert(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.Config{TLSCertFile: filepath.Join(dir, "tls.crt"), TLSKeyFile: filepath.Join(dir, "tls.key")}
+	if _, err := New(cfg, nil); err == nil {
+		t.Errorf("New() with missing certificate files expected an error")
+	}
+}
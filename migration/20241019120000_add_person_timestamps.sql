@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table persons
+    add column if not exists "created_at" timestamptz not null default now(),
+    add column if not exists "updated_at" timestamptz not null default now();
+
+create index if not exists persons_updated_at_id_idx on persons ("updated_at", "id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists persons_updated_at_id_idx;
+
+alter table persons
+    drop column if exists "updated_at",
+    drop column if exists "created_at";
+-- +goose StatementEnd
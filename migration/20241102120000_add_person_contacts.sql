@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table persons
+    add column if not exists "email" text not null default '',
+    add column if not exists "phone" text not null default '',
+    add column if not exists "birth_date" date,
+    add column if not exists "address_country" text not null default '',
+    add column if not exists "address_city" text not null default '',
+    add column if not exists "address_street" text not null default '',
+    add column if not exists "address_postal_code" text not null default '',
+    alter column "age" set default 0;
+
+-- age is derived from birth_date when it is known, the column only keeps
+-- the value for persons created before.
+comment on column persons."age" is 'legacy, ignored when birth_date is set';
+comment on column persons."address" is 'legacy free-text address';
+
+create unique index if not exists persons_email_key on persons (lower("email")) where "email" <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists persons_email_key;
+
+comment on column persons."address" is null;
+comment on column persons."age" is null;
+
+alter table persons
+    alter column "age" drop default,
+    drop column if exists "address_postal_code",
+    drop column if exists "address_street",
+    drop column if exists "address_city",
+    drop column if exists "address_country",
+    drop column if exists "birth_date",
+    drop column if exists "phone",
+    drop column if exists "email";
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+create extension if not exists pg_trgm;
+
+create index if not exists persons_name_trgm_idx on persons using gin ("name" gin_trgm_ops);
+
+-- Ids of merged-away persons, so requests to them can be redirected.
+create table if not exists person_merges (
+    "merged_id" int primary key,
+    "survivor_id" int not null references persons ("id") on delete cascade,
+    "merged_at" timestamptz not null default now()
+);
+
+create index if not exists person_merges_survivor_id_idx on person_merges ("survivor_id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists person_merges;
+
+drop index if exists persons_name_trgm_idx;
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Persons created before tenants belong to the default one.
+alter table persons
+    add column if not exists "tenant_id" text not null default 'default';
+alter table person_merges
+    add column if not exists "tenant_id" text not null default 'default';
+
+-- Every query is scoped by tenant_id, so it leads the indexes.
+alter table persons
+    add constraint persons_tenant_id_id_key unique ("tenant_id", "id");
+
+drop index if exists persons_updated_at_id_idx;
+create index if not exists persons_tenant_id_updated_at_id_idx on persons ("tenant_id", "updated_at", "id");
+
+-- Emails are unique within a tenant only.
+drop index if exists persons_email_key;
+create unique index if not exists persons_tenant_id_email_key on persons ("tenant_id", lower("email")) where "email" <> '';
+
+-- A person can only be merged into a person of the same tenant.
+alter table person_merges
+    drop constraint if exists person_merges_survivor_id_fkey,
+    add constraint person_merges_tenant_id_survivor_id_fkey
+        foreign key ("tenant_id", "survivor_id") references persons ("tenant_id", "id") on delete cascade;
+
+drop index if exists person_merges_survivor_id_idx;
+create index if not exists person_merges_tenant_id_survivor_id_idx on person_merges ("tenant_id", "survivor_id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists person_merges_tenant_id_survivor_id_idx;
+create index if not exists person_merges_survivor_id_idx on person_merges ("survivor_id");
+
+alter table person_merges
+    drop constraint if exists person_merges_tenant_id_survivor_id_fkey,
+    add constraint person_merges_survivor_id_fkey
+        foreign key ("survivor_id") references persons ("id") on delete cascade;
+
+drop index if exists persons_tenant_id_email_key;
+create unique index if not exists persons_email_key on persons (lower("email")) where "email" <> '';
+
+drop index if exists persons_tenant_id_updated_at_id_idx;
+create index if not exists persons_updated_at_id_idx on persons ("updated_at", "id");
+
+alter table persons
+    drop constraint if exists persons_tenant_id_id_key;
+
+alter table person_merges
+    drop column if exists "tenant_id";
+alter table persons
+    drop column if exists "tenant_id";
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Typed edges between persons of the same tenant: from the parent to the
+-- child, from the manager to the report. Bidirectional edges, like spouses,
+-- are followed both ways.
+create table if not exists person_relations (
+    "id" serial primary key,
+    "tenant_id" text not null default 'default',
+    "from_id" int not null,
+    "to_id" int not null,
+    "type" text not null check ("type" in ('spouse', 'parent', 'manager')),
+    "bidirectional" boolean not null default false,
+    "created_at" timestamptz not null default now(),
+    check ("from_id" <> "to_id"),
+    foreign key ("tenant_id", "from_id") references persons ("tenant_id", "id") on delete cascade,
+    foreign key ("tenant_id", "to_id") references persons ("tenant_id", "id") on delete cascade,
+    unique ("tenant_id", "from_id", "to_id", "type")
+);
+
+-- A bidirectional edge has no order, so it is unique by the pair.
+create unique index if not exists person_relations_tenant_id_pair_type_key on person_relations
+    ("tenant_id", least("from_id", "to_id"), greatest("from_id", "to_id"), "type") where "bidirectional";
+
+-- Walks follow edges from either end.
+create index if not exists person_relations_tenant_id_to_id_idx on person_relations ("tenant_id", "to_id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists person_relations;
+-- +goose StatementEnd
@@ -0,0 +1,110 @@
+-- +goose Up
+-- +goose StatementBegin
+-- normalize_organization_name is the key organizations are unique by: the
+-- words of the name in lower case without punctuation and legal forms,
+-- transliterated to Latin, so that "Sber", "sber" and "ООО Сбер" are
+-- one organization. Mirrored by models.NormalizeOrganizationName.
+create or replace function normalize_organization_name(name text) returns text
+    language sql immutable strict parallel safe
+as $$
+select coalesce(string_agg(
+    translate(
+        replace(replace(replace(replace(replace(replace(replace(replace(replace(replace(
+            word, 'ж', 'zh'), 'х', 'kh'), 'ц', 'ts'), 'ч', 'ch'), 'ш', 'sh'), 'щ', 'shch'),
+            'ю', 'yu'), 'я', 'ya'), 'ъ', ''), 'ь', ''),
+        'абвгдезийклмнопрстуфыэ', 'abvgdeziyklmnoprstufye'),
+    ' ' order by n), '')
+from regexp_split_to_table(
+    regexp_replace(
+        replace(lower(translate($1, 'АБВГДЕЁЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯ', 'абвгдеёжзийклмнопрстуфхцчшщъыьэюя')), 'ё', 'е'),
+        '[^a-z0-9а-я]+', ' ', 'g'),
+    ' ') with ordinality as words (word, n)
+where word <> ''
+  and word not in ('ооо', 'оао', 'зао', 'пао', 'ао', 'ип', 'ooo', 'llc', 'ltd', 'inc', 'corp', 'gmbh', 'jsc', 'pjsc')
+$$;
+
+create table if not exists organizations (
+    "id" serial primary key,
+    "tenant_id" text not null default 'default',
+    "name" text not null,
+    "normalized_name" text generated always as (normalize_organization_name("name")) stored,
+    "created_at" timestamptz not null default now(),
+    "updated_at" timestamptz not null default now(),
+    check ("normalized_name" <> ''),
+    unique ("tenant_id", "id"),
+    unique ("tenant_id", "normalized_name")
+);
+
+-- Organizations with employees can not be deleted.
+create table if not exists employments (
+    "id" serial primary key,
+    "tenant_id" text not null default 'default',
+    "person_id" int not null,
+    "organization_id" int not null,
+    "role" text not null default '',
+    "start_date" date,
+    "end_date" date,
+    "created_at" timestamptz not null default now(),
+    check ("end_date" >= "start_date"),
+    foreign key ("tenant_id", "person_id") references persons ("tenant_id", "id") on delete cascade,
+    foreign key ("tenant_id", "organization_id") references organizations ("tenant_id", "id")
+);
+
+create index if not exists employments_tenant_id_person_id_idx on employments ("tenant_id", "person_id");
+create index if not exists employments_tenant_id_organization_id_idx on employments ("tenant_id", "organization_id");
+
+-- Every spelling of an employer becomes one organization, named by the most
+-- common spelling, and persons are employed there from now on.
+insert into organizations ("tenant_id", "name")
+select distinct on ("tenant_id", "normalized_name") "tenant_id", "name"
+from (
+    select "tenant_id", btrim("work") as "name", normalize_organization_name("work") as "normalized_name", count(*) as "persons"
+    from persons
+    group by 1, 2, 3
+) spellings
+where "normalized_name" <> ''
+order by "tenant_id", "normalized_name", "persons" desc, "name";
+
+insert into employments ("tenant_id", "person_id", "organization_id")
+select p."tenant_id", p."id", o."id"
+from persons p
+join organizations o on o."tenant_id" = p."tenant_id" and o."normalized_name" = normalize_organization_name(p."work");
+
+-- work is derived from the current employment from now on. Only spellings
+-- that differ from the organization name are rewritten, and the original text
+-- is kept so that Down can restore it.
+create table if not exists persons_work_before_organizations (
+    "tenant_id" text not null,
+    "person_id" int not null,
+    "work" text not null,
+    "migrated_work" text not null,
+    primary key ("tenant_id", "person_id"),
+    foreign key ("tenant_id", "person_id") references persons ("tenant_id", "id") on delete cascade
+);
+
+insert into persons_work_before_organizations ("tenant_id", "person_id", "work", "migrated_work")
+select p."tenant_id", p."id", p."work", o."name"
+from persons p
+join employments e on e."tenant_id" = p."tenant_id" and e."person_id" = p."id"
+join organizations o on o."tenant_id" = e."tenant_id" and o."id" = e."organization_id"
+where p."work" <> o."name";
+
+update persons p
+set "work" = b."migrated_work", "updated_at" = now()
+from persons_work_before_organizations b
+where b."tenant_id" = p."tenant_id" and b."person_id" = p."id";
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Persons whose work was not changed since are given their original text back.
+update persons p
+set "work" = b."work", "updated_at" = now()
+from persons_work_before_organizations b
+where b."tenant_id" = p."tenant_id" and b."person_id" = p."id" and p."work" = b."migrated_work";
+
+drop table if exists persons_work_before_organizations;
+drop table if exists employments;
+drop table if exists organizations;
+drop function if exists normalize_organization_name(text);
+-- +goose StatementEnd
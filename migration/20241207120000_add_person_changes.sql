@@ -0,0 +1,67 @@
+-- +goose Up
+-- +goose StatementBegin
+-- change_xid is the transaction that last wrote the person. The changes feed
+-- lists a change only once every transaction older than it has finished, so
+-- a transaction that commits late can not land behind a cursor.
+alter table persons
+    add column if not exists "change_xid" xid8 not null default pg_current_xact_id();
+
+-- The functions keep the search_path of the migration, so that the triggers
+-- of a tenant schema write to the tables of that schema.
+create or replace function stamp_person_change() returns trigger
+    language plpgsql
+    set search_path from current
+as $$
+begin
+    new."change_xid" := pg_current_xact_id();
+    return new;
+end
+$$;
+
+create trigger persons_stamp_change
+    before update on persons
+    for each row execute function stamp_person_change();
+
+-- Tombstones list deleted and merged-away persons in the changes feed.
+create table if not exists person_tombstones (
+    "tenant_id" text not null,
+    "id" int not null,
+    "deleted_at" timestamptz not null default now(),
+    "change_xid" xid8 not null default pg_current_xact_id(),
+    primary key ("tenant_id", "id")
+);
+
+create or replace function record_person_tombstone() returns trigger
+    language plpgsql
+    set search_path from current
+as $$
+begin
+    insert into person_tombstones ("tenant_id", "id") values (old."tenant_id", old."id");
+    return old;
+end
+$$;
+
+create trigger persons_record_tombstone
+    after delete on persons
+    for each row execute function record_person_tombstone();
+
+drop index if exists persons_tenant_id_updated_at_id_idx;
+create index if not exists persons_tenant_id_change_xid_id_idx on persons ("tenant_id", "change_xid", "id");
+create index if not exists person_tombstones_tenant_id_change_xid_id_idx on person_tombstones ("tenant_id", "change_xid", "id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists persons_tenant_id_change_xid_id_idx;
+create index if not exists persons_tenant_id_updated_at_id_idx on persons ("tenant_id", "updated_at", "id");
+
+drop trigger if exists persons_record_tombstone on persons;
+drop function if exists record_person_tombstone();
+drop table if exists person_tombstones;
+
+drop trigger if exists persons_stamp_change on persons;
+drop function if exists stamp_person_change();
+
+alter table persons
+    drop column if exists "change_xid";
+-- +goose StatementEnd
@@ -0,0 +1,3 @@
+package openapi
+
+//go:generate oapi-codegen -config oapi-codegen.yaml ../../../person-service.yaml
@@ -0,0 +1,1009 @@
+// Package openapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package openapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for RelationType.
+const (
+	RelationTypeManager RelationType = "manager"
+	RelationTypeParent  RelationType = "parent"
+	RelationTypeSpouse  RelationType = "spouse"
+)
+
+// Defines values for FindRelatedPersonsParamsDirection.
+const (
+	FindRelatedPersonsParamsDirectionBoth FindRelatedPersonsParamsDirection = "both"
+	FindRelatedPersonsParamsDirectionIn   FindRelatedPersonsParamsDirection = "in"
+	FindRelatedPersonsParamsDirectionOut  FindRelatedPersonsParamsDirection = "out"
+)
+
+// DuplicateCandidate defines model for DuplicateCandidate.
+type DuplicateCandidate struct {
+	// Person Returned in the media type asked for in Accept, JSON by default. In CSV the first line is the header and postal_address fields are in columns like postal_address.country.
+	Person PersonResponse `json:"person"`
+	Score  float32        `json:"score"`
+}
+
+// Employment defines model for Employment.
+type Employment struct {
+	CreatedAt      time.Time           `json:"created_at"`
+	EndDate        *openapi_types.Date `json:"end_date,omitempty"`
+	Id             int32               `json:"id"`
+	OrganizationId int32               `json:"organization_id"`
+	PersonId       int32               `json:"person_id"`
+	Role           string              `json:"role"`
+	StartDate      *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// EmploymentRequest defines model for EmploymentRequest.
+type EmploymentRequest struct {
+	// EndDate Last day of the employment, not before start_date. Employments without one are current.
+	EndDate        *openapi_types.Date `json:"end_date,omitempty"`
+	OrganizationId int32               `json:"organization_id"`
+	Role           string              `json:"role,omitempty"`
+	StartDate      *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// JSONPosition Where in the request body a JSON error is
+type JSONPosition struct {
+	// Column 1-based column, in bytes
+	Column int32 `json:"column"`
+
+	// Line 1-based line
+	Line int32 `json:"line"`
+
+	// Offset Byte offset from the start of the body
+	Offset int64 `json:"offset"`
+}
+
+// MergeRequest defines model for MergeRequest.
+type MergeRequest struct {
+	// Fields Id of the Person whose value wins, per field: name, age, email, phone, birth_date, address, postal_address or work. By default the target value is kept, and empty target values are filled from the sources in the given order.
+	Fields map[string]int32 `json:"fields,omitempty"`
+
+	// SourceIds Persons merged into the target and removed
+	SourceIds []int32 `json:"source_ids"`
+
+	// TargetId Person that survives the merge
+	TargetId int32 `json:"target_id"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int32     `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// OrganizationRequest defines model for OrganizationRequest.
+type OrganizationRequest struct {
+	// Name Unique by the normalized name, see the name filter of listOrganizations.
+	Name string `json:"name"`
+}
+
+// PersonRequest Any of the media types of the request body can be sent. XML and CSV values are converted to the types below. In CSV the first line is the header and postal_address fields are in columns like postal_address.country.
+type PersonRequest struct {
+	// Address Legacy free-text address.
+	Address string `json:"address,omitempty"`
+
+	// Age Between 1 and 150, ignored when birth_date is set.
+	Age       int32               `json:"age,omitempty"`
+	BirthDate *openapi_types.Date `json:"birth_date,omitempty"`
+
+	// Email Unique across persons, case-insensitive.
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name"`
+
+	// Phone E.164 number, spaces, dashes, dots and parentheses are allowed.
+	Phone         string         `json:"phone,omitempty"`
+	PostalAddress *PostalAddress `json:"postal_address,omitempty"`
+
+	// Work Name of the employer. The Person is employed from today by the Organization with this name, which is created when there is none.
+	Work string `json:"work,omitempty"`
+}
+
+// PersonResponse Returned in the media type asked for in Accept, JSON by default. In CSV the first line is the header and postal_address fields are in columns like postal_address.country.
+type PersonResponse struct {
+	// Address Legacy free-text address. For persons with only a postal_address it holds that address formatted on one line.
+	Address string `json:"address"`
+
+	// Age Derived from birth_date when it is known.
+	Age       int32               `json:"age"`
+	BirthDate *openapi_types.Date `json:"birth_date,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+
+	// Deleted Set in changes listed with updated_since for Persons deleted or merged away, of which only id and updated_at, the time of the deletion, are set.
+	Deleted       bool           `json:"deleted,omitempty"`
+	Email         string         `json:"email,omitempty"`
+	Id            int32          `json:"id"`
+	Name          string         `json:"name"`
+	Phone         string         `json:"phone,omitempty"`
+	PostalAddress *PostalAddress `json:"postal_address,omitempty"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+
+	// Work Name of the Organization of the current employment, the one that started last, or empty when there is none.
+	Work string `json:"work"`
+}
+
+// PostalAddress defines model for PostalAddress.
+type PostalAddress struct {
+	City string `json:"city,omitempty"`
+
+	// Country ISO 3166-1 alpha-2 code.
+	Country    string `json:"country,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Street     string `json:"street,omitempty"`
+}
+
+// RelatedPerson defines model for RelatedPerson.
+type RelatedPerson struct {
+	// Depth Relations between the queried Person and this one
+	Depth int32 `json:"depth"`
+
+	// Path Ids of the Persons from the queried one to this one
+	Path []int32 `json:"path"`
+
+	// Person Returned in the media type asked for in Accept, JSON by default. In CSV the first line is the header and postal_address fields are in columns like postal_address.country.
+	Person PersonResponse `json:"person"`
+
+	// RelationId The last relation on the path, to remove it
+	RelationId int32 `json:"relation_id"`
+
+	// Type Relations go from the first Person to the second: from the parent to the child, from the manager to the report.
+	Type RelationType `json:"type"`
+}
+
+// Relation defines model for Relation.
+type Relation struct {
+	Bidirectional bool      `json:"bidirectional"`
+	CreatedAt     time.Time `json:"created_at"`
+	FromId        int32     `json:"from_id"`
+	Id            int32     `json:"id"`
+	ToId          int32     `json:"to_id"`
+
+	// Type Relations go from the first Person to the second: from the parent to the child, from the manager to the report.
+	Type RelationType `json:"type"`
+}
+
+// RelationRequest defines model for RelationRequest.
+type RelationRequest struct {
+	// Bidirectional Follow the relation both ways whatever the direction asked for. Spouse relations always are.
+	Bidirectional bool `json:"bidirectional,omitempty"`
+
+	// ToId The second Person of the relation
+	ToId int32 `json:"to_id"`
+
+	// Type Relations go from the first Person to the second: from the parent to the child, from the manager to the report.
+	Type RelationType `json:"type"`
+}
+
+// RelationType Relations go from the first Person to the second: from the parent to the child, from the manager to the report.
+type RelationType string
+
+// ValidationErrorResponse defines model for ValidationErrorResponse.
+type ValidationErrorResponse struct {
+	// Errors Messages keyed by the JSON path of the field, like postal_address.country. They are in the language asked for in Accept-Language, en (the default) or ru, which is echoed in Content-Language.
+	Errors  map[string]string `json:"errors,omitempty"`
+	Message string            `json:"message"`
+
+	// Position Where in the request body a JSON error is
+	Position *JSONPosition `json:"position,omitempty"`
+}
+
+// EmploymentID defines model for EmploymentID.
+type EmploymentID = int32
+
+// OrganizationID defines model for OrganizationID.
+type OrganizationID = int32
+
+// PersonID defines model for PersonID.
+type PersonID = int32
+
+// BadID defines model for BadID.
+type BadID = ErrorResponse
+
+// Conflict defines model for Conflict.
+type Conflict = ErrorResponse
+
+// InternalError defines model for InternalError.
+type InternalError = ErrorResponse
+
+// NotAcceptable defines model for NotAcceptable.
+type NotAcceptable = ErrorResponse
+
+// NotFound defines model for NotFound.
+type NotFound = ErrorResponse
+
+// OrganizationConflict defines model for OrganizationConflict.
+type OrganizationConflict = ErrorResponse
+
+// OrganizationNotFound defines model for OrganizationNotFound.
+type OrganizationNotFound = ErrorResponse
+
+// PayloadTooLarge defines model for PayloadTooLarge.
+type PayloadTooLarge = ErrorResponse
+
+// ServiceUnavailable defines model for ServiceUnavailable.
+type ServiceUnavailable = ErrorResponse
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ErrorResponse
+
+// UnsupportedMediaType defines model for UnsupportedMediaType.
+type UnsupportedMediaType = ErrorResponse
+
+// ListOrganizationsParams defines parameters for ListOrganizations.
+type ListOrganizationsParams struct {
+	// Name Part of the name. Names are compared normalized: in lower case, without punctuation and legal forms like LLC or ООО, and transliterated to Latin, so that sber finds ООО «Сбер».
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// ListPersonsParams defines parameters for ListPersons.
+type ListPersonsParams struct {
+	// UpdatedSince Return only Persons created, modified or deleted at or after this moment, in the order the changes committed. A change shows up once the writes that were running when it committed have finished, so that none lands behind a cursor already returned.
+	UpdatedSince *time.Time `form:"updated_since,omitempty" json:"updated_since,omitempty"`
+
+	// Cursor Opaque cursor from X-Next-Cursor of the previous page, used with updated_since
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Page size, used with updated_since
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// FindPersonDuplicatesParams defines parameters for FindPersonDuplicates.
+type FindPersonDuplicatesParams struct {
+	// MinScore Lowest similarity score of returned candidates
+	MinScore *float32 `form:"min_score,omitempty" json:"min_score,omitempty"`
+
+	// Limit Maximum number of candidates
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// FindRelatedPersonsParams defines parameters for FindRelatedPersons.
+type FindRelatedPersonsParams struct {
+	// Type Types of relations to follow, all by default
+	Type *[]RelationType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Direction out follows relations from the first Person to the second, like from a manager to the reports, in the other way round, like from a child to the parents, and both either way. Bidirectional relations are always followed both ways.
+	Direction *FindRelatedPersonsParamsDirection `form:"direction,omitempty" json:"direction,omitempty"`
+
+	// Depth Most relations between the Person and the returned ones
+	Depth *int32 `form:"depth,omitempty" json:"depth,omitempty"`
+}
+
+// FindRelatedPersonsParamsDirection defines parameters for FindRelatedPersons.
+type FindRelatedPersonsParamsDirection string
+
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody = OrganizationRequest
+
+// EditOrganizationJSONRequestBody defines body for EditOrganization for application/json ContentType.
+type EditOrganizationJSONRequestBody = OrganizationRequest
+
+// CreatePersonJSONRequestBody defines body for CreatePerson for application/json ContentType.
+type CreatePersonJSONRequestBody = PersonRequest
+
+// MergePersonsJSONRequestBody defines body for MergePersons for application/json ContentType.
+type MergePersonsJSONRequestBody = MergeRequest
+
+// EditPersonJSONRequestBody defines body for EditPerson for application/json ContentType.
+type EditPersonJSONRequestBody = PersonRequest
+
+// CreateEmploymentJSONRequestBody defines body for CreateEmployment for application/json ContentType.
+type CreateEmploymentJSONRequestBody = EmploymentRequest
+
+// UpdateEmploymentJSONRequestBody defines body for UpdateEmployment for application/json ContentType.
+type UpdateEmploymentJSONRequestBody = EmploymentRequest
+
+// CreateRelationJSONRequestBody defines body for CreateRelation for application/json ContentType.
+type CreateRelationJSONRequestBody = RelationRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get this OpenAPI document as JSON
+	// (GET /api/v1/openapi.json)
+	GetOpenAPIJSON(ctx echo.Context) error
+	// Get this OpenAPI document as YAML
+	// (GET /api/v1/openapi.yaml)
+	GetOpenAPIYAML(ctx echo.Context) error
+	// Get all Organizations
+	// (GET /api/v1/organizations)
+	ListOrganizations(ctx echo.Context, params ListOrganizationsParams) error
+	// Create a new Organization
+	// (POST /api/v1/organizations)
+	CreateOrganization(ctx echo.Context) error
+	// Remove Organization by ID
+	// (DELETE /api/v1/organizations/{id})
+	DeleteOrganization(ctx echo.Context, id OrganizationID) error
+	// Get Organization by ID
+	// (GET /api/v1/organizations/{id})
+	GetOrganization(ctx echo.Context, id OrganizationID) error
+	// Rename Organization by ID
+	// (PATCH /api/v1/organizations/{id})
+	EditOrganization(ctx echo.Context, id OrganizationID) error
+	// Get employments at Organization by ID
+	// (GET /api/v1/organizations/{id}/employments)
+	ListOrganizationEmployments(ctx echo.Context, id OrganizationID) error
+	// Get all Persons
+	// (GET /api/v1/persons)
+	ListPersons(ctx echo.Context, params ListPersonsParams) error
+	// Create new Person
+	// (POST /api/v1/persons)
+	CreatePerson(ctx echo.Context) error
+	// Merge Persons into one
+	// (POST /api/v1/persons/merge)
+	MergePersons(ctx echo.Context) error
+	// Remove Person by ID
+	// (DELETE /api/v1/persons/{id})
+	DeletePerson(ctx echo.Context, id PersonID) error
+	// Get Person by ID
+	// (GET /api/v1/persons/{id})
+	GetPerson(ctx echo.Context, id PersonID) error
+	// Update Person by ID
+	// (PATCH /api/v1/persons/{id})
+	EditPerson(ctx echo.Context, id PersonID) error
+	// Find likely duplicates of Person by ID
+	// (GET /api/v1/persons/{id}/duplicates)
+	FindPersonDuplicates(ctx echo.Context, id PersonID, params FindPersonDuplicatesParams) error
+	// Get employments of Person by ID
+	// (GET /api/v1/persons/{id}/employments)
+	ListEmployments(ctx echo.Context, id PersonID) error
+	// Employ Person by ID at an organization
+	// (POST /api/v1/persons/{id}/employments)
+	CreateEmployment(ctx echo.Context, id PersonID) error
+	// Remove an employment of Person by ID
+	// (DELETE /api/v1/persons/{id}/employments/{employment_id})
+	DeleteEmployment(ctx echo.Context, id PersonID, employmentId EmploymentID) error
+	// Replace an employment of Person by ID
+	// (PUT /api/v1/persons/{id}/employments/{employment_id})
+	UpdateEmployment(ctx echo.Context, id PersonID, employmentId EmploymentID) error
+	// Find Persons related to Person by ID
+	// (GET /api/v1/persons/{id}/relations)
+	FindRelatedPersons(ctx echo.Context, id PersonID, params FindRelatedPersonsParams) error
+	// Relate Person by ID to another Person
+	// (POST /api/v1/persons/{id}/relations)
+	CreateRelation(ctx echo.Context, id PersonID) error
+	// Remove a relation of Person by ID
+	// (DELETE /api/v1/persons/{id}/relations/{relation_id})
+	DeleteRelation(ctx echo.Context, id PersonID, relationId int32) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetOpenAPIJSON converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOpenAPIJSON(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOpenAPIJSON(ctx)
+	return err
+}
+
+// GetOpenAPIYAML converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOpenAPIYAML(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOpenAPIYAML(ctx)
+	return err
+}
+
+// ListOrganizations converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOrganizations(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationsParams
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOrganizations(ctx, params)
+	return err
+}
+
+// CreateOrganization converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateOrganization(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateOrganization(ctx)
+	return err
+}
+
+// DeleteOrganization converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteOrganization(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteOrganization(ctx, id)
+	return err
+}
+
+// GetOrganization converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganization(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganization(ctx, id)
+	return err
+}
+
+// EditOrganization converts echo context to params.
+func (w *ServerInterfaceWrapper) EditOrganization(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EditOrganization(ctx, id)
+	return err
+}
+
+// ListOrganizationEmployments converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOrganizationEmployments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOrganizationEmployments(ctx, id)
+	return err
+}
+
+// ListPersons converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPersons(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPersonsParams
+	// ------------- Optional query parameter "updated_since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_since", ctx.QueryParams(), &params.UpdatedSince)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter updated_since: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPersons(ctx, params)
+	return err
+}
+
+// CreatePerson converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePerson(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePerson(ctx)
+	return err
+}
+
+// MergePersons converts echo context to params.
+func (w *ServerInterfaceWrapper) MergePersons(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MergePersons(ctx)
+	return err
+}
+
+// DeletePerson converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePerson(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePerson(ctx, id)
+	return err
+}
+
+// GetPerson converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPerson(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPerson(ctx, id)
+	return err
+}
+
+// EditPerson converts echo context to params.
+func (w *ServerInterfaceWrapper) EditPerson(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EditPerson(ctx, id)
+	return err
+}
+
+// FindPersonDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) FindPersonDuplicates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindPersonDuplicatesParams
+	// ------------- Optional query parameter "min_score" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_score", ctx.QueryParams(), &params.MinScore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_score: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FindPersonDuplicates(ctx, id, params)
+	return err
+}
+
+// ListEmployments converts echo context to params.
+func (w *ServerInterfaceWrapper) ListEmployments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListEmployments(ctx, id)
+	return err
+}
+
+// CreateEmployment converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateEmployment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateEmployment(ctx, id)
+	return err
+}
+
+// DeleteEmployment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteEmployment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "employment_id" -------------
+	var employmentId EmploymentID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "employment_id", ctx.Param("employment_id"), &employmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter employment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteEmployment(ctx, id, employmentId)
+	return err
+}
+
+// UpdateEmployment converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateEmployment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "employment_id" -------------
+	var employmentId EmploymentID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "employment_id", ctx.Param("employment_id"), &employmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter employment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateEmployment(ctx, id, employmentId)
+	return err
+}
+
+// FindRelatedPersons converts echo context to params.
+func (w *ServerInterfaceWrapper) FindRelatedPersons(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindRelatedPersonsParams
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "direction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "direction", ctx.QueryParams(), &params.Direction)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter direction: %s", err))
+	}
+
+	// ------------- Optional query parameter "depth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "depth", ctx.QueryParams(), &params.Depth)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter depth: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FindRelatedPersons(ctx, id, params)
+	return err
+}
+
+// CreateRelation converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateRelation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateRelation(ctx, id)
+	return err
+}
+
+// DeleteRelation converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRelation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "relation_id" -------------
+	var relationId int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "relation_id", ctx.Param("relation_id"), &relationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter relation_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteRelation(ctx, id, relationId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/api/v1/openapi.json", wrapper.GetOpenAPIJSON)
+	router.GET(baseURL+"/api/v1/openapi.yaml", wrapper.GetOpenAPIYAML)
+	router.GET(baseURL+"/api/v1/organizations", wrapper.ListOrganizations)
+	router.POST(baseURL+"/api/v1/organizations", wrapper.CreateOrganization)
+	router.DELETE(baseURL+"/api/v1/organizations/:id", wrapper.DeleteOrganization)
+	router.GET(baseURL+"/api/v1/organizations/:id", wrapper.GetOrganization)
+	router.PATCH(baseURL+"/api/v1/organizations/:id", wrapper.EditOrganization)
+	router.GET(baseURL+"/api/v1/organizations/:id/employments", wrapper.ListOrganizationEmployments)
+	router.GET(baseURL+"/api/v1/persons", wrapper.ListPersons)
+	router.POST(baseURL+"/api/v1/persons", wrapper.CreatePerson)
+	router.POST(baseURL+"/api/v1/persons/merge", wrapper.MergePersons)
+	router.DELETE(baseURL+"/api/v1/persons/:id", wrapper.DeletePerson)
+	router.GET(baseURL+"/api/v1/persons/:id", wrapper.GetPerson)
+	router.PATCH(baseURL+"/api/v1/persons/:id", wrapper.EditPerson)
+	router.GET(baseURL+"/api/v1/persons/:id/duplicates", wrapper.FindPersonDuplicates)
+	router.GET(baseURL+"/api/v1/persons/:id/employments", wrapper.ListEmployments)
+	router.POST(baseURL+"/api/v1/persons/:id/employments", wrapper.CreateEmployment)
+	router.DELETE(baseURL+"/api/v1/persons/:id/employments/:employment_id", wrapper.DeleteEmployment)
+	router.PUT(baseURL+"/api/v1/persons/:id/employments/:employment_id", wrapper.UpdateEmployment)
+	router.GET(baseURL+"/api/v1/persons/:id/relations", wrapper.FindRelatedPersons)
+	router.POST(baseURL+"/api/v1/persons/:id/relations", wrapper.CreateRelation)
+	router.DELETE(baseURL+"/api/v1/persons/:id/relations/:relation_id", wrapper.DeleteRelation)
+
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+w9224jOXa/QlTykCCli6cvuyNgH9xuz8SB3dOw3bsTzDYMqupI4rqKrCFZUmsaBvIl",
+	"AfKcpzzkKW/7BZM/WhySdS9JJcmW7VmjH9qq4uWQPPdzeOqrF4g4ERy4Vt7oqzcDGoI0f57QYAYngmsp",
+	"IvwdggokSzQT3Bt5Mf3So1P4wweyYHpG9AxIIPiETVMJITk5PvnX05uL4x9vjr8/9QkXvQBHI4sZcMI0",
+	"YYpwoYkC7fmeCmYQU5xCLxPwRp7SkvGpd3fne6fXdNqc/E9AbwlwzfSSaDolYmIAkKASwRX4RAsyBqKA",
+	"azKmwS1hnJxNeh8Eh94F1cFsw6znVOkLEbIJg7A5+zWLIZsyokqTYEb5NH/0EaQSfO0Md76XUElj0G6v",
+	"T+MkEssYuD57j78ZzpNQjYByGmNnyJvcsNDzPQk/p0wifFqmUJ5tImRMtTfyGNevvvF8L2acxWnsjY78",
+	"DBTGNUxBmtX+IKeUs18oLm/l9Pc8p92kw8x253sZZpjNfkdDO3EguAau8U+aJBELzBYM/oLHN/pamu8f",
+	"JUy8kfcPg4JYBvatGpxKKeSlG9/OVkWXMz6nEQsdXpCz996d750IPolYoA8HxmlMWYSERyMJNFySVEFI",
+	"xktCudAzkBne3vneGdcgOY3MmIfcKDstUSDnIAmY6e987wLktI0Q3Y4uqCKxaUIY1yJfkODg+WWOdi4s",
+	"4C0jUeRhwtCvSuWczRmfdqBk31N6GZknLE4it6wPQh8HASSajiM43P4he8uYUAwhowShVcj8LDh4+ipN",
+	"EiE1hJ4F9DuR8vCQMGoywSkzcpgI6Ujig1jHc1G+RAwZeoa/M6qs2EmlxOcF9x8vq9ye4ByTXjZ674rx",
+	"oIYaRtj1StKubZWu/aAiGUtCal0f0+auzm4PzwaOHXGUoci3UtEYCEfmGrFfICSGG9dAfkycqQBdYM5H",
+	"uowEDa+FOKdyekCKu4SfU1CajEW4ROKKcHpJ9IzyukYUsZhpBPYK5JwF8InTOWXRYTkEUlFINR1TBQhv",
+	"WgDhI5EYHLDw4WvGSUxRjnLKAyCUh0TCJFWgyEIyDapKQ5eg5bJ3PNEgmwR8BYHgoUImu6BMkzFMhAQi",
+	"sQ+y0hYmW5Lgd753LcQF5Uu35eqwm+ZYT0xDILGF24LRetaXx9enN+dnF2fXh9ygTzzn7RfI/a9No8ci",
+	"BS404g8tBE5JJhkV0A2NM79PLVxwQnnIQqoN4IkUCUjNrNaWWGG8AU4rVQpAcRohoaI0TiJBteejCZMr",
+	"jbkCOcwVSJ7GY6etFproT248P4Pnc95ejP8CgSHxQptvLiOQQDWEN1RXYMI19zSLwcvHK3QM4OFNtimV",
+	"Hm2NWdiqIddRxvdEiZnedO5ll929vRSWwbUoTlTqrsuqnYExDwpImmtx8/rl7V5/Ug5/mwdW3vwqyqOV",
+	"SEK6zHSuwkLzDfo7Ci4W2ifFfMrYzSLVRHAgVOZ6TN/zNx9yl7NbZxOtOhff+9Kbih4+7KlblvSEWSyN",
+	"eonAztLaYnseXx361pOpMJzGqcSgFJ1Cu/lenitr2DbHv1398OGjUKzdJvjTDCQgD7OuhRJ7owR7WvuE",
+	"MOX5NeACEaVxy4hHPRS8IbHvfRx7vLRitAMlRYzD6jHN207jiMlEgW6O9G6pgdiXZCJFbNZtjjlDcFx8",
+	"bY63r71W+75y3HZCtwI/2562EzGW3kpSnDCIQvMXDUNmEfNjtcXm5ddszrDqtiGLmVBA5jRKgSwYVz5J",
+	"QBIz88jowz6hU/AJoDntk2QmOFocTOqZoQaf0DCUoLCjUJpGN+436lcLIW/75N2ShDChaaTNxBqVRu2m",
+	"ZIrcQqJ9o2xBnOhl5b0ynGLCogjC0iGJVAbW0MOfUzYHToQMQfa9+iZvQeFm1Btmd7zN8q6a3aW1WE0x",
+	"FnNAVsw0xGoHFhUzfma7Fq+plHSJL+1Mjve1AYeamXbGPChnFKN14G/trCojczFvZYfakLlsrdyPItBZ",
+	"6lo3WovUTZNwy1nbRK8ZviJcKyNv2oyVBJ6BXT3PT5z9nAJa9XrWME99ogDsCzReJyzSIJGmI6Z0eVLV",
+	"37g2M30b8JlWmYNdt6mXbX4X96giOgLKM890n/x4cW5I5eTqj2XyDgSfg9GYM6oyw40hEos+OeOmPT6f",
+	"MKm04fzIN/CJNTbMoDXuY3mnGZ9xJ4EUidgt1Fr2A5FyLZf9hlhzDVoUIZjSYEkmEqCn4YvOWGBzx7tz",
+	"HyfcayIK9AKAkyOzwqM3Q5+wKRdoc5nIQsGGcUMUVJWpFeTSHaZi+E6auBERK9GZBlIoRawWq3wSUAU9",
+	"xhVwVEjmUAHdDrVmN3fZ4pVMwgi1JuCn/aO3r4k1i3yiEhqA8klI1cz8L7SyiEclcD0D5dCZRpFYQIjr",
+	"gS/UeElH3r/8jnz77bfk6JtXvddvem9/twemVNF3o4FoWh+7xne+hzK5udYPNIaqXg+yT64LPYGp7Hkm",
+	"iAWaAo5JVRxVLkDGlGNYixkLZjiA458Wd7VVNxXhgsPulNOJpfnelzgqGG5my96VWF2heNftfJ1KbmR+",
+	"jeERqm5xM4QsPM6+1ZTHucbzvPgX+U7IjELtMQoeof5fA45pMhMIntE5cpAN9eIBC26sPFxpv41TtDK7",
+	"9yDZPMOuEmcrxVBvuVjwfje1f0vmtYt6EkIEus17fwXGGWTjpMqIZgjthmZqg2I8AIM8mW7pRkO92amZ",
+	"dEGXPlKlpSBzFiw02FJoH74VmaUYrRmICe4b7HFSwQE/FiICyrdgNzlXf1jmvL+ql3PxR2Ks26uaXZhx",
+	"hbO6Z1kEqOyAwedIc9YK0NToUxitN45ua1l14bvr9F9qrIlsfxz0W6jF69hwZTebxgPTy32O1vHI5laf",
+	"Xf1AXh29fds7IjRKZrT3DQlECFXhfflpf3GNo+7nf5JgvRg7y8mGmn8JEZ7Vx9zTXN30EBI9a5OIkTUw",
+	"yNipp4h7P6cgGeRhTuRRRgUQXd00CW2b7CxUVYeFKtwA2ZQG7UV5utUWeHPeuqG9q99dum1pNdGvs9SZ",
+	"rBURdttw1SZ9x7oPCNOe3x3q9TBmB3WdRSDKdF0G1w3nuxP3s+SUNS7/bOwm1oxZyCQEFglL+JpJnh1l",
+	"LZ56dy9854ZadB907z03W50tJJs73/zqxm105WczrXQuNA6iipLfCbRTnMXukHIs9Iws6FKRxYxqmIMN",
+	"kebjFCpvn1wlIlVFX8yzMT2phL30jfxEmhSkTMgwYzG5u8Fhor+tv23v86wc4LozykKTqxjpVBRczRoJ",
+	"bo1Zio5Z+KhoZG3O7HUwY1HoF29jyqmJywu3Q4mQRgkEjpvxk6fM2RlCx3FMbNB08T63kN4fMZ3LQLoh",
+	"SGECBGv91S0qdHlHLmzwAn3CS5ushfAbkwpZUnbkxjby1xpDaLouM+vJJi7yaUqnrVZb79y99Alw8k9W",
+	"hzbm2z+j8iTTkg0LwUxYa/DExpnzzvv4nleHd4wCkUds1qFqJbrTPSyELRmfCDM300bh+SEBfvzxDHeB",
+	"ceaoaw5S2WOaHyFcIgFOE+aNvFf9Yf/IiQ1zzAOasMH8aOCa9LPY+9RqMIgRNvsy9Ebe96DdfLgCr5a8",
+	"+M1wuFVQv2V1VRzL1yaC1ISssYlK45jKpYXGahL1doQq4gDUdKpwU8uvDTzeZxysvvoljaMOq//344vz",
+	"7VafDbw28fb+Vu8A7Lr6si965fLP615rr5oq/FMzc7GIz6EZ0SdoLWWO5BgZWljymY+QUtEfJ43D0c+j",
+	"0EnKA51asYfKagRTGhkXhvOynJ+fIPH/+p/4z8antKRcRUzjAqy3+pxqxn2ihLO7xiZ2xkPl+pG//vev",
+	"//Xr//z6v///H3/9P2QQJvcXFddlkfxr/luXPv15T5rI1eF17KN8Ck29uAWTyqdmchCNLHycHMNXw9er",
+	"Guc7NygnXN753utvvt3cp56Fded7b4bDzf2qKcWm16vNvVpy5Zr0SaOI1Ekmo8nyc3J5enVNkEpzklPe",
+	"Z2eRNinxxKib5QFcSjoo/Q4D4feVWtUWGburyisnFWtIf/QgILTh9olzVNdp4vVweG8wrNKq1mTUYyqj",
+	"BaMD5ram3WLnow6IWM8wNf3ebO7XmpP3TGnNIgGhhMOC1OhiC3pbJRIHX1l4Z42CCNrSrSo0bp3HUEqn",
+	"wsiqzbrKUg9QvlRp+r0ZugZ7Tby27VLRZFC7LNMii16vB93lLZtbCw7QEimtPyN7c8W0fr0dxudZ2wW5",
+	"HC57tpFrXjq350oNl9Y/VVka3jt4v630WakFPyyWDg8mPFqQ/3npRQekzGeqgu1PBYm5ldnq5cKoSt3n",
+	"7SI+0bIIwts4ThZi1EI02f9pyB6ArJ6QRvioRG0kmgt3PSnlcH9R+aJZPrAsNbl7+3KRtZrloKxzdPW+",
+	"lNL1H10Ed3JaFAB3cVmUlkeoboTXfZJQZZN5Hb99kdu/LbkNVQS4RwJ0SVMlSmsKdjxeDCGE5UQnG6Bx",
+	"OWYfs+RIJQjNLuElIorwdraxAKv3fKegFXk1fE1SrlnkxlKYaO/0gqZOgCTvptnkarWpbzbtKFdErGfE",
+	"J7HDPCJknrlENf6iE23ihkyRWNjkFBeDMSn6LmJl1ZZAxDHTGsI+OXYPiZqJBQpWInhgM53t5UvrYl2A",
+	"BCJTzs2WuNSwfBgyo3OMD3GmZhAWjllu0tEoOmbHMGM8xO1NpRIyv16dHcIqL20lcctrrc6wNqu8YSMn",
+	"FNNiHRQGF37sfYAvundiHzkVMJEwZyJVJDEBKlPFoJlJtgJoO/r66h9NDzseAvtl68nsld/yXC6K5o2O",
+	"hsO28Gx+IXE4HK6P1h5GnDSzOioipT1/yWRCSZokuZ6KjKEMTaymCQ1unw5AX+LocYHBrNNBoOaPCUWz",
+	"VEAUkYI1Hl7w+9454y3pgPg0i+VzTNdFTmDJUkL0hz97+PDP3nZ1O3yvwm2as1a5UD6vT+jY1BkSvCgI",
+	"lNjMwC3Lhuyh5By2ko3hc6QkKw0gbzsBXyqM8sxjTgVpZCqSfbJTnCkvNfMQ/oTq9aVO3Hj34Rq8dLuh",
+	"2jnhNmN0jZy1h7gwuJEfxla1gyo9tyT95xRIe3Fx7B48q+BIJ7bRtKkG9hrr6GvOUGqXLcyt1NxCoRJW",
+	"XdM1lQeKq7p+XsqPySKP0aSOMVmxFbE5qYxlWmXj3gIkijCtCAt9N0NWLgUNOQtOD69zmPsbpsyJTaq0",
+	"aSRMNw01czW84LkPwScrt88P7HCtq3F3LcmA5hCLMm3PycG6tVP1hcvswGUMiuSUb8jdXgDYldU0Y/Jt",
+	"AfWco23nIc1rQHYLolcKxu0ZPn++AWe3C3Xv3AbVs9UHd2XxXpFA8Cw9ueDULe415OSNOnpNTv096AfA",
+	"iAdktfekD68YbyeFuDTW7hrxah5eoaXHMu6p0r1yxcd1nSoVeXe2ll8Nf7+5j5WzDxRBqMrBvyeTeTfG",
+	"lecFNCP598NjXqztB7e2Xzj3Q3HuesrFbvztGRoQOzHOF7Pjgdj7J4OBO3H4FTbHIMwqgq4OIee1Qq2P",
+	"w9TldEHkIdGCHCEkWrKppDFRLGYRlfjBADHJ6ri5Oh2o1mKSWeaqcMHnMShtbx82VdzvGHfXxN8XgO4u",
+	"iRoxx3OxwNlLUJvlIew5fEG+/hXxx5jxm6xaaUsMcth/4+9RFLUO8oXt6OoDIaQbAVwbIN0QH30K4dGW",
+	"srUdsm7yXtUN+rtOrHnuyTTID8w1uWhJCtaFRHCfTLE9ha0ZtK0Wo60VjGhmdVnfbTPNNivKxEvlV8Sa",
+	"8iuufkozv2afNLr7cwk8cAJd/esvL/T8G0qO25GQ18d8S7j25IzYZmHsA19JLBPi6guJUCHXp2XPPNIH",
+	"VYSsMOkXQ2YLsrdIVyF1QlFWVrf0/sT44Gvls14dYi33wjT8jW0rHyTrFp8putzL3cZDk09xEDVR/rzj",
+	"RZTXVrajHEtbdM1THqraBCYDOftkgivCm1WLye9mtWqbTdXRuhUeD+GfjFQdHkiqNig4iWjwFF2FT4U3",
+	"vAjbvRiUQa/74VCrZG2exrTSYLZ131SpcFt+D8XNmCZEC2JqARKlIVGuEK0pi0OjqFRUTJGUmwLGec0x",
+	"gT8mNGYROiMBvwEzx+zZwsTOHXqCB+BnF7EiMF+XwUl9woHKwhtJTpZB5Lye3OCorbBN9IK1xePROVEp",
+	"bXmvrsrrrNZ9sYFaOJh8sz1F9WdTRTSJTOlP+03PNregq/+3peVerUzXsN0bcIs027nWs19dds4dvGlJ",
+	"22vLqeKKj/nYHia6SZHW+5o6dVlPW3pOWSe0KTwILOvcJ+/KxQtL8NoC66bQYI4GedXCVVd38qHafa+4",
+	"NaXiePYXw8Y4cktJvBZnsCjV9qwWRq0URIUy9q/0E2dlONv8xOvdxE/BS1whvS5eJdfhUe9evPiS7tc3",
+	"7M7S0oRNct3PodS8TlkeL3PdWi5W+i5sRpOjEs/xK0UyjcCyBTGbosT6XS6LEqNPzG1VLwJ7YKdVvjFr",
+	"XFYyb/PsM3gPV0EoT2bPv/2Lpm2pevQk/1DOiw6+lQ4e1SP3pPQF7b2vKlR18MHXUpXtDr6ue+Azfuvn",
+	"5avFvu/rO/OdvGTZmp6lj6xOb78ND1llWTsZnzis+Wa9xc5URt7Im2mdjAaDSAQ0mgmlR78fDofe3ee7",
+	"vw0ApPmGw3SDAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,401 @@
+// Package client is a typed Go client for the persons REST API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	personsPath       = "/api/v1/persons"
+	organizationsPath = "/api/v1/organizations"
+	headerLastWrite   = "X-Last-Write"
+
+	defaultTimeout     = 10 * time.Second
+	defaultMaxRetries  = 3
+	defaultBaseBackoff = 100 * time.Millisecond
+	defaultMaxBackoff  = 2 * time.Second
+)
+
+type (
+	Person              = openapi.PersonResponse
+	PersonRequest       = openapi.PersonRequest
+	DuplicateCandidate  = openapi.DuplicateCandidate
+	MergeRequest        = openapi.MergeRequest
+	Relation            = openapi.Relation
+	RelationRequest     = openapi.RelationRequest
+	RelationType        = openapi.RelationType
+	RelatedPerson       = openapi.RelatedPerson
+	Organization        = openapi.Organization
+	OrganizationRequest = openapi.OrganizationRequest
+	Employment          = openapi.Employment
+	EmploymentRequest   = openapi.EmploymentRequest
+)
+
+// RelationQuery selects the persons FindRelatedPersons returns. Zero values
+// use server defaults: all types, direction out and depth 1.
+type RelationQuery struct {
+	Types []RelationType
+	// Direction is out, in or both.
+	Direction string
+	Depth     int
+}
+
+type Client struct {
+	baseURL     *url.URL
+	httpClient  *http.Client
+	maxRetries  int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+
+	// lastWrite is X-Last-Write of the last write, sent back with every
+	// request so that reads see the writes of the client.
+	mu        sync.Mutex
+	lastWrite string
+}
+
+type Option func(*Client)
+
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithRetries sets how many times idempotent calls (GET and DELETE) are
+// retried after a network error or a 429/502/503/504 response. Backoff
+// doubles from base up to max; a Retry-After header takes precedence.
+func WithRetries(maxRetries int, base, max time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.baseBackoff = base
+		c.maxBackoff = max
+	}
+}
+
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parse base url error: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("base url %q must be absolute", baseURL)
+	}
+
+	c := &Client{
+		baseURL:     u,
+		httpClient:  &http.Client{Timeout: defaultTimeout},
+		maxRetries:  defaultMaxRetries,
+		baseBackoff: defaultBaseBackoff,
+		maxBackoff:  defaultMaxBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+func (c *Client) GetPerson(ctx context.Context, id int32) (Person, error) {
+	var p Person
+	_, err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", personsPath, id), nil, nil, &p)
+	return p, err
+}
+
+func (c *Client) ListPersons(ctx context.Context) ([]Person, error) {
+	var persons []Person
+	_, err := c.do(ctx, http.MethodGet, personsPath, nil, nil, &persons)
+	return persons, err
+}
+
+// CreatePerson takes the id from Location.
+func (c *Client) CreatePerson(ctx context.Context, req PersonRequest) (int32, error) {
+	resp, err := c.do(ctx, http.MethodPost, personsPath, nil, req, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	location := resp.Header.Get("Location")
+	rawID := location[strings.LastIndex(location, "/")+1:]
+	id, err := strconv.ParseInt(rawID, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("bad Location header %q: %w", location, err)
+	}
+	return int32(id), nil
+}
+
+// UpdatePerson applies a partial update: zero fields are left unchanged.
+func (c *Client) UpdatePerson(ctx context.Context, id int32, req PersonRequest) (Person, error) {
+	var p Person
+	_, err := c.do(ctx, http.MethodPatch, fmt.Sprintf("%s/%d", personsPath, id), nil, req, &p)
+	return p, err
+}
+
+func (c *Client) DeletePerson(ctx context.Context, id int32) error {
+	_, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", personsPath, id), nil, nil, nil)
+	return err
+}
+
+// FindDuplicates uses server defaults for zero minScore and limit.
+func (c *Client) FindDuplicates(ctx context.Context, id int32, minScore float32, limit int) ([]DuplicateCandidate, error) {
+	query := url.Values{}
+	if minScore > 0 {
+		query.Set("min_score", strconv.FormatFloat(float64(minScore), 'f', -1, 32))
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var candidates []DuplicateCandidate
+	_, err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d/duplicates", personsPath, id), query, nil, &candidates)
+	return candidates, err
+}
+
+// MergePersons keeps merged-away ids working for GetPerson and UpdatePerson,
+// which follow the redirect to the surviving person.
+func (c *Client) MergePersons(ctx context.Context, req MergeRequest) (Person, error) {
+	var p Person
+	_, err := c.do(ctx, http.MethodPost, personsPath+"/merge", nil, req, &p)
+	return p, err
+}
+
+// CreateRelation makes the person with id the parent, the manager or a
+// spouse.
+func (c *Client) CreateRelation(ctx context.Context, id int32, req RelationRequest) (Relation, error) {
+	var r Relation
+	_, err := c.do(ctx, http.MethodPost, fmt.Sprintf("%s/%d/relations", personsPath, id), nil, req, &r)
+	return r, err
+}
+
+func (c *Client) DeleteRelation(ctx context.Context, id, relationID int32) error {
+	_, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d/relations/%d", personsPath, id, relationID), nil, nil, nil)
+	return err
+}
+
+func (c *Client) FindRelatedPersons(ctx context.Context, id int32, q RelationQuery) ([]RelatedPerson, error) {
+	query := url.Values{}
+	for _, t := range q.Types {
+		query.Add("type", string(t))
+	}
+	if q.Direction != "" {
+		query.Set("direction", q.Direction)
+	}
+	if q.Depth > 0 {
+		query.Set("depth", strconv.Itoa(q.Depth))
+	}
+
+	var related []RelatedPerson
+	_, err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d/relations", personsPath, id), query, nil, &related)
+	return related, err
+}
+
+// ListOrganizations compares names normalized, an empty name lists all
+// organizations.
+func (c *Client) ListOrganizations(ctx context.Context, name string) ([]Organization, error) {
+	query := url.Values{}
+	if name != "" {
+		query.Set("name", name)
+	}
+
+	var organizations []Organization
+	_, err := c.do(ctx, http.MethodGet, organizationsPath, query, nil, &organizations)
+	return organizations, err
+}
+
+// CreateOrganization fails with ErrConflict when another organization has
+// the same normalized name.
+func (c *Client) CreateOrganization(ctx context.Context, req OrganizationRequest) (Organization, error) {
+	var o Organization
+	_, err := c.do(ctx, http.MethodPost, organizationsPath, nil, req, &o)
+	return o, err
+}
+
+func (c *Client) GetOrganization(ctx context.Context, id int32) (Organization, error) {
+	var o Organization
+	_, err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", organizationsPath, id), nil, nil, &o)
+	return o, err
+}
+
+// UpdateOrganization also changes the work of the persons employed there.
+func (c *Client) UpdateOrganization(ctx context.Context, id int32, req OrganizationRequest) (Organization, error) {
+	var o Organization
+	_, err := c.do(ctx, http.MethodPatch, fmt.Sprintf("%s/%d", organizationsPath, id), nil, req, &o)
+	return o, err
+}
+
+// DeleteOrganization fails with ErrConflict while the organization has
+// employments.
+func (c *Client) DeleteOrganization(ctx context.Context, id int32) error {
+	_, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", organizationsPath, id), nil, nil, nil)
+	return err
+}
+
+func (c *Client) ListOrganizationEmployments(ctx context.Context, id int32) ([]Employment, error) {
+	var employments []Employment
+	_, err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d/employments", organizationsPath, id), nil, nil, &employments)
+	return employments, err
+}
+
+func (c *Client) ListEmployments(ctx context.Context, id int32) ([]Employment, error) {
+	var employments []Employment
+	_, err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d/employments", personsPath, id), nil, nil, &employments)
+	return employments, err
+}
+
+func (c *Client) CreateEmployment(ctx context.Context, id int32, req EmploymentRequest) (Employment, error) {
+	var e Employment
+	_, err := c.do(ctx, http.MethodPost, fmt.Sprintf("%s/%d/employments", personsPath, id), nil, req, &e)
+	return e, err
+}
+
+func (c *Client) UpdateEmployment(ctx context.Context, id, employmentID int32, req EmploymentRequest) (Employment, error) {
+	var e Employment
+	_, err := c.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d/employments/%d", personsPath, id, employmentID), nil, req, &e)
+	return e, err
+}
+
+func (c *Client) DeleteEmployment(ctx context.Context, id, employmentID int32) error {
+	_, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d/employments/%d", personsPath, id, employmentID), nil, nil, nil)
+	return err
+}
+
+func (c *Client) OpenAPISpec(ctx context.Context) (json.RawMessage, error) {
+	var spec json.RawMessage
+	_, err := c.do(ctx, http.MethodGet, "/api/v1/openapi.json", nil, nil, &spec)
+	return spec, err
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) (*http.Response, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("marshal request error: %w", err)
+		}
+	}
+
+	u := *c.baseURL
+	u.Path += path
+	u.RawQuery = query.Encode()
+
+	retries := 0
+	if method == http.MethodGet || method == http.MethodDelete {
+		retries = c.maxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, method, u.String(), payload)
+		if err == nil && (resp.StatusCode < 300 || !retryable(resp.StatusCode)) {
+			return resp, decode(resp, out)
+		}
+		if attempt >= retries {
+			if err != nil {
+				return nil, err
+			}
+			return resp, decode(resp, out)
+		}
+
+		wait := c.backoff(attempt)
+		if err == nil {
+			if after := retryAfter(resp); after > 0 {
+				wait = after
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, method, target string, payload []byte) (*http.Response, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, fmt.Errorf("build request error: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	c.mu.Lock()
+	if c.lastWrite != "" {
+		req.Header.Set(headerLastWrite, c.lastWrite)
+	}
+	c.mu.Unlock()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", method, target, err)
+	}
+	if lastWrite := resp.Header.Get(headerLastWrite); lastWrite != "" {
+		c.mu.Lock()
+		c.lastWrite = lastWrite
+		c.mu.Unlock()
+	}
+	return resp, nil
+}
+
+func (c *Client) backoff(attempt int) time.Duration {
+	d := c.baseBackoff << attempt
+	if d <= 0 || d > c.maxBackoff {
+		return c.maxBackoff
+	}
+	return d
+}
+
+func retryable(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func retryAfter(resp *http.Response) time.Duration {
+	secs, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || secs < 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
+
+func decode(resp *http.Response, out any) error {
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response error: %w", err)
+	}
+
+	if resp.StatusCode >= 300 {
+		return newError(resp.StatusCode, data)
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err = json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decode response error: %w", err)
+	}
+	return nil
+}
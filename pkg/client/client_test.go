@@ -0,0 +1,384 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/config"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/models"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/repositories/memory"
+	"github.com/AskaryanKarine/BMSTU-ds-1/internal/server"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.Handler, opts ...Option) *Client {
+	t.Helper()
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	c, err := New(ts.URL, opts...)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return c
+}
+
+func newServer(t *testing.T, pr server.PersonRepository) *server.Server {
+	t.Helper()
+
+	s, err := server.New(config.Config{}, pr)
+	if err != nil {
+		t.Fatalf("server.New() error = %v", err)
+	}
+	return s
+}
+
+func TestClient_CRUD(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, newServer(t, memory.NewStorage()))
+
+	id, err := c.CreatePerson(ctx, PersonRequest{Name: "test", Age: 20, Work: "test"})
+	if err != nil {
+		t.Fatalf("CreatePerson() error = %v", err)
+	}
+
+	p, err := c.GetPerson(ctx, id)
+	if err != nil {
+		t.Fatalf("GetPerson() error = %v", err)
+	}
+	if p.Id != id || p.Name != "test" || p.Age != 20 {
+		t.Errorf("GetPerson() got %+v", p)
+	}
+
+	p, err = c.UpdatePerson(ctx, id, PersonRequest{Name: "updated"})
+	if err != nil {
+		t.Fatalf("UpdatePerson() error = %v", err)
+	}
+	if p.Name != "updated" || p.Age != 20 {
+		t.Errorf("UpdatePerson() got %+v", p)
+	}
+
+	persons, err := c.ListPersons(ctx)
+	if err != nil {
+		t.Fatalf("ListPersons() error = %v", err)
+	}
+	if len(persons) != 1 {
+		t.Errorf("ListPersons() expected 1 person, but got %d", len(persons))
+	}
+
+	if err = c.DeletePerson(ctx, id); err != nil {
+		t.Fatalf("DeletePerson() error = %v", err)
+	}
+
+	_, err = c.GetPerson(ctx, id)
+	var apiErr *APIError
+	if !errors.Is(err, ErrNotFound) || !errors.As(err, &apiErr) || apiErr.Message == "" {
+		t.Errorf("GetPerson() after delete expected ErrNotFound APIError, but got %v", err)
+	}
+
+	spec, err := c.OpenAPISpec(ctx)
+	if err != nil || len(spec) == 0 {
+		t.Errorf("OpenAPISpec() error = %v", err)
+	}
+}
+
+func TestClient_validationError(t *testing.T) {
+	c := newTestClient(t, newServer(t, memory.NewStorage()))
+
+	_, err := c.CreatePerson(context.Background(), PersonRequest{Name: "test", Age: -10})
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("CreatePerson() expected ValidationError, but got %v", err)
+	}
+	if !errors.Is(err, ErrBadRequest) {
+		t.Errorf("CreatePerson() expected ErrBadRequest")
+	}
+	if _, ok := verr.Fields["age"]; !ok {
+		t.Errorf("CreatePerson() expected error for age, but got %v", verr.Fields)
+	}
+}
+
+func TestClient_ListChanges(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewStorage()
+	c := newTestClient(t, newServer(t, repo))
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		if _, err := c.CreatePerson(ctx, PersonRequest{Name: name, Age: 30}); err != nil {
+			t.Fatalf("CreatePerson() error = %v", err)
+		}
+	}
+	if _, err := c.UpdatePerson(ctx, 1, PersonRequest{Name: "aa"}); err != nil {
+		t.Fatalf("UpdatePerson() error = %v", err)
+	}
+
+	b, err := c.GetPerson(ctx, 2)
+	if err != nil {
+		t.Fatalf("GetPerson() error = %v", err)
+	}
+
+	it := c.ListChanges(ctx, b.CreatedAt, 2)
+	var names []string
+	for it.Next() {
+		names = append(names, it.Person().Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ListChanges() error = %v", err)
+	}
+
+	expected := []string{"b", "c", "d", "e", "aa"}
+	if len(names) != len(expected) {
+		t.Fatalf("ListChanges() expected %v, but got %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("ListChanges() expected %v, but got %v", expected, names)
+			break
+		}
+	}
+}
+
+func TestClient_retries(t *testing.T) {
+	repo := memory.NewStorage()
+	p, _ := repo.CreatePerson(models.Person{Name: "test"})
+	srv := newServer(t, repo)
+
+	tests := []struct {
+		name             string
+		failures         int32
+		call             func(c *Client) error
+		expectedRequests int32
+		expectedErr      error
+	}{
+		{
+			name:     "get is retried until success",
+			failures: 2,
+			call: func(c *Client) error {
+				_, err := c.GetPerson(context.Background(), p.ID)
+				return err
+			},
+			expectedRequests: 3,
+		},
+		{
+			name:     "get gives up after max retries",
+			failures: 10,
+			call: func(c *Client) error {
+				_, err := c.GetPerson(context.Background(), p.ID)
+				return err
+			},
+			expectedRequests: 4,
+			expectedErr:      ErrServerError,
+		},
+		{
+			name:     "create is not retried",
+			failures: 1,
+			call: func(c *Client) error {
+				_, err := c.CreatePerson(context.Background(), PersonRequest{Name: "test"})
+				return err
+			},
+			expectedRequests: 1,
+			expectedErr:      ErrServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) <= tt.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				srv.ServeHTTP(w, r)
+			})
+			c := newTestClient(t, handler, WithRetries(3, time.Millisecond, 5*time.Millisecond))
+
+			err := tt.call(c)
+			if tt.expectedErr == nil && err != nil || tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, but got %v", tt.expectedErr, err)
+			}
+			if requests != tt.expectedRequests {
+				t.Errorf("expected %d requests, but got %d", tt.expectedRequests, requests)
+			}
+		})
+	}
+}
+
+func TestClient_lastWrite(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(t, memory.NewStorage())
+	var sent []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Header.Get(headerLastWrite))
+		srv.ServeHTTP(w, r)
+	})
+	c := newTestClient(t, handler)
+
+	if _, err := c.ListPersons(ctx); err != nil {
+		t.Fatalf("ListPersons() error = %v", err)
+	}
+	id, err := c.CreatePerson(ctx, PersonRequest{Name: "test"})
+	if err != nil {
+		t.Fatalf("CreatePerson() error = %v", err)
+	}
+	if _, err = c.GetPerson(ctx, id); err != nil {
+		t.Fatalf("GetPerson() error = %v", err)
+	}
+
+	if len(sent) != 3 || sent[0] != "" || sent[1] != "" || sent[2] == "" {
+		t.Errorf("expected %s sent only after the write, but got %q", headerLastWrite, sent)
+	}
+}
+
+func TestClient_duplicatesAndMerge(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, newServer(t, memory.NewStorage()))
+
+	persons := []PersonRequest{
+		{Name: "Ivan Ivanov", Address: "Moscow, Baumanskaya 5", Email: "ivan@example.com"},
+		{Name: "Ivan Ivanov", Address: "Moscow, Baumanskaya 5a", Work: "BMSTU"},
+		{Name: "Petr Petrov", Address: "Kazan"},
+	}
+	for _, p := range persons {
+		if _, err := c.CreatePerson(ctx, p); err != nil {
+			t.Fatalf("CreatePerson() error = %v", err)
+		}
+	}
+
+	duplicates, err := c.FindDuplicates(ctx, 1, 0, 0)
+	if err != nil {
+		t.Fatalf("FindDuplicates() error = %v", err)
+	}
+	if len(duplicates) != 1 || duplicates[0].Person.Id != 2 || duplicates[0].Score < 0.5 {
+		t.Fatalf("FindDuplicates() expected person 2, but got %+v", duplicates)
+	}
+
+	merged, err := c.MergePersons(ctx, MergeRequest{
+		TargetId:  1,
+		SourceIds: []int32{2},
+		Fields:    map[string]int32{"address": 2},
+	})
+	if err != nil {
+		t.Fatalf("MergePersons() error = %v", err)
+	}
+	if merged.Id != 1 || merged.Address != persons[1].Address || merged.Work != "BMSTU" || merged.Email != persons[0].Email {
+		t.Errorf("MergePersons() got %+v", merged)
+	}
+
+	// The merged-away id redirects to the survivor.
+	p, err := c.GetPerson(ctx, 2)
+	if err != nil {
+		t.Fatalf("GetPerson() of merged id error = %v", err)
+	}
+	if p.Id != 1 {
+		t.Errorf("GetPerson() of merged id expected person 1, but got %d", p.Id)
+	}
+
+	_, err = c.MergePersons(ctx, MergeRequest{TargetId: 1, SourceIds: []int32{2}})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("MergePersons() of merged id expected ErrNotFound, but got %v", err)
+	}
+}
+
+func TestClient_relations(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, newServer(t, memory.NewStorage()))
+
+	for _, name := range []string{"ceo", "cto", "dev"} {
+		if _, err := c.CreatePerson(ctx, PersonRequest{Name: name, Age: 30}); err != nil {
+			t.Fatalf("CreatePerson() error = %v", err)
+		}
+	}
+	for _, r := range [][2]int32{{1, 2}, {2, 3}} {
+		_, err := c.CreateRelation(ctx, r[0], RelationRequest{ToId: r[1], Type: openapi.RelationTypeManager})
+		if err != nil {
+			t.Fatalf("CreateRelation() error = %v", err)
+		}
+	}
+	if _, err := c.CreateRelation(ctx, 1, RelationRequest{ToId: 4, Type: openapi.RelationTypeManager}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("CreateRelation() to a missing person expected ErrNotFound, but got %v", err)
+	}
+
+	related, err := c.FindRelatedPersons(ctx, 1, RelationQuery{Types: []RelationType{openapi.RelationTypeManager}, Depth: 2})
+	if err != nil {
+		t.Fatalf("FindRelatedPersons() error = %v", err)
+	}
+	if len(related) != 2 || related[1].Person.Id != 3 || !slices.Equal(related[1].Path, []int32{1, 2, 3}) {
+		t.Fatalf("FindRelatedPersons() expected persons 2 and 3, but got %+v", related)
+	}
+
+	if err = c.DeleteRelation(ctx, 2, related[1].RelationId); err != nil {
+		t.Fatalf("DeleteRelation() error = %v", err)
+	}
+	related, err = c.FindRelatedPersons(ctx, 3, RelationQuery{Direction: "in", Depth: 2})
+	if err != nil || len(related) != 0 {
+		t.Errorf("FindRelatedPersons() after DeleteRelation() expected none, but got %+v, %v", related, err)
+	}
+}
+
+func TestClient_organizations(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, newServer(t, memory.NewStorage()))
+
+	sber, err := c.CreateOrganization(ctx, OrganizationRequest{Name: "Sber"})
+	if err != nil {
+		t.Fatalf("CreateOrganization() error = %v", err)
+	}
+	if _, err = c.CreateOrganization(ctx, OrganizationRequest{Name: "ООО «Сбер»"}); !errors.Is(err, ErrConflict) {
+		t.Errorf("CreateOrganization() of a taken name expected ErrConflict, but got %v", err)
+	}
+
+	id, err := c.CreatePerson(ctx, PersonRequest{Name: "Ivan", Age: 30, Work: "sber"})
+	if err != nil {
+		t.Fatalf("CreatePerson() error = %v", err)
+	}
+	p, err := c.GetPerson(ctx, id)
+	if err != nil || p.Work != "Sber" {
+		t.Fatalf("GetPerson() work expected %q, but got %+v, %v", "Sber", p, err)
+	}
+
+	employments, err := c.ListEmployments(ctx, id)
+	if err != nil || len(employments) != 1 || employments[0].OrganizationId != sber.Id {
+		t.Fatalf("ListEmployments() expected an employment at Sber, but got %+v, %v", employments, err)
+	}
+	if err = c.DeleteOrganization(ctx, sber.Id); !errors.Is(err, ErrConflict) {
+		t.Errorf("DeleteOrganization() with employments expected ErrConflict, but got %v", err)
+	}
+
+	yesterday := openapi_types.Date{Time: time.Now().AddDate(0, 0, -1)}
+	_, err = c.UpdateEmployment(ctx, id, employments[0].Id, EmploymentRequest{
+		OrganizationId: sber.Id, Role: "engineer", StartDate: &yesterday, EndDate: &yesterday,
+	})
+	if err != nil {
+		t.Fatalf("UpdateEmployment() error = %v", err)
+	}
+	if p, err = c.GetPerson(ctx, id); err != nil || p.Work != "" {
+		t.Errorf("GetPerson() work after the employment ended expected none, but got %+v, %v", p, err)
+	}
+
+	google, err := c.CreateOrganization(ctx, OrganizationRequest{Name: "Google"})
+	if err != nil {
+		t.Fatalf("CreateOrganization() error = %v", err)
+	}
+	if _, err = c.CreateEmployment(ctx, id, EmploymentRequest{OrganizationId: google.Id}); err != nil {
+		t.Fatalf("CreateEmployment() error = %v", err)
+	}
+	if _, err = c.UpdateOrganization(ctx, google.Id, OrganizationRequest{Name: "Alphabet"}); err != nil {
+		t.Fatalf("UpdateOrganization() error = %v", err)
+	}
+	if p, err = c.GetPerson(ctx, id); err != nil || p.Work != "Alphabet" {
+		t.Errorf("GetPerson() work after a rename expected %q, but got %+v, %v", "Alphabet", p, err)
+	}
+
+	found, err := c.ListOrganizations(ctx, "alphabet inc")
+	if err != nil || len(found) != 1 || found[0].Id != google.Id {
+		t.Errorf("ListOrganizations() expected Alphabet, but got %+v, %v", found, err)
+	}
+}
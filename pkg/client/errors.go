@@ -0,0 +1,84 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/AskaryanKarine/BMSTU-ds-1/pkg/api/openapi"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrBadRequest  = errors.New("bad request")
+	ErrConflict    = errors.New("conflict")
+	ErrServerError = errors.New("server error")
+)
+
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("api error %d: %s", e.StatusCode, e.Message)
+}
+
+func (e *APIError) Is(target error) bool {
+	return statusIs(e.StatusCode, target)
+}
+
+// ValidationError fields are keyed by the JSON path of the invalid field,
+// like "postal_address.city".
+type ValidationError struct {
+	StatusCode int
+	Message    string
+	Fields     map[string]string
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Fields) == 0 {
+		return fmt.Sprintf("validation error %d: %s", e.StatusCode, e.Message)
+	}
+
+	fields := make([]string, 0, len(e.Fields))
+	for k, v := range e.Fields {
+		fields = append(fields, fmt.Sprintf("%s: %s", k, v))
+	}
+	sort.Strings(fields)
+	return fmt.Sprintf("validation error %d: %s (%s)", e.StatusCode, e.Message, strings.Join(fields, "; "))
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return statusIs(e.StatusCode, target)
+}
+
+func statusIs(code int, target error) bool {
+	switch target {
+	case ErrNotFound:
+		return code == http.StatusNotFound
+	case ErrBadRequest:
+		return code == http.StatusBadRequest
+	case ErrConflict:
+		return code == http.StatusConflict
+	case ErrServerError:
+		return code >= http.StatusInternalServerError
+	}
+	return false
+}
+
+func newError(code int, body []byte) error {
+	var verr openapi.ValidationErrorResponse
+	if err := json.Unmarshal(body, &verr); err == nil && verr.Errors != nil {
+		return &ValidationError{StatusCode: code, Message: verr.Message, Fields: verr.Errors}
+	}
+
+	var aerr openapi.ErrorResponse
+	if err := json.Unmarshal(body, &aerr); err == nil && aerr.Message != "" {
+		return &APIError{StatusCode: code, Message: aerr.Message}
+	}
+
+	return &APIError{StatusCode: code, Message: strings.TrimSpace(http.StatusText(code) + " " + string(body))}
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ChangesIterator walks persons modified or deleted since a moment page by
+// page, following the cursor returned by the API. Deleted persons have only
+// Id, UpdatedAt and Deleted set:
+//
+//	it := c.ListChanges(ctx, since, 100)
+//	for it.Next() {
+//		p := it.Person()
+//	}
+//	if err := it.Err(); err != nil { ... }
+type ChangesIterator struct {
+	c     *Client
+	ctx   context.Context
+	since time.Time
+	limit int
+
+	page   []Person
+	pos    int
+	cursor string
+	done   bool
+	err    error
+}
+
+// ListChanges uses the server default page size for a limit of 0.
+func (c *Client) ListChanges(ctx context.Context, since time.Time, limit int) *ChangesIterator {
+	return &ChangesIterator{c: c, ctx: ctx, since: since, limit: limit, pos: -1}
+}
+
+func (it *ChangesIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.pos++
+	for it.pos >= len(it.page) {
+		if it.done {
+			return false
+		}
+		if !it.fetch() {
+			return false
+		}
+	}
+	return true
+}
+
+func (it *ChangesIterator) Person() Person {
+	return it.page[it.pos]
+}
+
+func (it *ChangesIterator) Err() error {
+	return it.err
+}
+
+// Cursor lets a sync resume later.
+func (it *ChangesIterator) Cursor() string {
+	return it.cursor
+}
+
+func (it *ChangesIterator) fetch() bool {
+	query := url.Values{}
+	query.Set("updated_since", it.since.UTC().Format(time.RFC3339Nano))
+	if it.limit > 0 {
+		query.Set("limit", strconv.Itoa(it.limit))
+	}
+	if it.cursor != "" {
+		query.Set("cursor", it.cursor)
+	}
+
+	var page []Person
+	resp, err := it.c.do(it.ctx, http.MethodGet, personsPath, query, nil, &page)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page = page
+	it.pos = 0
+	it.cursor = resp.Header.Get("X-Next-Cursor")
+	it.done = it.cursor == ""
+	return true
+}
@@ -0,0 +1,157 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	maxAge            = 150
+	maxPersonNameSize = 100
+)
+
+var (
+	// phoneRe accepts E.164 numbers; spaces, dashes, dots and parentheses
+	// are stripped before matching.
+	phoneRe         = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+	postalCodeRe    = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 -]{1,8}[A-Za-z0-9]$`)
+)
+
+// Rule is a custom validation tag. Messages holds the error message per
+// locale, where {0} is the field name and {1} the tag parameter. A rule
+// without Func only adds messages to a built-in tag.
+type Rule struct {
+	Tag      string
+	Func     validator.Func
+	Messages map[string]string
+}
+
+var rules = []Rule{
+	{
+		Tag:  "personname",
+		Func: isPersonName,
+		Messages: map[string]string{
+			"en": "{0} must be a name of letters, spaces, hyphens, apostrophes and dots",
+			"ru": "{0} должно состоять из букв, пробелов, дефисов, апострофов и точек",
+		},
+	},
+	{
+		Tag:  "phone",
+		Func: isPhone,
+		Messages: map[string]string{
+			"en": "{0} must be an international phone number, like +7 999 123-45-67",
+			"ru": "{0} должен быть номером телефона в международном формате, например +7 999 123-45-67",
+		},
+	},
+	{
+		Tag:  "sane_age",
+		Func: isSaneAge,
+		Messages: map[string]string{
+			"en": fmt.Sprintf("{0} must be between 1 and %d", maxAge),
+			"ru": fmt.Sprintf("{0} должен быть от 1 до %d", maxAge),
+		},
+	},
+	{
+		Tag:  "notblank",
+		Func: isNotBlank,
+		Messages: map[string]string{
+			"en": "{0} must not be blank",
+			"ru": "{0} не может быть пустым",
+		},
+	},
+	{
+		Tag:  "postal_code",
+		Func: isPostalCode,
+		Messages: map[string]string{
+			"en": "{0} must be a postal code",
+			"ru": "{0} должен быть почтовым индексом",
+		},
+	},
+	{
+		Tag: "iso3166_1_alpha2",
+		Messages: map[string]string{
+			"en": "{0} must be an ISO 3166-1 alpha-2 country code, like RU",
+			"ru": "{0} должен быть кодом страны ISO 3166-1 alpha-2, например RU",
+		},
+	},
+	{
+		Tag:  "past_date",
+		Func: isPastDate,
+		Messages: map[string]string{
+			"en": "{0} must not be in the future",
+			"ru": "{0} не может быть в будущем",
+		},
+	},
+}
+
+// Register adds a custom validation tag. It must be called before the first
+// call of Default, usually from an init function.
+func Register(r Rule) {
+	if defaultValidate != nil {
+		panic(fmt.Sprintf("validation rule %s is registered after the validator is built", r.Tag))
+	}
+	for _, existing := range rules {
+		if existing.Tag == r.Tag {
+			panic(fmt.Sprintf("validation rule %s is registered twice", r.Tag))
+		}
+	}
+	rules = append(rules, r)
+}
+
+// isPersonName accepts letters, spaces, hyphens, apostrophes and dots,
+// starting with a letter once trimmed.
+func isPersonName(fl validator.FieldLevel) bool {
+	name := strings.TrimSpace(fl.Field().String())
+	if name == "" || utf8.RuneCountInString(name) > maxPersonNameSize {
+		return false
+	}
+
+	for i, r := range name {
+		if i == 0 && !unicode.IsLetter(r) {
+			return false
+		}
+		if !unicode.IsLetter(r) && !unicode.Is(unicode.Mn, r) && !strings.ContainsRune(" -'’.", r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isPhone(fl validator.FieldLevel) bool {
+	return phoneRe.MatchString(phoneSeparators.Replace(fl.Field().String()))
+}
+
+func isSaneAge(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.Int() > 0 && field.Int() <= maxAge
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return field.Uint() > 0 && field.Uint() <= maxAge
+	}
+	return false
+}
+
+func isNotBlank(fl validator.FieldLevel) bool {
+	return strings.TrimSpace(fl.Field().String()) != ""
+}
+
+func isPostalCode(fl validator.FieldLevel) bool {
+	return postalCodeRe.MatchString(fl.Field().String())
+}
+
+// isPastDate checks that a time.Time field is not later than now.
+func isPastDate(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Type() != reflect.TypeOf(time.Time{}) {
+		return false
+	}
+	return !field.Interface().(time.Time).After(time.Now())
+}
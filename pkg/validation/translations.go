@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/ru"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	entranslations "github.com/go-playground/validator/v10/translations/en"
+	rutranslations "github.com/go-playground/validator/v10/translations/ru"
+	"golang.org/x/text/language"
+)
+
+// uni holds a translator per supported locale, English is the fallback.
+var uni = ut.New(en.New(), en.New(), ru.New())
+
+var defaultTranslations = map[string]func(*validator.Validate, ut.Translator) error{
+	"en": entranslations.RegisterDefaultTranslations,
+	"ru": rutranslations.RegisterDefaultTranslations,
+}
+
+// Translator returns the translator best matching an Accept-Language header
+// value, or the English one.
+func Translator(acceptLanguage string) ut.Translator {
+	tags, _, _ := language.ParseAcceptLanguage(acceptLanguage)
+	locales := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		base, _ := tag.Base()
+		locales = append(locales, base.String())
+	}
+
+	trans, _ := uni.FindTranslator(locales...)
+	return trans
+}
+
+func registerTranslations(v *validator.Validate) error {
+	for locale, register := range defaultTranslations {
+		trans, _ := uni.GetTranslator(locale)
+		if err := register(v, trans); err != nil {
+			return err
+		}
+
+		for _, r := range rules {
+			msg, ok := r.Messages[locale]
+			if !ok {
+				msg = r.Messages["en"]
+			}
+			err := v.RegisterTranslation(r.Tag, trans, addTranslation(r.Tag, msg), translate)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func addTranslation(tag, msg string) validator.RegisterTranslationsFunc {
+	return func(trans ut.Translator) error {
+		return trans.Add(tag, msg, true)
+	}
+}
+
+func translate(trans ut.Translator, fe validator.FieldError) string {
+	msg, err := trans.T(fe.Tag(), fe.Field(), fe.Param())
+	if err != nil {
+		return fe.Error()
+	}
+	return msg
+}
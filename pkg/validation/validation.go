@@ -1,17 +1,110 @@
+// Package validation validates request models with go-playground/validator,
+// adding the domain rules of the service and error messages in English and
+// Russian.
 package validation
 
 import (
+	"errors"
+	"fmt"
 	"github.com/go-playground/validator/v10"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	defaultOnce     sync.Once
+	defaultValidate *validator.Validate
 )
 
 type customValidator struct {
 	validator *validator.Validate
 }
 
-func MustRegisterCustomValidator(v *validator.Validate) *customValidator {
-	return &customValidator{validator: v}
+// NewEchoValidator adapts Default to echo.Validator.
+func NewEchoValidator() *customValidator {
+	return &customValidator{validator: Default()}
 }
 
 func (cv *customValidator) Validate(i interface{}) error {
 	return cv.validator.Struct(i)
 }
+
+// Default returns the validator with all registered rules and translations.
+// It is shared, as translations can be added to a translator only once, and
+// is safe for concurrent use. Rules must be registered before the first call.
+func Default() *validator.Validate {
+	defaultOnce.Do(func() {
+		defaultValidate = newValidator()
+	})
+	return defaultValidate
+}
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(jsonName)
+
+	for _, r := range rules {
+		if r.Func == nil {
+			continue
+		}
+		if err := v.RegisterValidation(r.Tag, r.Func); err != nil {
+			panic(fmt.Sprintf("register %s validation: %v", r.Tag, err))
+		}
+	}
+	if err := registerTranslations(v); err != nil {
+		panic(fmt.Sprintf("register translations: %v", err))
+	}
+	return v
+}
+
+// jsonName makes errors refer to fields by their JSON names.
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	}
+	return name
+}
+
+// FieldErrors flattens a validation error into a map from the JSON path of a
+// field, like "postal_address.country", to a message in the language best
+// matching acceptLanguage, an Accept-Language header value.
+func FieldErrors(err error, acceptLanguage string) map[string]string {
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return map[string]string{"": err.Error()}
+	}
+
+	trans := Translator(acceptLanguage)
+	res := make(map[string]string, len(verrs))
+	for _, fe := range verrs {
+		_, path, found := strings.Cut(fe.Namespace(), ".")
+		if !found {
+			path = fe.Field()
+		}
+		res[path] = fe.Translate(trans)
+	}
+	return res
+}
+
+// Message joins FieldErrors into one line, for APIs without a field map in
+// their error format.
+func Message(err error, acceptLanguage string) string {
+	errs := FieldErrors(err, acceptLanguage)
+	fields := make([]string, 0, len(errs))
+	for field := range errs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		parts = append(parts, errs[field])
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,110 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type testAddress struct {
+	PostalCode string `json:"postal_code" validate:"omitempty,postal_code"`
+}
+
+type testPerson struct {
+	Name      string      `json:"name" validate:"required,personname"`
+	Age       int32       `json:"age" validate:"omitempty,sane_age"`
+	Phone     string      `json:"phone" validate:"omitempty,phone"`
+	Work      string      `json:"work" validate:"omitempty,notblank"`
+	BirthDate *time.Time  `json:"birth_date" validate:"omitempty,past_date"`
+	Address   testAddress `json:"postal_address"`
+}
+
+func TestRules(t *testing.T) {
+	future := time.Now().Add(48 * time.Hour)
+	past := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		person        testPerson
+		expectedField string
+	}{
+		{name: "valid", person: testPerson{Name: "Anna-Maria O'Neil", Age: 30, Phone: "+7 (999) 123-45-67", BirthDate: &past}},
+		{name: "cyrillic name", person: testPerson{Name: "Иван Иванович"}},
+		{name: "blank name", person: testPerson{Name: "   "}, expectedField: "name"},
+		{name: "name with digits", person: testPerson{Name: "R2D2"}, expectedField: "name"},
+		{name: "name starting with a dot", person: testPerson{Name: ".Ivan"}, expectedField: "name"},
+		{name: "negative age", person: testPerson{Name: "Ivan", Age: -1}, expectedField: "age"},
+		{name: "too old", person: testPerson{Name: "Ivan", Age: 151}, expectedField: "age"},
+		{name: "local phone", person: testPerson{Name: "Ivan", Phone: "123-45-67"}, expectedField: "phone"},
+		{name: "blank work", person: testPerson{Name: "Ivan", Work: " \t"}, expectedField: "work"},
+		{name: "future birth date", person: testPerson{Name: "Ivan", BirthDate: &future}, expectedField: "birth_date"},
+		{name: "bad postal code", person: testPerson{Name: "Ivan", Address: testAddress{PostalCode: "#1"}}, expectedField: "postal_address.postal_code"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Default().Struct(tt.person)
+			if tt.expectedField == "" {
+				if err != nil {
+					t.Errorf("Struct() error = %v", err)
+				}
+				return
+			}
+
+			errs := FieldErrors(err, "")
+			if _, ok := errs[tt.expectedField]; !ok || len(errs) != 1 {
+				t.Errorf("FieldErrors() expected an error for %s, but got %v", tt.expectedField, errs)
+			}
+		})
+	}
+}
+
+func TestFieldErrors_translations(t *testing.T) {
+	err := Default().Struct(testPerson{Age: 200})
+
+	tests := []struct {
+		acceptLanguage string
+		expected       map[string]string
+	}{
+		{
+			acceptLanguage: "",
+			expected: map[string]string{
+				"name": "name is a required field",
+				"age":  "age must be between 1 and 150",
+			},
+		},
+		{
+			acceptLanguage: "ru-RU,ru;q=0.9,en;q=0.8",
+			expected: map[string]string{
+				"name": "name обязательное поле",
+				"age":  "age должен быть от 1 до 150",
+			},
+		},
+		{
+			acceptLanguage: "de, en;q=0.5",
+			expected: map[string]string{
+				"name": "name is a required field",
+				"age":  "age must be between 1 and 150",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.acceptLanguage, func(t *testing.T) {
+			errs := FieldErrors(err, tt.acceptLanguage)
+			if len(errs) != len(tt.expected) {
+				t.Fatalf("FieldErrors() expected %v, but got %v", tt.expected, errs)
+			}
+			for field, msg := range tt.expected {
+				if errs[field] != msg {
+					t.Errorf("FieldErrors() for %s expected %q, but got %q", field, msg, errs[field])
+				}
+			}
+		})
+	}
+}
+
+func TestFieldErrors_notValidationError(t *testing.T) {
+	errs := FieldErrors(Default().Struct(42), "ru")
+	if len(errs) != 1 || !strings.HasPrefix(errs[""], "validator:") {
+		t.Errorf("FieldErrors() expected the error under an empty key, but got %v", errs)
+	}
+}